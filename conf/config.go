@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -17,6 +18,8 @@ type Config struct {
 	Server       Server       `yaml:"server"`
 	Prom         Prom         `json:"prom"`
 	StorageClass StorageClass `yaml:"storageClass"`
+	PortForward  PortForward  `yaml:"portForward"`
+	FileTransfer FileTransfer `yaml:"fileTransfer"`
 }
 
 type Server struct {
@@ -29,9 +32,53 @@ type Prom struct {
 }
 
 type StorageClass struct {
+	Provisioners []Provisioner `yaml:"provisioners"` // only StorageClasses of these provisioners can be created
+	// Provisioner is the former list of names, read as provisioners without restrictions when those are absent
 	Provisioner []string `yaml:"provisioner"`
 }
 
+// Provisioner declares what a StorageClass of the provisioner may set.
+type Provisioner struct {
+	Name               string   `yaml:"name"`
+	AllowedParameters  []string `yaml:"allowedParameters"`  // empty allows any key
+	RequiredParameters []string `yaml:"requiredParameters"` // must be set with a non-empty value
+	ReclaimPolicies    []string `yaml:"reclaimPolicies"`    // empty allows Delete and Retain
+	BindingModes       []string `yaml:"bindingModes"`       // empty allows Immediate and WaitForFirstConsumer
+}
+
+// useLegacy registers the names of the former provisioner key, allowing any parameters like before.
+func (s *StorageClass) useLegacy() {
+	if len(s.Provisioners) > 0 {
+		return
+	}
+	for _, name := range s.Provisioner {
+		s.Provisioners = append(s.Provisioners, Provisioner{Name: name})
+	}
+}
+
+// Lookup returns the registered provisioner of the name, nil when it is not registered.
+func (s *StorageClass) Lookup(name string) *Provisioner {
+	for i := range s.Provisioners {
+		if s.Provisioners[i].Name == name {
+			return &s.Provisioners[i]
+		}
+	}
+
+	return nil
+}
+
+type PortForward struct {
+	MaxSessions int           `yaml:"maxSessions"` // concurrent port-forward sessions
+	IdleTimeout time.Duration `yaml:"idleTimeout"` // close a session after no traffic, e.g. 10m
+	// AllowedOrigins are the pages besides kube-ctl itself that may open tunnels, e.g. https://console.example.com
+	AllowedOrigins []string `yaml:"allowedOrigins"`
+}
+
+type FileTransfer struct {
+	MaxDownloadMB int64 `yaml:"maxDownloadMB"` // largest file or directory copied out of a container
+	MaxUploadMB   int64 `yaml:"maxUploadMB"`   // largest total size of one upload
+}
+
 func GetConf() *Config {
 	once.Do(func() {
 		initConfig()
@@ -54,6 +101,7 @@ func initConfig() {
 	if err := viper.Unmarshal(conf); err != nil {
 		panic(err)
 	}
+	conf.StorageClass.useLegacy()
 }
 
 func getEnv() string {
@@ -46,7 +46,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "返回ConfigMap的详细信息",
+                        "description": "返回ConfigMap的详细信息，usedBy 为引用它的工作负载和 Pod",
                         "schema": {
                             "allOf": [
                                 {
@@ -72,7 +72,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "创建新的 ConfigMap 或更新已存在的 ConfigMap",
+                "description": "创建新的 ConfigMap 或更新已存在的 ConfigMap，binaryData 的值为 base64 编码，只设置所列的键，未列出的已有二进制键保留，删除需放入 removeBinaryKeys；合并后 data 与 binaryData 总大小不超过 1MiB；immutable 一旦开启不可关闭且数据不可再修改；restartConsumers 为 true 时保存后滚动重启使用它的 Deployment/StatefulSet/DaemonSet",
                 "consumes": [
                     "application/json"
                 ],
@@ -92,17 +92,56 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ConfigMap"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段",
+                        "name": "force",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "操作成功，返回成功消息",
+                        "description": "操作成功；开启重启时返回已重启的工作负载",
                         "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Consumer"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "参数错误(code=20001)",
+                        "description": "参数错误(code=20001)或验证错误、ConfigMap 不可变(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "413": {
+                        "description": "合并后超过 1MiB 大小上限(code=20002)",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
@@ -159,6 +198,61 @@ const docTemplate = `{
                 }
             }
         },
+        "/api/configmap/download": {
+            "get": {
+                "description": "将 data 或 binaryData 中指定 key 的内容作为文件下载，文件名即 key",
+                "produces": [
+                    "application/octet-stream"
+                ],
+                "tags": [
+                    "ConfigMap管理"
+                ],
+                "summary": "下载 ConfigMap 的某个 key",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ConfigMap名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "要下载的 key",
+                        "name": "key",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "key 的内容",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "key 不存在(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
         "/api/configmap/list": {
             "get": {
                 "description": "获取指定命名空间下的所有ConfigMap列表",
@@ -219,6 +313,76 @@ const docTemplate = `{
                 }
             }
         },
+        "/api/configmap/upload": {
+            "post": {
+                "description": "以 multipart 表单上传文件，每个文件以文件名为 key 写入 ConfigMap(同 kubectl create configmap --from-file)，文本写入 data，二进制写入 binaryData；ConfigMap 不存在时自动创建",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ConfigMap管理"
+                ],
+                "summary": "上传文件到 ConfigMap",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ConfigMap名称",
+                        "name": "name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "要上传的文件，可多个",
+                        "name": "files",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "操作成功，返回成功消息",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误(code=20001)或 key 非法、ConfigMap 不可变(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "413": {
+                        "description": "超过 1MiB 大小上限(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
         "/api/cronjob": {
             "get": {
                 "description": "获取指定命名空间下指定CronJob的详细信息",
@@ -296,6 +460,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.CronJob"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -306,7 +476,13 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "参数错误(code=20001)",
+                        "description": "参数错误(code=20001)或验证错误(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "资源在读取后已被修改或删除(code=30002)，data 为当前对象，可合并或重新加载",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
@@ -363,9 +539,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/cronjob/list": {
+        "/api/cronjob/jobs": {
             "get": {
-                "description": "获取指定命名空间下的所有CronJob列表",
+                "description": "获取 CronJob 所属的 Job 列表(按创建时间倒序)及成功、失败次数、最近调度时间和最近成功时间，保留的 Job 数量受历史记录限制",
                 "consumes": [
                     "application/json"
                 ],
@@ -375,7 +551,7 @@ const docTemplate = `{
                 "tags": [
                     "CronJob 管理"
                 ],
-                "summary": "获取 CronJob 列表",
+                "summary": "获取 CronJob 执行历史",
                 "parameters": [
                     {
                         "type": "string",
@@ -386,15 +562,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "关键词",
-                        "name": "keyword",
+                        "description": "CronJob 名称",
+                        "name": "name",
                         "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "返回CronJob列表",
+                        "description": "返回执行历史",
                         "schema": {
                             "allOf": [
                                 {
@@ -404,10 +580,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.CronJob"
-                                            }
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.CronJobHistory"
                                         }
                                     }
                                 }
@@ -423,9 +596,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/daemonset": {
+        "/api/cronjob/list": {
             "get": {
-                "description": "获取指定命名空间下指定DaemonSet的详细信息",
+                "description": "获取指定命名空间下的所有CronJob列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -433,9 +606,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "DaemonSet 管理"
+                    "CronJob 管理"
                 ],
-                "summary": "获取DaemonSet详情",
+                "summary": "获取 CronJob 列表",
                 "parameters": [
                     {
                         "type": "string",
@@ -446,15 +619,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "DaemonSet 名称",
-                        "name": "name",
+                        "description": "关键词",
+                        "name": "keyword",
                         "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "返回DaemonSet的详细信息",
+                        "description": "返回CronJob列表",
                         "schema": {
                             "allOf": [
                                 {
@@ -464,7 +637,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.DaemonSet"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.CronJob"
+                                            }
                                         }
                                     }
                                 }
@@ -478,9 +654,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/cronjob/resume": {
             "post": {
-                "description": "创建新的 DaemonSet 或更新已存在的 DaemonSet",
+                "description": "suspend 暂停调度新的 Job，已在运行的 Job 不受影响；resume 恢复调度",
                 "consumes": [
                     "application/json"
                 ],
@@ -488,29 +666,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "DaemonSet 管理"
+                    "CronJob 管理"
                 ],
-                "summary": "创建或更新 DaemonSet",
+                "summary": "暂停或恢复 CronJob",
                 "parameters": [
                     {
-                        "description": "DaemonSet 配置信息",
-                        "name": "pod",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.DaemonSet"
-                        }
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CronJob 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "操作成功，返回成功消息",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
-                        }
-                    },
-                    "400": {
-                        "description": "参数错误(code=20001)",
+                        "description": "操作成功",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
@@ -522,9 +699,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "删除指定命名空间下的指定DaemonSet",
+            }
+        },
+        "/api/cronjob/run": {
+            "post": {
+                "description": "不等待调度，立即以 CronJob 的 jobTemplate 创建一个 Job(名称追加 -manual-xxxxx，带 cronjob.kubernetes.io/instantiate: manual 注解)，该 Job 归属于 CronJob 并计入执行历史",
                 "consumes": [
                     "application/json"
                 ],
@@ -532,9 +711,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "DaemonSet 管理"
+                    "CronJob 管理"
                 ],
-                "summary": "删除 DaemonSet",
+                "summary": "立即运行 CronJob",
                 "parameters": [
                     {
                         "type": "string",
@@ -545,7 +724,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "DaemonSet 名称",
+                        "description": "CronJob 名称",
                         "name": "name",
                         "in": "query",
                         "required": true
@@ -553,9 +732,21 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "删除成功",
+                        "description": "返回新创建的 Job",
                         "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Job"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "500": {
@@ -567,9 +758,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/daemonset/list": {
+        "/api/cronjob/schedule": {
             "get": {
-                "description": "获取指定命名空间下的所有DaemonSet列表",
+                "description": "解析 cron 表达式并按 timeZone 计算接下来的运行时间。传入 schedule 时预览该表达式(用于创建前校验)，否则预览指定 CronJob 的调度",
                 "consumes": [
                     "application/json"
                 ],
@@ -577,28 +768,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "DaemonSet 管理"
+                    "CronJob 管理"
                 ],
-                "summary": "获取DaemonSet列表",
+                "summary": "预览 CronJob 调度时间",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "命名空间",
                         "name": "namespace",
-                        "in": "query",
-                        "required": true
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "关键词",
-                        "name": "keyword",
-                        "in": "query",
-                        "required": true
+                        "description": "CronJob 名称",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "待预览的 cron 表达式",
+                        "name": "schedule",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "待预览的时区，如 Asia/Shanghai，为空时按 UTC 计算",
+                        "name": "timeZone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "返回的运行次数，默认 5，最多 100",
+                        "name": "count",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "返回DaemonSet列表",
+                        "description": "返回接下来的运行时间",
                         "schema": {
                             "allOf": [
                                 {
@@ -608,18 +815,21 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.DaemonSet"
-                                            }
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.CronJobSchedule"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "500": {
-                        "description": "系统错误(code=30000)",
+                    "400": {
+                        "description": "参数错误(code=20001)或表达式、时区无效(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
@@ -627,9 +837,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/dashboard": {
-            "get": {
-                "description": "获取集群Metrics信息构建Dashboard界面",
+        "/api/cronjob/suspend": {
+            "post": {
+                "description": "suspend 暂停调度新的 Job，已在运行的 Job 不受影响；resume 恢复调度",
                 "consumes": [
                     "application/json"
                 ],
@@ -637,46 +847,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Metrics 管理"
+                    "CronJob 管理"
+                ],
+                "summary": "暂停或恢复 CronJob",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CronJob 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "获取集群Metrics信息",
                 "responses": {
                     "200": {
-                        "description": "获取集群信息成功",
+                        "description": "操作成功",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
                     },
                     "500": {
-                        "description": "获取集群信息失败",
+                        "description": "系统错误(code=30000)",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "object",
-                                            "additionalProperties": {
-                                                "type": "array",
-                                                "items": {
-                                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.MetricsItem"
-                                                }
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
                     }
                 }
             }
         },
-        "/api/deployment": {
+        "/api/daemonset": {
             "get": {
-                "description": "获取指定命名空间下指定Deployment的详细信息",
+                "description": "获取指定命名空间下指定DaemonSet的详细信息",
                 "consumes": [
                     "application/json"
                 ],
@@ -684,9 +892,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Deployment 管理"
+                    "DaemonSet 管理"
                 ],
-                "summary": "获取Deployment详情",
+                "summary": "获取DaemonSet详情",
                 "parameters": [
                     {
                         "type": "string",
@@ -697,7 +905,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Deployment 名称",
+                        "description": "DaemonSet 名称",
                         "name": "name",
                         "in": "query",
                         "required": true
@@ -705,7 +913,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "返回Deployment的详细信息",
+                        "description": "返回DaemonSet的详细信息",
                         "schema": {
                             "allOf": [
                                 {
@@ -715,7 +923,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Deployment"
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.DaemonSet"
                                         }
                                     }
                                 }
@@ -731,7 +939,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "创建新的 Deployment 或更新已存在的 Deployment",
+                "description": "创建新的 DaemonSet 或更新已存在的 DaemonSet",
                 "consumes": [
                     "application/json"
                 ],
@@ -739,18 +947,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Deployment 管理"
+                    "DaemonSet 管理"
                 ],
-                "summary": "创建或更新 Deployment",
+                "summary": "创建或更新 DaemonSet",
                 "parameters": [
                     {
-                        "description": "Deployment 配置信息",
+                        "description": "DaemonSet 配置信息",
                         "name": "pod",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Deployment"
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.DaemonSet"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段",
+                        "name": "force",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -761,7 +981,13 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "参数错误(code=20001)",
+                        "description": "参数错误(code=20001)或验证错误(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
@@ -775,7 +1001,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "删除指定命名空间下的指定Deployment",
+                "description": "删除指定命名空间下的指定DaemonSet",
                 "consumes": [
                     "application/json"
                 ],
@@ -783,9 +1009,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Deployment 管理"
+                    "DaemonSet 管理"
                 ],
-                "summary": "删除 Deployment",
+                "summary": "删除 DaemonSet",
                 "parameters": [
                     {
                         "type": "string",
@@ -796,7 +1022,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Deployment 名称",
+                        "description": "DaemonSet 名称",
                         "name": "name",
                         "in": "query",
                         "required": true
@@ -818,9 +1044,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/deployment/list": {
+        "/api/daemonset/coverage": {
             "get": {
-                "description": "获取指定命名空间下的所有Deployment列表",
+                "description": "逐个节点列出守护 Pod 是否运行：Running/Pending、未被调度的节点(Skipped)及原因(污点未容忍、nodeSelector 或节点亲和性不匹配)、应运行但缺失的节点(Missing，如资源不足)以及运行在不该运行节点上的 Pod(Misscheduled)",
                 "consumes": [
                     "application/json"
                 ],
@@ -828,9 +1054,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Deployment 管理"
+                    "DaemonSet 管理"
                 ],
-                "summary": "获取Deployment列表",
+                "summary": "获取 DaemonSet 节点覆盖情况",
                 "parameters": [
                     {
                         "type": "string",
@@ -841,15 +1067,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "关键词",
-                        "name": "keyword",
+                        "description": "DaemonSet 名称",
+                        "name": "name",
                         "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "返回Deployment列表",
+                        "description": "返回各节点覆盖情况",
                         "schema": {
                             "allOf": [
                                 {
@@ -859,10 +1085,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Deployment"
-                                            }
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.DaemonSetCoverage"
                                         }
                                     }
                                 }
@@ -878,9 +1101,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/ingress": {
+        "/api/daemonset/list": {
             "get": {
-                "description": "获取指定命名空间下指定Ingress的详细信息",
+                "description": "获取指定命名空间下的所有DaemonSet列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -888,9 +1111,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Ingress 管理"
+                    "DaemonSet 管理"
                 ],
-                "summary": "获取Ingress详情",
+                "summary": "获取DaemonSet列表",
                 "parameters": [
                     {
                         "type": "string",
@@ -901,15 +1124,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Ingress 名称",
-                        "name": "name",
+                        "description": "关键词",
+                        "name": "keyword",
                         "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "返回Ingress的详细信息",
+                        "description": "返回DaemonSet列表",
                         "schema": {
                             "allOf": [
                                 {
@@ -919,7 +1142,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Ingress"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.DaemonSet"
+                                            }
                                         }
                                     }
                                 }
@@ -933,53 +1159,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/daemonset/restart": {
             "post": {
-                "description": "创建新的 Ingress 或更新已存在的 Ingress",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Ingress 管理"
-                ],
-                "summary": "创建或更新 Ingress",
-                "parameters": [
-                    {
-                        "description": "Ingress 配置信息",
-                        "name": "pod",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Ingress"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "操作成功，返回成功消息",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
-                        }
-                    },
-                    "400": {
-                        "description": "参数错误(code=20001)",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
-                        }
-                    },
-                    "500": {
-                        "description": "系统错误(code=30000)",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
-                        }
-                    }
-                }
-            },
-            "delete": {
-                "description": "删除一个 Ingress",
+                "description": "与 kubectl rollout restart 相同，按更新策略逐节点重建守护 Pod；OnDelete 策略下需手动删除 Pod 才会生效",
                 "consumes": [
                     "application/json"
                 ],
@@ -987,28 +1171,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Ingress 管理"
+                    "DaemonSet 管理"
                 ],
-                "summary": "删除 Ingress",
+                "summary": "重启 DaemonSet",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Ingress 名称",
-                        "name": "name",
+                        "description": "命名空间",
+                        "name": "namespace",
                         "in": "query",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "命名空间",
-                        "name": "namespace",
+                        "description": "DaemonSet 名称",
+                        "name": "name",
                         "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "删除 Ingress 成功",
+                        "description": "操作成功",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
@@ -1022,9 +1206,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/ingress/list": {
+        "/api/dashboard": {
             "get": {
-                "description": "获取指定命名空间下指定Ingress的列表",
+                "description": "获取集群Metrics信息构建Dashboard界面",
                 "consumes": [
                     "application/json"
                 ],
@@ -1032,28 +1216,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Ingress 管理"
-                ],
-                "summary": "获取Ingress列表",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "命名空间",
-                        "name": "namespace",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "关键词",
-                        "name": "keyword",
-                        "in": "query",
-                        "required": true
-                    }
+                    "Metrics 管理"
                 ],
+                "summary": "获取集群Metrics信息",
                 "responses": {
                     "200": {
-                        "description": "返回Ingress的列表",
+                        "description": "获取集群信息成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "获取集群信息失败",
                         "schema": {
                             "allOf": [
                                 {
@@ -1063,28 +1237,25 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Ingress"
+                                            "type": "object",
+                                            "additionalProperties": {
+                                                "type": "array",
+                                                "items": {
+                                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.MetricsItem"
+                                                }
                                             }
                                         }
                                     }
                                 }
                             ]
                         }
-                    },
-                    "500": {
-                        "description": "系统错误(code=30000)",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
-                        }
                     }
                 }
             }
         },
-        "/api/ingroute": {
+        "/api/deployment": {
             "get": {
-                "description": "获取指定命名空间下指定IngressRoute的详细信息",
+                "description": "获取指定命名空间下指定Deployment的详细信息",
                 "consumes": [
                     "application/json"
                 ],
@@ -1092,9 +1263,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "IngressRoute 管理"
+                    "Deployment 管理"
                 ],
-                "summary": "获取IngresRoute详情",
+                "summary": "获取Deployment详情",
                 "parameters": [
                     {
                         "type": "string",
@@ -1105,7 +1276,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "IngressRoute 名称",
+                        "description": "Deployment 名称",
                         "name": "name",
                         "in": "query",
                         "required": true
@@ -1113,7 +1284,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "返回IngressRoute的详细信息",
+                        "description": "返回Deployment的详细信息，autoscaler 为管理其副本数的 HPA，disruptionBudget 为保护其 Pod 的 PDB 情况(None/Blocking/Allowed)",
                         "schema": {
                             "allOf": [
                                 {
@@ -1123,7 +1294,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.IngressRoute"
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Deployment"
                                         }
                                     }
                                 }
@@ -1139,7 +1310,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "创建新的 IngressRoute 或更新已存在的 IngressRoute",
+                "description": "创建新的 Deployment 或更新已存在的 Deployment",
                 "consumes": [
                     "application/json"
                 ],
@@ -1147,29 +1318,47 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "IngressRoute 管理"
+                    "Deployment 管理"
                 ],
-                "summary": "创建或更新 IngressRoute",
+                "summary": "创建或更新 Deployment",
                 "parameters": [
                     {
-                        "description": "IngressRoute 配置信息",
+                        "description": "Deployment 配置信息",
                         "name": "pod",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.IngressRoute"
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Deployment"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段",
+                        "name": "force",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "操作成功，返回成功消息",
+                        "description": "操作成功；副本数由生效中的 HPA 管理时 msg 为覆盖提示，预演时该提示位于 data.warnings",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
                     },
                     "400": {
-                        "description": "参数错误(code=20001)",
+                        "description": "参数错误(code=20001)或验证错误(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
@@ -1183,7 +1372,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "删除一个 IngressRoute",
+                "description": "删除指定命名空间下的指定Deployment",
                 "consumes": [
                     "application/json"
                 ],
@@ -1191,28 +1380,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "IngressRoute 管理"
+                    "Deployment 管理"
                 ],
-                "summary": "删除 IngressRoute",
+                "summary": "删除 Deployment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "IngressRoute 名称",
-                        "name": "name",
+                        "description": "命名空间",
+                        "name": "namespace",
                         "in": "query",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "命名空间",
-                        "name": "namespace",
+                        "description": "Deployment 名称",
+                        "name": "name",
                         "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "删除 IngressRoute 成功",
+                        "description": "删除成功",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
@@ -1226,9 +1415,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/ingroute/list": {
+        "/api/deployment/list": {
             "get": {
-                "description": "获取指定命名空间下指定IngressRoute的列表",
+                "description": "获取指定命名空间下的所有Deployment列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -1236,9 +1425,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "IngressRoute 管理"
+                    "Deployment 管理"
                 ],
-                "summary": "获取IngressRoute列表",
+                "summary": "获取Deployment列表",
                 "parameters": [
                     {
                         "type": "string",
@@ -1257,60 +1446,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "返回IngressRoute的列表",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.IngressRoute"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "500": {
-                        "description": "系统错误(code=30000)",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/ingroute/mws": {
-            "get": {
-                "description": "获取指定命名空间下指定IngressRoute的Middlewares列表",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "IngressRoute 管理"
-                ],
-                "summary": "获取IngressRoute的Middlewares列表",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "命名空间",
-                        "name": "namespace",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "返回IngressRoute的Middlewares列表",
+                        "description": "返回Deployment列表",
                         "schema": {
                             "allOf": [
                                 {
@@ -1322,7 +1458,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "type": "string"
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Deployment"
                                             }
                                         }
                                     }
@@ -1339,9 +1475,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/job": {
+        "/api/hpa": {
             "get": {
-                "description": "获取指定命名空间下指定Job的详细信息",
+                "description": "获取指定命名空间下指定 HPA 的配置，可修改后直接提交更新",
                 "consumes": [
                     "application/json"
                 ],
@@ -1349,9 +1485,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Job 管理"
+                    "HPA 管理"
                 ],
-                "summary": "获取Job详情",
+                "summary": "获取 HPA 详情",
                 "parameters": [
                     {
                         "type": "string",
@@ -1362,7 +1498,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Job 名称",
+                        "description": "HPA 名称",
                         "name": "name",
                         "in": "query",
                         "required": true
@@ -1370,7 +1506,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "返回Job的详细信息",
+                        "description": "返回 HPA 的详细信息",
                         "schema": {
                             "allOf": [
                                 {
@@ -1380,7 +1516,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Job"
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.HPA"
                                         }
                                     }
                                 }
@@ -1396,7 +1532,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "创建新的 Job 或更新已存在的 Job",
+                "description": "创建或更新以 Deployment/StatefulSet 为目标的 autoscaling/v2 HorizontalPodAutoscaler，支持 CPU/内存利用率(整个 Pod 或指定容器)、自定义与外部指标以及扩缩容行为策略；未指定指标时默认 CPU 利用率 80%",
                 "consumes": [
                     "application/json"
                 ],
@@ -1404,18 +1540,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Job 管理"
+                    "HPA 管理"
                 ],
-                "summary": "创建或更新 Job",
+                "summary": "创建或更新 HPA",
                 "parameters": [
                     {
-                        "description": "Job 配置信息",
-                        "name": "pod",
+                        "description": "HPA 配置信息",
+                        "name": "hpa",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Job"
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.HPA"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段",
+                        "name": "force",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1426,7 +1574,13 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "参数错误(code=20001)",
+                        "description": "参数错误(code=20001)或验证错误、目标工作负载不存在或已被其他 HPA 管理(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
@@ -1440,7 +1594,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "删除指定命名空间下的指定Job",
+                "description": "删除指定命名空间下的指定 HPA，目标工作负载保持当前副本数",
                 "consumes": [
                     "application/json"
                 ],
@@ -1448,9 +1602,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Job 管理"
+                    "HPA 管理"
                 ],
-                "summary": "删除 Job",
+                "summary": "删除 HPA",
                 "parameters": [
                     {
                         "type": "string",
@@ -1461,7 +1615,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Job 名称",
+                        "description": "HPA 名称",
                         "name": "name",
                         "in": "query",
                         "required": true
@@ -1483,9 +1637,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/job/list": {
+        "/api/hpa/list": {
             "get": {
-                "description": "获取指定命名空间下的所有Job列表",
+                "description": "获取指定命名空间下的所有 HPA，包含当前/期望副本数与各指标的当前值/目标值",
                 "consumes": [
                     "application/json"
                 ],
@@ -1493,9 +1647,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Job 管理"
+                    "HPA 管理"
                 ],
-                "summary": "获取Job列表",
+                "summary": "获取 HPA 列表",
                 "parameters": [
                     {
                         "type": "string",
@@ -1508,13 +1662,12 @@ const docTemplate = `{
                         "type": "string",
                         "description": "关键词",
                         "name": "keyword",
-                        "in": "query",
-                        "required": true
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "返回Job列表",
+                        "description": "返回 HPA 列表",
                         "schema": {
                             "allOf": [
                                 {
@@ -1526,7 +1679,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Job"
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.HPA"
                                             }
                                         }
                                     }
@@ -1543,9 +1696,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/node": {
+        "/api/hpa/status": {
             "get": {
-                "description": "获取集群中单个 Node 信息",
+                "description": "获取 HPA 的当前与期望副本数、最近扩缩容时间、各指标当前值以及 AbleToScale/ScalingActive/ScalingLimited 等状态条件",
                 "consumes": [
                     "application/json"
                 ],
@@ -1553,111 +1706,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Node管理"
+                    "HPA 管理"
                 ],
-                "summary": "获取 Node 详情",
+                "summary": "获取 HPA 状态",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "node name",
-                        "name": "name",
+                        "description": "命名空间",
+                        "name": "namespace",
                         "in": "query",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "获取成功",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.NodeDetail"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "500": {
-                        "description": "系统错误(code=30000)",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/node/label": {
-            "put": {
-                "description": "为单个 Node 添加 label",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Node管理"
-                ],
-                "summary": "Node 添加标签",
-                "parameters": [
-                    {
-                        "description": "node name and labels",
-                        "name": "node",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.UpdateLabelReq"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "更新成功",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
-                        }
                     },
-                    "500": {
-                        "description": "系统错误(code=30000)",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/node/list": {
-            "get": {
-                "description": "获取集群中所有 Node 信息",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Node管理"
-                ],
-                "summary": "获取 Node 列表",
-                "parameters": [
                     {
                         "type": "string",
-                        "description": "node 关键词",
-                        "name": "keyword",
+                        "description": "HPA 名称",
+                        "name": "name",
                         "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "获取成功",
+                        "description": "返回 HPA 状态",
                         "schema": {
                             "allOf": [
                                 {
@@ -1667,10 +1737,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.NodeListItem"
-                                            }
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.HPAStatus"
                                         }
                                     }
                                 }
@@ -1686,9 +1753,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/node/pods": {
+        "/api/ingress": {
             "get": {
-                "description": "集群中某个节点下的所有 Pod",
+                "description": "获取指定命名空间下指定Ingress的详细信息",
                 "consumes": [
                     "application/json"
                 ],
@@ -1696,9 +1763,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Node管理"
+                    "Ingress 管理"
                 ],
-                "summary": "Node 下的所有 Pod",
+                "summary": "获取Ingress详情",
                 "parameters": [
                     {
                         "type": "string",
@@ -1709,15 +1776,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Node 名称",
-                        "name": "node",
+                        "description": "Ingress 名称",
+                        "name": "name",
                         "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "查询成功",
+                        "description": "返回Ingress的详细信息",
                         "schema": {
                             "allOf": [
                                 {
@@ -1727,10 +1794,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.PodListItem"
-                                            }
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Ingress"
                                         }
                                     }
                                 }
@@ -1744,11 +1808,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/node/taint": {
-            "put": {
-                "description": "集群中某个节点的污点更新",
+            },
+            "post": {
+                "description": "创建新的 Ingress 或更新已存在的 Ingress",
                 "consumes": [
                     "application/json"
                 ],
@@ -1756,23 +1818,90 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Node管理"
+                    "Ingress 管理"
                 ],
-                "summary": "Node 污点更新",
+                "summary": "创建或更新 Ingress",
                 "parameters": [
                     {
-                        "description": "node name and taints",
-                        "name": "node",
+                        "description": "Ingress 配置信息",
+                        "name": "pod",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.UpdateTaintReq"
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Ingress"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段",
+                        "name": "force",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "更新成功",
+                        "description": "操作成功，返回成功消息",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误(code=20001)或验证错误(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "删除一个 Ingress",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Ingress 管理"
+                ],
+                "summary": "删除 Ingress",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Ingress 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "删除 Ingress 成功",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
@@ -1786,9 +1915,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/pod": {
+        "/api/ingress/list": {
             "get": {
-                "description": "获取指定命名空间下指定Pod的详细信息",
+                "description": "获取指定命名空间下指定Ingress的列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -1796,9 +1925,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Pod管理"
+                    "Ingress 管理"
                 ],
-                "summary": "获取Pod详情",
+                "summary": "获取Ingress列表",
                 "parameters": [
                     {
                         "type": "string",
@@ -1809,7 +1938,67 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Pod名称",
+                        "description": "关键词",
+                        "name": "keyword",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "返回Ingress的列表",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Ingress"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/ingroute": {
+            "get": {
+                "description": "获取指定命名空间下指定IngressRoute的详细信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "IngressRoute 管理"
+                ],
+                "summary": "获取IngresRoute详情",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "IngressRoute 名称",
                         "name": "name",
                         "in": "query",
                         "required": true
@@ -1817,7 +2006,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "返回Pod的详细信息，包含基础信息、卷配置、网络配置、初始化容器和主容器配置",
+                        "description": "返回IngressRoute的详细信息",
                         "schema": {
                             "allOf": [
                                 {
@@ -1827,7 +2016,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Pod"
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.IngressRoute"
                                         }
                                     }
                                 }
@@ -1843,7 +2032,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "创建新的Pod或更新已存在的Pod",
+                "description": "创建新的 IngressRoute 或更新已存在的 IngressRoute",
                 "consumes": [
                     "application/json"
                 ],
@@ -1851,18 +2040,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Pod管理"
+                    "IngressRoute 管理"
                 ],
-                "summary": "创建或更新Pod",
+                "summary": "创建或更新 IngressRoute",
                 "parameters": [
                     {
-                        "description": "Pod配置信息",
+                        "description": "IngressRoute 配置信息",
                         "name": "pod",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Pod"
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.IngressRoute"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段",
+                        "name": "force",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1873,7 +2074,13 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "参数错误(code=20001)或验证错误(code=20002)",
+                        "description": "参数错误(code=20001)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
@@ -1887,7 +2094,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "删除指定命名空间下的指定Pod",
+                "description": "删除一个 IngressRoute",
                 "consumes": [
                     "application/json"
                 ],
@@ -1895,28 +2102,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Pod管理"
+                    "IngressRoute 管理"
                 ],
-                "summary": "删除Pod",
+                "summary": "删除 IngressRoute",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "命名空间",
-                        "name": "namespace",
+                        "description": "IngressRoute 名称",
+                        "name": "name",
                         "in": "query",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Pod名称",
-                        "name": "name",
+                        "description": "命名空间",
+                        "name": "namespace",
                         "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "删除成功",
+                        "description": "删除 IngressRoute 成功",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
@@ -1930,9 +2137,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/pod/list": {
+        "/api/ingroute/list": {
             "get": {
-                "description": "获取指定命名空间下的所有Pod列表",
+                "description": "获取指定命名空间下指定IngressRoute的列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -1940,9 +2147,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Pod管理"
+                    "IngressRoute 管理"
                 ],
-                "summary": "获取Pod列表",
+                "summary": "获取IngressRoute列表",
                 "parameters": [
                     {
                         "type": "string",
@@ -1950,11 +2157,18 @@ const docTemplate = `{
                         "name": "namespace",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "关键词",
+                        "name": "keyword",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "返回Pod列表，每个Pod包含名称、就绪状态、运行状态、重启次数、运行时长、IP和所在节点",
+                        "description": "返回IngressRoute的列表",
                         "schema": {
                             "allOf": [
                                 {
@@ -1966,7 +2180,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.PodListItem"
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.IngressRoute"
                                             }
                                         }
                                     }
@@ -1983,9 +2197,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/pod/namespace": {
+        "/api/ingroute/mws": {
             "get": {
-                "description": "获取所有可用的命名空间列表",
+                "description": "获取指定命名空间下指定IngressRoute的Middlewares列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -1993,12 +2207,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Pod管理"
+                    "IngressRoute 管理"
+                ],
+                "summary": "获取IngressRoute的Middlewares列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    }
                 ],
-                "summary": "获取命名空间列表",
                 "responses": {
                     "200": {
-                        "description": "返回命名空间列表，每个命名空间包含名称、创建时间和状态",
+                        "description": "返回IngressRoute的Middlewares列表",
                         "schema": {
                             "allOf": [
                                 {
@@ -2010,7 +2233,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Namespace"
+                                                "type": "string"
                                             }
                                         }
                                     }
@@ -2027,9 +2250,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/pod/search": {
-            "post": {
-                "description": "搜索指定命名空间下的指定Pod",
+        "/api/job": {
+            "get": {
+                "description": "获取指定命名空间下指定Job的详细信息",
                 "consumes": [
                     "application/json"
                 ],
@@ -2037,9 +2260,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Pod管理"
+                    "Job 管理"
                 ],
-                "summary": "搜索Pod",
+                "summary": "获取Job详情",
                 "parameters": [
                     {
                         "type": "string",
@@ -2050,7 +2273,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Pod名称",
+                        "description": "Job 名称",
                         "name": "name",
                         "in": "query",
                         "required": true
@@ -2058,7 +2281,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "搜索成功",
+                        "description": "返回Job的详细信息",
                         "schema": {
                             "allOf": [
                                 {
@@ -2068,7 +2291,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.PodListItem"
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Job"
                                         }
                                     }
                                 }
@@ -2082,11 +2305,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/pv": {
-            "get": {
-                "description": "获取所有 PersistentVolume 存储空间的信息",
+            },
+            "post": {
+                "description": "创建新的 Job 或更新已存在的 Job",
                 "consumes": [
                     "application/json"
                 ],
@@ -2094,71 +2315,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PV 管理"
+                    "Job 管理"
+                ],
+                "summary": "创建或更新 Job",
+                "parameters": [
+                    {
+                        "description": "Job 配置信息",
+                        "name": "pod",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Job"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
                 ],
-                "summary": "获取 PV 列表",
                 "responses": {
                     "200": {
-                        "description": "获取成功",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.PersistentVolumeItem"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    },
-                    "500": {
-                        "description": "系统错误(code=30000)",
+                        "description": "操作成功，返回成功消息",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
-                    }
-                }
-            },
-            "post": {
-                "description": "创建一个 PersistentVolume 存储空间",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "PV 管理"
-                ],
-                "summary": "创建 PV",
-                "parameters": [
-                    {
-                        "description": "PV 信息",
-                        "name": "pod",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.PersistentVolume"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "创建 PV 成功",
+                    },
+                    "400": {
+                        "description": "参数错误(code=20001)或验证错误(code=20002)",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
                     },
-                    "400": {
-                        "description": "参数错误(code=20001)",
+                    "409": {
+                        "description": "资源在读取后已被修改或删除(code=30002)，data 为当前对象，可合并或重新加载",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
@@ -2172,7 +2363,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "删除一个 PersistentVolume 存储空间",
+                "description": "删除指定命名空间下的指定Job",
                 "consumes": [
                     "application/json"
                 ],
@@ -2180,13 +2371,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PV 管理"
+                    "Job 管理"
                 ],
-                "summary": "删除 PV",
+                "summary": "删除 Job",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "PV 名称",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Job 名称",
                         "name": "name",
                         "in": "query",
                         "required": true
@@ -2194,7 +2392,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "删除 PV 成功",
+                        "description": "删除成功",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
@@ -2208,9 +2406,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/pvc": {
+        "/api/job/list": {
             "get": {
-                "description": "获取所有 PersistentVolumeClaim 存储声明信息",
+                "description": "获取指定命名空间下的所有Job列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -2218,9 +2416,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PVC 管理"
+                    "Job 管理"
                 ],
-                "summary": "获取 PVC 列表",
+                "summary": "获取Job列表",
                 "parameters": [
                     {
                         "type": "string",
@@ -2239,7 +2437,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "获取成功",
+                        "description": "返回Job列表",
                         "schema": {
                             "allOf": [
                                 {
@@ -2251,7 +2449,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.PersistentVolumeClaim"
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Job"
                                             }
                                         }
                                     }
@@ -2266,9 +2464,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "创建一个 PersistentVolumeClaim 声明用户的存储需求",
+            }
+        },
+        "/api/job/pods": {
+            "get": {
+                "description": "获取 Job 创建的所有 Pod 及其状态、各容器的退出码，Indexed Job 附带完成索引",
                 "consumes": [
                     "application/json"
                 ],
@@ -2276,57 +2476,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "PVC 管理"
+                    "Job 管理"
                 ],
-                "summary": "创建 PVC",
+                "summary": "获取 Job 的 Pod",
                 "parameters": [
                     {
-                        "description": "PVC 信息",
-                        "name": "pod",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.PersistentVolumeClaim"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "创建 PVC 成功",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
-                        }
-                    },
-                    "400": {
-                        "description": "参数错误(code=20001)",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
-                        }
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
                     },
-                    "500": {
-                        "description": "系统错误(code=30000)",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
-                        }
-                    }
-                }
-            },
-            "delete": {
-                "description": "删除一个 PersistentVolumeClaim 存储声明",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "PVC 管理"
-                ],
-                "summary": "删除 PVC",
-                "parameters": [
                     {
                         "type": "string",
-                        "description": "PVC 名称",
+                        "description": "Job 名称",
                         "name": "name",
                         "in": "query",
                         "required": true
@@ -2334,9 +2497,24 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "删除 PVC 成功",
+                        "description": "返回 Pod 列表，按创建时间排序",
                         "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.JobPod"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "500": {
@@ -2348,9 +2526,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/rbac/rb": {
-            "get": {
-                "description": "获取 RoleBinding 的详细信息",
+        "/api/job/rerun": {
+            "post": {
+                "description": "以已完成或失败的 Job 为模板创建一个新的 Job 副本(名称追加 -rerun-xxxxx)，原 Job 保留",
                 "consumes": [
                     "application/json"
                 ],
@@ -2358,9 +2536,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC 管理"
+                    "Job 管理"
                 ],
-                "summary": "获取 RoleBinding 详情",
+                "summary": "重新运行 Job",
                 "parameters": [
                     {
                         "type": "string",
@@ -2371,7 +2549,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Role 名称",
+                        "description": "Job 名称",
                         "name": "name",
                         "in": "query",
                         "required": true
@@ -2379,7 +2557,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "返回 RoleBinding 的详细信息",
+                        "description": "返回新创建的 Job",
                         "schema": {
                             "allOf": [
                                 {
@@ -2389,13 +2567,19 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.RoleBinding"
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Job"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "Job 尚未结束(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
                     "500": {
                         "description": "系统错误(code=30000)",
                         "schema": {
@@ -2403,9 +2587,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/job/resume": {
             "post": {
-                "description": "创建或更新 RoleBinding | ClusterRoleBinding 将用户与规则绑定",
+                "description": "suspend 暂停运行中的 Job 并终止其 Pod，resume 恢复运行；已结束的 Job 请使用重新运行",
                 "consumes": [
                     "application/json"
                 ],
@@ -2413,29 +2599,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC 管理"
+                    "Job 管理"
                 ],
-                "summary": "创建或更新 RoleBinding | ClusterRoleBinding",
+                "summary": "暂停或恢复 Job",
                 "parameters": [
                     {
-                        "description": "RoleBinding | ClusterRoleBinding 用户与规则绑定信息",
-                        "name": "roleBinding",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.RoleBinding"
-                        }
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Job 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "创建或更新 RB 成功",
+                        "description": "操作成功",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
                     },
                     "400": {
-                        "description": "参数错误(code=20001)",
+                        "description": "Job 已结束(code=20002)",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
@@ -2447,9 +2638,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "删除 RoleBinding | ClusterRoleBinding 规则与用户绑定信息",
+            }
+        },
+        "/api/job/suspend": {
+            "post": {
+                "description": "suspend 暂停运行中的 Job 并终止其 Pod，resume 恢复运行；已结束的 Job 请使用重新运行",
                 "consumes": [
                     "application/json"
                 ],
@@ -2457,9 +2650,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC 管理"
+                    "Job 管理"
                 ],
-                "summary": "删除 RoleBinding | ClusterRoleBinding",
+                "summary": "暂停或恢复 Job",
                 "parameters": [
                     {
                         "type": "string",
@@ -2470,7 +2663,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "RoleBinding 名称",
+                        "description": "Job 名称",
                         "name": "name",
                         "in": "query",
                         "required": true
@@ -2478,7 +2671,13 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "删除 RoleBinding 成功",
+                        "description": "操作成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Job 已结束(code=20002)",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
@@ -2492,9 +2691,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/rbac/rb/list": {
+        "/api/networkpolicy": {
             "get": {
-                "description": "获取所有 RoleBinding 列表",
+                "description": "获取指定命名空间下指定 NetworkPolicy 的配置",
                 "consumes": [
                     "application/json"
                 ],
@@ -2502,9 +2701,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC 管理"
+                    "NetworkPolicy 管理"
                 ],
-                "summary": "获取 RoleBinding 列表",
+                "summary": "获取 NetworkPolicy 详情",
                 "parameters": [
                     {
                         "type": "string",
@@ -2515,15 +2714,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "关键词",
-                        "name": "keyword",
+                        "description": "NetworkPolicy 名称",
+                        "name": "name",
                         "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "返回 Role 列表",
+                        "description": "返回 NetworkPolicy 的详细信息",
                         "schema": {
                             "allOf": [
                                 {
@@ -2533,10 +2732,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.RoleBinding"
-                                            }
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.NetworkPolicy"
                                         }
                                     }
                                 }
@@ -2550,11 +2746,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/rbac/role": {
-            "get": {
-                "description": "获取 Role 的详细信息",
+            },
+            "post": {
+                "description": "创建或更新 networking.k8s.io/v1 NetworkPolicy，支持入/出站规则、Pod/命名空间选择器、ipBlock 与端口(含端口范围与具名端口)",
                 "consumes": [
                     "application/json"
                 ],
@@ -2562,42 +2756,49 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC 管理"
+                    "NetworkPolicy 管理"
                 ],
-                "summary": "获取 Role 详情",
+                "summary": "创建或更新 NetworkPolicy",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "命名空间",
-                        "name": "namespace",
-                        "in": "query",
-                        "required": true
+                        "description": "NetworkPolicy 配置信息",
+                        "name": "networkPolicy",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.NetworkPolicy"
+                        }
                     },
                     {
-                        "type": "string",
-                        "description": "Role 名称",
-                        "name": "name",
-                        "in": "query",
-                        "required": true
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段",
+                        "name": "force",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "返回Role的详细信息",
+                        "description": "操作成功，返回成功消息",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Role"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误(code=20001)或验证错误(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
                     },
                     "500": {
@@ -2608,8 +2809,53 @@ const docTemplate = `{
                     }
                 }
             },
+            "delete": {
+                "description": "删除指定命名空间下的指定 NetworkPolicy",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "NetworkPolicy 管理"
+                ],
+                "summary": "删除 NetworkPolicy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "NetworkPolicy 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "删除成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/networkpolicy/analyze": {
             "post": {
-                "description": "创建或更新 Role | ClusterRole 作为用户访问规则",
+                "description": "综合源 Pod 所在命名空间的出站策略与目标 Pod 所在命名空间的入站策略，判断源 Pod 能否访问目标 Pod 的端口，并给出起决定作用的策略",
                 "consumes": [
                     "application/json"
                 ],
@@ -2617,29 +2863,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC 管理"
+                    "NetworkPolicy 管理"
                 ],
-                "summary": "创建或更新 Role | ClusterRole",
+                "summary": "网络可达性分析",
                 "parameters": [
                     {
-                        "description": "Role | ClusterRole 规则信息",
-                        "name": "role",
+                        "description": "源 Pod 与目标 Pod:端口",
+                        "name": "reachability",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Role"
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Reachability"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "创建或更新 Role 成功",
+                        "description": "返回是否放行以及出站、入站两个方向的判定",
                         "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Reachability"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "参数错误(code=20001)",
+                        "description": "参数错误(code=20001)或 Pod 不存在、端口无法解析(code=20002)",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
@@ -2651,9 +2909,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "删除 Role | ClusterRole 用户访问规则",
+            }
+        },
+        "/api/networkpolicy/list": {
+            "get": {
+                "description": "获取指定命名空间下的所有 NetworkPolicy",
                 "consumes": [
                     "application/json"
                 ],
@@ -2661,9 +2921,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC 管理"
+                    "NetworkPolicy 管理"
                 ],
-                "summary": "删除 Role | ClusterRole",
+                "summary": "获取 NetworkPolicy 列表",
                 "parameters": [
                     {
                         "type": "string",
@@ -2674,17 +2934,31 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Role 名称",
-                        "name": "name",
-                        "in": "query",
-                        "required": true
+                        "description": "关键词",
+                        "name": "keyword",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "删除 Role 成功",
+                        "description": "返回 NetworkPolicy 列表",
                         "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.NetworkPolicy"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "500": {
@@ -2696,9 +2970,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/rbac/role/list": {
+        "/api/node": {
             "get": {
-                "description": "获取所有 Role 列表",
+                "description": "获取集群中单个 Node 信息",
                 "consumes": [
                     "application/json"
                 ],
@@ -2706,28 +2980,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC 管理"
+                    "Node管理"
                 ],
-                "summary": "获取 Role 列表",
+                "summary": "获取 Node 详情",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "命名空间",
-                        "name": "namespace",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "关键词",
-                        "name": "keyword",
+                        "description": "node name",
+                        "name": "name",
                         "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "返回 Role 列表",
+                        "description": "获取成功",
                         "schema": {
                             "allOf": [
                                 {
@@ -2737,10 +3004,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Role"
-                                            }
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.NodeDetail"
                                         }
                                     }
                                 }
@@ -2756,9 +3020,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/rbac/sa": {
+        "/api/node/drain-check": {
             "get": {
-                "description": "获取所有 ServiceAccount 列表",
+                "description": "检查驱逐节点上的所有 Pod(与 kubectl drain 一样跳过 DaemonSet 与静态 Pod)是否会违反 PodDisruptionBudget",
                 "consumes": [
                     "application/json"
                 ],
@@ -2766,28 +3030,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC 管理"
+                    "Node管理"
                 ],
-                "summary": "获取 ServiceAccount 列表",
+                "summary": "Node 驱逐检查",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "命名空间",
-                        "name": "namespace",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "关键词",
-                        "name": "keyword",
+                        "description": "Node 名称",
+                        "name": "node",
                         "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "返回 ServiceAccount 列表",
+                        "description": "返回会被违反的 PDB，为空表示可以安全驱逐",
                         "schema": {
                             "allOf": [
                                 {
@@ -2799,7 +3056,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.ServiceAccount"
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.PDBViolation"
                                             }
                                         }
                                     }
@@ -2814,9 +3071,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "创建一个 ServiceAccount 以进行用户认证",
+            }
+        },
+        "/api/node/label": {
+            "put": {
+                "description": "为单个 Node 添加 label",
                 "consumes": [
                     "application/json"
                 ],
@@ -2824,29 +3083,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC 管理"
+                    "Node管理"
                 ],
-                "summary": "创建 ServiceAccount",
+                "summary": "Node 添加标签",
                 "parameters": [
                     {
-                        "description": "ServiceAccount 信息",
-                        "name": "serviceAccount",
+                        "description": "node name and labels",
+                        "name": "node",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ServiceAccount"
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.UpdateLabelReq"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "创建 SA 成功",
+                        "description": "更新成功",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
                     },
-                    "400": {
-                        "description": "参数错误(code=20001)",
+                    "409": {
+                        "description": "资源在读取后已被修改或删除(code=30002)，data 为当前对象，可合并或重新加载",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
@@ -2858,9 +3123,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "删除 ServiceAccount 用户访问集群账号",
+            }
+        },
+        "/api/node/list": {
+            "get": {
+                "description": "获取集群中所有 Node 信息",
                 "consumes": [
                     "application/json"
                 ],
@@ -2868,30 +3135,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "RBAC 管理"
+                    "Node管理"
                 ],
-                "summary": "删除 ServiceAccount",
+                "summary": "获取 Node 列表",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "命名空间",
-                        "name": "namespace",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "ServiceAccount 名称",
-                        "name": "name",
+                        "description": "node 关键词",
+                        "name": "keyword",
                         "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "删除 Role 成功",
+                        "description": "获取成功",
                         "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.NodeListItem"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "500": {
@@ -2903,9 +3178,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/secret": {
+        "/api/node/pods": {
             "get": {
-                "description": "获取指定命名空间下指定Secret的详细信息",
+                "description": "集群中某个节点下的所有 Pod",
                 "consumes": [
                     "application/json"
                 ],
@@ -2913,9 +3188,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Secret管理"
+                    "Node管理"
                 ],
-                "summary": "获取Secret详情",
+                "summary": "Node 下的所有 Pod",
                 "parameters": [
                     {
                         "type": "string",
@@ -2926,15 +3201,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Secret名称",
-                        "name": "name",
+                        "description": "Node 名称",
+                        "name": "node",
                         "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "返回Secret的详细信息",
+                        "description": "查询成功",
                         "schema": {
                             "allOf": [
                                 {
@@ -2944,7 +3219,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.SecretDetail"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.PodListItem"
+                                            }
                                         }
                                     }
                                 }
@@ -2958,9 +3236,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "description": "创建新的 Secret 或更新已存在的 Secret",
+            }
+        },
+        "/api/node/taint": {
+            "put": {
+                "description": "集群中某个节点的污点更新",
                 "consumes": [
                     "application/json"
                 ],
@@ -2968,128 +3248,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Secret管理"
+                    "Node管理"
                 ],
-                "summary": "创建或更新 Secret",
+                "summary": "Node 污点更新",
                 "parameters": [
                     {
-                        "description": "Secret 配置信息",
-                        "name": "pod",
+                        "description": "node name and taints",
+                        "name": "node",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Secret"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "操作成功，返回成功消息",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
-                        }
-                    },
-                    "400": {
-                        "description": "参数错误(code=20001)",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.UpdateTaintReq"
                         }
                     },
-                    "500": {
-                        "description": "系统错误(code=30000)",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
-                        }
-                    }
-                }
-            },
-            "delete": {
-                "description": "删除指定命名空间下的指定Secret",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Secret管理"
-                ],
-                "summary": "删除Secret",
-                "parameters": [
                     {
-                        "type": "string",
-                        "description": "命名空间",
-                        "name": "namespace",
-                        "in": "query",
-                        "required": true
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Secret名称",
-                        "name": "name",
-                        "in": "query",
-                        "required": true
+                        "type": "boolean",
+                        "description": "为 true 时即使新增的 NoExecute 污点驱逐 Pod 会违反 PodDisruptionBudget 也更新",
+                        "name": "force",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "删除成功",
+                        "description": "更新成功",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
                     },
-                    "500": {
-                        "description": "系统错误(code=30000)",
+                    "409": {
+                        "description": "资源在读取后已被修改或删除(code=30002)，data 为当前对象，可合并或重新加载；或新增 NoExecute 污点驱逐的 Pod 会违反 PodDisruptionBudget(code=30004)，data 为被违反的 PDB",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
-                    }
-                }
-            }
-        },
-        "/api/secret/list": {
-            "get": {
-                "description": "获取指定命名空间下的所有Secret列表",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Secret管理"
-                ],
-                "summary": "获取Secret列表",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "命名空间",
-                        "name": "namespace",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "返回Secret列表",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Secret"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
-                        }
                     },
                     "500": {
                         "description": "系统错误(code=30000)",
@@ -3100,9 +3296,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/service": {
+        "/api/pdb": {
             "get": {
-                "description": "获取指定命名空间下指定Service的详细信息",
+                "description": "获取指定命名空间下指定 PDB 的配置",
                 "consumes": [
                     "application/json"
                 ],
@@ -3110,9 +3306,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Service 管理"
+                    "PDB 管理"
                 ],
-                "summary": "获取Service详情",
+                "summary": "获取 PodDisruptionBudget 详情",
                 "parameters": [
                     {
                         "type": "string",
@@ -3123,7 +3319,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Service 名称",
+                        "description": "PDB 名称",
                         "name": "name",
                         "in": "query",
                         "required": true
@@ -3131,7 +3327,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "返回Service的详细信息",
+                        "description": "返回 PDB 的详细信息",
                         "schema": {
                             "allOf": [
                                 {
@@ -3141,7 +3337,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Service"
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.PDB"
                                         }
                                     }
                                 }
@@ -3157,7 +3353,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "创建新的 Service 或更新已存在的 Service",
+                "description": "创建或更新 PDB，minAvailable 与 maxUnavailable 二选一，可为数字或百分比；selector 支持 matchLabels 与 matchExpressions，不能为空",
                 "consumes": [
                     "application/json"
                 ],
@@ -3165,18 +3361,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Service 管理"
+                    "PDB 管理"
                 ],
-                "summary": "创建或更新 Service",
+                "summary": "创建或更新 PodDisruptionBudget",
                 "parameters": [
                     {
-                        "description": "Service 配置信息",
-                        "name": "pod",
+                        "description": "PDB 配置信息",
+                        "name": "pdb",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Service"
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.PDB"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段",
+                        "name": "force",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -3187,7 +3395,13 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "参数错误(code=20001)",
+                        "description": "参数错误(code=20001)或验证错误(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
@@ -3201,7 +3415,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "删除一个 Service",
+                "description": "删除指定命名空间下的指定 PDB",
                 "consumes": [
                     "application/json"
                 ],
@@ -3209,28 +3423,28 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Service 管理"
+                    "PDB 管理"
                 ],
-                "summary": "删除 Service",
+                "summary": "删除 PodDisruptionBudget",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Service 名称",
-                        "name": "name",
+                        "description": "命名空间",
+                        "name": "namespace",
                         "in": "query",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "命名空间",
-                        "name": "namespace",
+                        "description": "PDB 名称",
+                        "name": "name",
                         "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "删除 Service 成功",
+                        "description": "删除成功",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
@@ -3244,9 +3458,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/service/list": {
+        "/api/pdb/list": {
             "get": {
-                "description": "获取指定命名空间下指定Service的列表",
+                "description": "获取指定命名空间下的所有 PDB，包含 currentHealthy、desiredHealthy 与 disruptionsAllowed 状态",
                 "consumes": [
                     "application/json"
                 ],
@@ -3254,9 +3468,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Service 管理"
+                    "PDB 管理"
                 ],
-                "summary": "获取Service列表",
+                "summary": "获取 PodDisruptionBudget 列表",
                 "parameters": [
                     {
                         "type": "string",
@@ -3269,13 +3483,12 @@ const docTemplate = `{
                         "type": "string",
                         "description": "关键词",
                         "name": "keyword",
-                        "in": "query",
-                        "required": true
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "返回Service的列表",
+                        "description": "返回 PDB 列表",
                         "schema": {
                             "allOf": [
                                 {
@@ -3287,7 +3500,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Service"
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.PDB"
                                             }
                                         }
                                     }
@@ -3304,9 +3517,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/statefulset": {
+        "/api/pod": {
             "get": {
-                "description": "获取指定命名空间下指定StatefulSet的详细信息",
+                "description": "获取指定命名空间下指定Pod的详细信息",
                 "consumes": [
                     "application/json"
                 ],
@@ -3314,9 +3527,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "StatefulSet 管理"
+                    "Pod管理"
                 ],
-                "summary": "获取StatefulSet详情",
+                "summary": "获取Pod详情",
                 "parameters": [
                     {
                         "type": "string",
@@ -3327,7 +3540,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "StatefulSet 名称",
+                        "description": "Pod名称",
                         "name": "name",
                         "in": "query",
                         "required": true
@@ -3335,7 +3548,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "返回StatefulSet的详细信息",
+                        "description": "返回Pod的详细信息，包含基础信息、卷配置、网络配置、初始化容器和主容器配置",
                         "schema": {
                             "allOf": [
                                 {
@@ -3345,7 +3558,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.StatefulSet"
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Pod"
                                         }
                                     }
                                 }
@@ -3361,7 +3574,7 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "description": "创建新的 StatefulSet 或更新已存在的 StatefulSet",
+                "description": "创建新的Pod或更新已存在的Pod",
                 "consumes": [
                     "application/json"
                 ],
@@ -3369,18 +3582,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "StatefulSet 管理"
+                    "Pod管理"
                 ],
-                "summary": "创建或更新 StatefulSet",
+                "summary": "创建或更新Pod",
                 "parameters": [
                     {
-                        "description": "StatefulSet 配置信息",
+                        "description": "Pod配置信息",
                         "name": "pod",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.StatefulSet"
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Pod"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -3391,7 +3610,13 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "参数错误(code=20001)",
+                        "description": "参数错误(code=20001)或验证错误(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "资源在读取后已被修改或删除(code=30002)，data 为当前对象，可合并或重新加载",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
@@ -3405,7 +3630,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "删除指定命名空间下的指定 StatefulSet",
+                "description": "删除指定命名空间下的指定Pod",
                 "consumes": [
                     "application/json"
                 ],
@@ -3413,9 +3638,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "StatefulSet 管理"
+                    "Pod管理"
                 ],
-                "summary": "删除 StatefulSet",
+                "summary": "删除Pod",
                 "parameters": [
                     {
                         "type": "string",
@@ -3426,10 +3651,16 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "StatefulSet 名称",
+                        "description": "Pod名称",
                         "name": "name",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时即使违反 PodDisruptionBudget 也删除",
+                        "name": "force",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -3439,6 +3670,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
                     },
+                    "409": {
+                        "description": "删除会违反 PodDisruptionBudget(code=30004)，data 为被违反的 PDB",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
                     "500": {
                         "description": "系统错误(code=30000)",
                         "schema": {
@@ -3448,19 +3685,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/statefulset/list": {
+        "/api/pod/file/download": {
             "get": {
-                "description": "获取指定命名空间下的所有StatefulSet列表",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "通过 exec 执行 tar 将容器内的文件或目录打包下载，支持 tar、zip，普通文件还可以 raw 原样下载，大小超过配置上限时拒绝",
                 "produces": [
-                    "application/json"
+                    "application/octet-stream"
                 ],
                 "tags": [
-                    "StatefulSet 管理"
+                    "Pod管理"
                 ],
-                "summary": "获取StatefulSet列表",
+                "summary": "从容器下载文件或目录",
                 "parameters": [
                     {
                         "type": "string",
@@ -3471,32 +3705,48 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "关键词",
-                        "name": "keyword",
+                        "description": "Pod名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "容器名称，Pod 只有一个容器时可省略",
+                        "name": "container",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "容器内的绝对路径",
+                        "name": "path",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "打包格式: tar(默认) | zip | raw",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "返回StatefulSet列表",
+                        "description": "文件内容",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.StatefulSet"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "路径非法、不存在或格式不支持(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "413": {
+                        "description": "文件超过下载大小上限(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
                     },
                     "500": {
@@ -3508,23 +3758,49 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/storage": {
+        "/api/pod/file/list": {
             "get": {
-                "description": "获取所有 StorageClass 存储类信息",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "通过 exec 在容器内列出目录下的文件，目录排在前面",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "PVC 管理"
+                    "Pod管理"
                 ],
-                "summary": "获取 StorageClass 列表",
-                "responses": {
-                    "200": {
-                        "description": "获取成功",
-                        "schema": {
+                "summary": "浏览容器目录",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pod名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "容器名称，Pod 只有一个容器时可省略",
+                        "name": "container",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "容器内的绝对路径",
+                        "name": "path",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "返回目录下的文件列表",
+                        "schema": {
                             "allOf": [
                                 {
                                     "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
@@ -3535,7 +3811,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.StorageClass"
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.ContainerFile"
                                             }
                                         }
                                     }
@@ -3543,6 +3819,12 @@ const docTemplate = `{
                             ]
                         }
                     },
+                    "400": {
+                        "description": "路径非法或不存在(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
                     "500": {
                         "description": "系统错误(code=30000)",
                         "schema": {
@@ -3550,39 +3832,72 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/pod/file/upload": {
             "post": {
-                "description": "创建一个 StorageClas 存储类",
+                "description": "以 multipart 表单上传一个或多个文件，通过 exec 执行 tar 解包到容器目录，目录不存在时自动创建",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "StorageClass 管理"
+                    "Pod管理"
                 ],
-                "summary": "创建 StorageClass",
+                "summary": "上传文件到容器",
                 "parameters": [
                     {
-                        "description": "StorageClass 信息",
-                        "name": "pod",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.StorageClass"
-                        }
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pod名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "容器名称，Pod 只有一个容器时可省略",
+                        "name": "container",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "容器内的目标目录(绝对路径)",
+                        "name": "path",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "要上传的文件，可多个",
+                        "name": "files",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "创建 StorageClass 成功",
+                        "description": "操作成功，返回成功消息",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
                     },
                     "400": {
-                        "description": "参数错误(code=20001或20002)",
+                        "description": "参数错误(code=20001)或路径、文件名非法(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "413": {
+                        "description": "文件超过上传大小上限(code=20002)",
                         "schema": {
                             "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
                         }
@@ -3594,9 +3909,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "删除一个 StorageClass 存储类",
+            }
+        },
+        "/api/pod/list": {
+            "get": {
+                "description": "获取指定命名空间下的所有Pod列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -3604,23 +3921,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "StorageClass 管理"
+                    "Pod管理"
                 ],
-                "summary": "删除 StorageClass",
+                "summary": "获取Pod列表",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "StorageClass 名称",
-                        "name": "name",
+                        "description": "命名空间",
+                        "name": "namespace",
                         "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "删除 StorageClass 成功",
+                        "description": "返回Pod列表，每个Pod包含名称、就绪状态、运行状态、重启次数、运行时长、IP和所在节点",
                         "schema": {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.PodListItem"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "500": {
@@ -3631,236 +3963,6273 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.Base": {
-            "type": "object",
-            "properties": {
-                "labels": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+        },
+        "/api/pod/namespace": {
+            "get": {
+                "description": "获取所有可用的命名空间列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pod管理"
+                ],
+                "summary": "获取命名空间列表",
+                "responses": {
+                    "200": {
+                        "description": "返回命名空间列表，每个命名空间包含名称、创建时间和状态",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Namespace"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
                     }
-                },
-                "name": {
-                    "type": "string"
-                },
-                "namespace": {
-                    "type": "string"
-                },
-                "restartPolicy": {
-                    "description": "reboot strategy: Always | Never | On-Failure",
-                    "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.ConfigMap": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+        "/api/pod/resources/preview": {
+            "post": {
+                "description": "按 API Server 的默认规则及命名空间内 LimitRange 的默认值计算各容器最终的 requests 和 limits，返回 Pod 的 QoS 等级及 LimitRange 将拒绝的越界项，不会创建 Pod",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pod管理"
+                ],
+                "summary": "预览Pod资源配额",
+                "parameters": [
+                    {
+                        "description": "Pod配置信息，需填写命名空间",
+                        "name": "pod",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Pod"
+                        }
                     }
-                },
-                "labels": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                ],
+                "responses": {
+                    "200": {
+                        "description": "返回 QoS 等级、各容器生效的资源及其来源、越界项",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.PodResourcePreview"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误(code=20001)或验证错误(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
                     }
-                },
-                "name": {
-                    "type": "string"
-                },
-                "namespace": {
-                    "type": "string"
-                }
-            }
-        },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.ConfigMapRefVolume": {
-            "type": "object",
-            "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "optional": {
-                    "type": "boolean"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.Container": {
-            "type": "object",
-            "properties": {
-                "args": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "command": {
+        "/api/pod/search": {
+            "post": {
+                "description": "搜索指定命名空间下的指定Pod",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Pod管理"
+                ],
+                "summary": "搜索Pod",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pod名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "搜索成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.PodListItem"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/proxy/pod/{namespace}/{name}/{port}/{path}": {
+            "get": {
+                "description": "使用 pods/portforward 子资源建立隧道，将 path 之后的请求反向代理到 Pod 端口",
+                "tags": [
+                    "PortForward 管理"
+                ],
+                "summary": "通过 port-forward 代理 Pod 端口的 HTTP 请求",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pod 名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "端口号或容器端口名",
+                        "name": "port",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "转发到 Pod 的请求路径",
+                        "name": "path",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pod 端口的原始响应"
+                    },
+                    "400": {
+                        "description": "目标端口不存在或 Pod 未运行(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "port-forward 会话数达到上限(code=30001)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "502": {
+                        "description": "转发失败(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "使用 pods/portforward 子资源建立隧道，将 path 之后的请求反向代理到 Pod 端口",
+                "tags": [
+                    "PortForward 管理"
+                ],
+                "summary": "通过 port-forward 代理 Pod 端口的 HTTP 请求",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pod 名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "端口号或容器端口名",
+                        "name": "port",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "转发到 Pod 的请求路径",
+                        "name": "path",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pod 端口的原始响应"
+                    },
+                    "400": {
+                        "description": "目标端口不存在或 Pod 未运行(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "port-forward 会话数达到上限(code=30001)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "502": {
+                        "description": "转发失败(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/proxy/service/{namespace}/{name}/{port}/{path}": {
+            "get": {
+                "description": "将 Service 端口解析到一个就绪的后端 Pod，再通过 pods/portforward 反向代理请求",
+                "tags": [
+                    "PortForward 管理"
+                ],
+                "summary": "通过 port-forward 代理 Service 端口的 HTTP 请求",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Service 名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Service 端口号或端口名",
+                        "name": "port",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "转发到 Pod 的请求路径",
+                        "name": "path",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "后端 Pod 的原始响应"
+                    },
+                    "400": {
+                        "description": "端口不存在或没有就绪的后端(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "port-forward 会话数达到上限(code=30001)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "502": {
+                        "description": "转发失败(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "将 Service 端口解析到一个就绪的后端 Pod，再通过 pods/portforward 反向代理请求",
+                "tags": [
+                    "PortForward 管理"
+                ],
+                "summary": "通过 port-forward 代理 Service 端口的 HTTP 请求",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Service 名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Service 端口号或端口名",
+                        "name": "port",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "转发到 Pod 的请求路径",
+                        "name": "path",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "后端 Pod 的原始响应"
+                    },
+                    "400": {
+                        "description": "端口不存在或没有就绪的后端(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "port-forward 会话数达到上限(code=30001)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "502": {
+                        "description": "转发失败(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/pv": {
+            "get": {
+                "description": "获取所有 PersistentVolume 存储空间的信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PV 管理"
+                ],
+                "summary": "获取 PV 列表",
+                "responses": {
+                    "200": {
+                        "description": "获取成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.PersistentVolumeItem"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "创建一个 PersistentVolume 存储空间，支持 nfs、hostPath、local(需指定节点)、csi、iscsi 卷，可通过 claimRef 预绑定到指定 PVC",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PV 管理"
+                ],
+                "summary": "创建 PV",
+                "parameters": [
+                    {
+                        "description": "PV 信息",
+                        "name": "pod",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.PersistentVolume"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "创建 PV 成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误(code=20001)或验证错误(code=20002)，如不支持的卷类型",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "删除一个 PersistentVolume 存储空间",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PV 管理"
+                ],
+                "summary": "删除 PV",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "PV 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "删除 PV 成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/pvc": {
+            "get": {
+                "description": "获取所有 PersistentVolumeClaim 存储声明信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PVC 管理"
+                ],
+                "summary": "获取 PVC 列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "关键词",
+                        "name": "keyword",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "获取成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.PersistentVolumeClaim"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "创建一个 PersistentVolumeClaim 声明用户的存储需求",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PVC 管理"
+                ],
+                "summary": "创建 PVC",
+                "parameters": [
+                    {
+                        "description": "PVC 信息",
+                        "name": "pod",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.PersistentVolumeClaim"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "创建 PVC 成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误(code=20001)或验证错误(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "删除一个 PersistentVolumeClaim 存储声明",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PVC 管理"
+                ],
+                "summary": "删除 PVC",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "PVC 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "删除 PVC 成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/pvc/detail": {
+            "get": {
+                "description": "获取 PVC 的阶段、条件、StorageClass、申请容量与实际容量、绑定的 PV 以及挂载它的 Pod",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PVC 管理"
+                ],
+                "summary": "获取 PVC 详情",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "PVC 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "返回 PVC 详情",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.PersistentVolumeClaimDetail"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/pvc/diagnose": {
+            "get": {
+                "description": "解释 PVC 处于 Pending 的原因：没有匹配的 PV(并列出同类 PV 不匹配的原因)、WaitForFirstConsumer 等待 Pod 调度、StorageClass 不存在、供应器(provisioner)缺失或供应失败，附带 PVC 的最近事件",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PVC 管理"
+                ],
+                "summary": "诊断 PVC 绑定",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "PVC 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "返回诊断结果",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.PVCDiagnosis"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/pvc/expand": {
+            "post": {
+                "description": "调大已绑定 PVC 的申请容量，要求其 StorageClass 开启 allowVolumeExpansion；扩容进度见详情中的 resizing 与条件",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "PVC 管理"
+                ],
+                "summary": "在线扩容 PVC",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "PVC 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "新的容量，如 20Gi，需大于当前容量",
+                        "name": "capacity",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "操作成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "无法扩容(code=20002)，如未绑定、容量未增大或 StorageClass 不允许扩容",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/rbac/rb": {
+            "get": {
+                "description": "获取 RoleBinding 的详细信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC 管理"
+                ],
+                "summary": "获取 RoleBinding 详情",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Role 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "返回 RoleBinding 的详细信息",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.RoleBinding"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "创建或更新 RoleBinding | ClusterRoleBinding 将用户与规则绑定",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC 管理"
+                ],
+                "summary": "创建或更新 RoleBinding | ClusterRoleBinding",
+                "parameters": [
+                    {
+                        "description": "RoleBinding | ClusterRoleBinding 用户与规则绑定信息",
+                        "name": "roleBinding",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.RoleBinding"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "创建或更新 RB 成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误(code=20001)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "删除 RoleBinding | ClusterRoleBinding 规则与用户绑定信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC 管理"
+                ],
+                "summary": "删除 RoleBinding | ClusterRoleBinding",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "RoleBinding 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "删除 RoleBinding 成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/rbac/rb/list": {
+            "get": {
+                "description": "获取所有 RoleBinding 列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC 管理"
+                ],
+                "summary": "获取 RoleBinding 列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "关键词",
+                        "name": "keyword",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "返回 Role 列表",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.RoleBinding"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/rbac/role": {
+            "get": {
+                "description": "获取 Role 的详细信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC 管理"
+                ],
+                "summary": "获取 Role 详情",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Role 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "返回Role的详细信息",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Role"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "创建或更新 Role | ClusterRole 作为用户访问规则",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC 管理"
+                ],
+                "summary": "创建或更新 Role | ClusterRole",
+                "parameters": [
+                    {
+                        "description": "Role | ClusterRole 规则信息",
+                        "name": "role",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Role"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "创建或更新 Role 成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误(code=20001)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "删除 Role | ClusterRole 用户访问规则",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC 管理"
+                ],
+                "summary": "删除 Role | ClusterRole",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Role 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "删除 Role 成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/rbac/role/list": {
+            "get": {
+                "description": "获取所有 Role 列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC 管理"
+                ],
+                "summary": "获取 Role 列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "关键词",
+                        "name": "keyword",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "返回 Role 列表",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Role"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/rbac/sa": {
+            "get": {
+                "description": "获取所有 ServiceAccount 列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC 管理"
+                ],
+                "summary": "获取 ServiceAccount 列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "关键词",
+                        "name": "keyword",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "返回 ServiceAccount 列表",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.ServiceAccount"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "创建一个 ServiceAccount 以进行用户认证",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC 管理"
+                ],
+                "summary": "创建 ServiceAccount",
+                "parameters": [
+                    {
+                        "description": "ServiceAccount 信息",
+                        "name": "serviceAccount",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ServiceAccount"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "创建 SA 成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误(code=20001)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "删除 ServiceAccount 用户访问集群账号",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "RBAC 管理"
+                ],
+                "summary": "删除 ServiceAccount",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ServiceAccount 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "删除 Role 成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/secret": {
+            "get": {
+                "description": "获取指定命名空间下指定Secret的详细信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Secret管理"
+                ],
+                "summary": "获取Secret详情",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Secret名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "返回Secret的详细信息，值已掩码，明文请调用 reveal 接口；usedBy 为引用它的工作负载和 Pod",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.SecretDetail"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "创建新的 Secret 或更新已存在的 Secret，data 中传明文值；更新时值为掩码 ****** 的 key 保留原值；restartConsumers 为 true 时保存后滚动重启使用它的工作负载",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Secret管理"
+                ],
+                "summary": "创建或更新 Secret",
+                "parameters": [
+                    {
+                        "description": "Secret 配置信息",
+                        "name": "pod",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Secret"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "操作成功；开启重启时返回已重启的工作负载",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Consumer"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误(code=20001)或验证错误(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "删除指定命名空间下的指定Secret",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Secret管理"
+                ],
+                "summary": "删除Secret",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Secret名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "删除成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/secret/basic-auth": {
+            "post": {
+                "description": "生成 kubernetes.io/basic-auth 类型的 Secret，用户名和密码至少填写一个",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Secret管理"
+                ],
+                "summary": "创建或更新 basic-auth Secret",
+                "parameters": [
+                    {
+                        "description": "用户名和密码",
+                        "name": "secret",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.BasicAuthSecret"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "操作成功，返回成功消息",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误(code=20001)或验证错误(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/secret/dockerconfigjson": {
+            "post": {
+                "description": "根据仓库地址、用户名和密码生成 kubernetes.io/dockerconfigjson 类型的 Secret",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Secret管理"
+                ],
+                "summary": "创建或更新镜像仓库 Secret",
+                "parameters": [
+                    {
+                        "description": "镜像仓库认证信息",
+                        "name": "secret",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.DockerConfigSecret"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "操作成功，返回成功消息",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误(code=20001)或验证错误(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/secret/list": {
+            "get": {
+                "description": "获取指定命名空间下的所有Secret列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Secret管理"
+                ],
+                "summary": "获取Secret列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "返回Secret列表",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Secret"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/secret/reveal": {
+            "post": {
+                "description": "返回指定 key 的解码值(不指定则返回全部)，必须填写原因；每次查看都会记录审计日志并在 Secret 上生成 SecretRevealed 事件",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Secret管理"
+                ],
+                "summary": "查看 Secret 明文",
+                "parameters": [
+                    {
+                        "description": "要查看的 Secret、key 以及原因",
+                        "name": "reveal",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.SecretReveal"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "返回明文值，二进制值以 base64 返回",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.SecretItem"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误(code=20001)或验证错误(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/secret/ssh-auth": {
+            "post": {
+                "description": "使用 PEM 格式私钥生成 kubernetes.io/ssh-auth 类型的 Secret，可选附带 known_hosts",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Secret管理"
+                ],
+                "summary": "创建或更新 ssh-auth Secret",
+                "parameters": [
+                    {
+                        "description": "SSH 私钥",
+                        "name": "secret",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.SSHAuthSecret"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "操作成功，返回成功消息",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误(code=20001)或验证错误(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/secret/tls": {
+            "post": {
+                "description": "上传 PEM 格式的证书和私钥，校验证书可解析、未过期且与私钥匹配后生成 kubernetes.io/tls 类型的 Secret",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Secret管理"
+                ],
+                "summary": "创建或更新 TLS Secret",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Secret 名称",
+                        "name": "name",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "修改所基于的资源版本，为空时不做冲突检查",
+                        "name": "resourceVersion",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "标签，格式为 key=value，可重复传多个",
+                        "name": "labels",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "PEM 格式证书(链)",
+                        "name": "cert",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "PEM 格式私钥",
+                        "name": "key",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "操作成功，返回成功消息",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误(code=20001)或验证错误(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "413": {
+                        "description": "证书或私钥文件超过 1MiB 大小上限(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/service": {
+            "get": {
+                "description": "获取指定命名空间下指定Service的详细信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service 管理"
+                ],
+                "summary": "获取Service详情",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Service 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "返回Service的详细信息",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Service"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "创建新的 Service 或更新已存在的 Service",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service 管理"
+                ],
+                "summary": "创建或更新 Service",
+                "parameters": [
+                    {
+                        "description": "Service 配置信息",
+                        "name": "pod",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Service"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "操作成功，返回成功消息",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误(code=20001)或验证错误(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "删除一个 Service",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service 管理"
+                ],
+                "summary": "删除 Service",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Service 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "删除 Service 成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/service/list": {
+            "get": {
+                "description": "获取指定命名空间下指定Service的列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service 管理"
+                ],
+                "summary": "获取Service列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "关键词",
+                        "name": "keyword",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "返回Service的列表",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Service"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/service/topology": {
+            "get": {
+                "description": "解析 Service 的 EndpointSlice、后端 Pod 以及路由到该 Service 的 Ingress/IngressRoute，并标记选择器无匹配 Pod、targetPort 不存在等问题",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Service 管理"
+                ],
+                "summary": "获取Service拓扑",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Service 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "返回Service的拓扑信息",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.ServiceTopology"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/snapshot": {
+            "post": {
+                "description": "为已绑定的 CSI PVC 创建 VolumeSnapshot，未指定快照类时使用该驱动的默认 VolumeSnapshotClass",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "VolumeSnapshot 管理"
+                ],
+                "summary": "创建卷快照",
+                "parameters": [
+                    {
+                        "description": "快照信息",
+                        "name": "snapshot",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.VolumeSnapshot"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "创建成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误(code=20001)或验证错误(code=20002)，如未安装快照 CRD、PVC 未绑定或没有可用的快照类",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "删除 VolumeSnapshot，存储系统中的快照是否一并删除取决于快照类的 deletionPolicy",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "VolumeSnapshot 管理"
+                ],
+                "summary": "删除卷快照",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "快照名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "删除成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/snapshot/class": {
+            "post": {
+                "description": "创建 VolumeSnapshotClass，可设为对应 CSI 驱动的默认快照类",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "VolumeSnapshot 管理"
+                ],
+                "summary": "创建快照类",
+                "parameters": [
+                    {
+                        "description": "快照类信息",
+                        "name": "class",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.VolumeSnapshotClass"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "创建成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误(code=20001)或验证错误(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "删除 VolumeSnapshotClass",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "VolumeSnapshot 管理"
+                ],
+                "summary": "删除快照类",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "快照类名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "删除成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/snapshot/class/list": {
+            "get": {
+                "description": "获取所有 VolumeSnapshotClass；未安装快照 CRD 时返回空",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "VolumeSnapshot 管理"
+                ],
+                "summary": "获取快照类列表",
+                "responses": {
+                    "200": {
+                        "description": "返回快照类列表",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.VolumeSnapshotClass"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/snapshot/list": {
+            "get": {
+                "description": "获取命名空间下的 VolumeSnapshot，包含源 PVC、是否可用(readyToUse)与恢复所需的最小容量(restoreSize)；未安装快照 CRD 时返回空",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "VolumeSnapshot 管理"
+                ],
+                "summary": "获取卷快照列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间，为空时查询所有命名空间",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "关键词",
+                        "name": "keyword",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "返回快照列表",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.VolumeSnapshot"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/snapshot/restore": {
+            "post": {
+                "description": "以 VolumeSnapshot 为数据源(dataSource)新建 PVC，快照需已可用；未指定的 StorageClass 与访问模式沿用源 PVC，容量默认取快照的 restoreSize",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "VolumeSnapshot 管理"
+                ],
+                "summary": "从快照恢复 PVC",
+                "parameters": [
+                    {
+                        "description": "恢复信息",
+                        "name": "restore",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.VolumeSnapshotRestore"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "恢复成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误(code=20001)或验证错误(code=20002)，如快照未就绪、容量小于 restoreSize 或 StorageClass 的供应器与快照驱动不一致",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/snapshot/supported": {
+            "get": {
+                "description": "通过服务发现检查集群是否安装了 snapshot.storage.k8s.io/v1 的 CRD，未安装时前端隐藏快照功能",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "VolumeSnapshot 管理"
+                ],
+                "summary": "是否支持卷快照",
+                "responses": {
+                    "200": {
+                        "description": "返回是否支持",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "boolean"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/statefulset": {
+            "get": {
+                "description": "获取指定命名空间下指定StatefulSet的详细信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StatefulSet 管理"
+                ],
+                "summary": "获取StatefulSet详情",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "StatefulSet 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "返回StatefulSet的详细信息，autoscaler 为管理其副本数的 HPA",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.StatefulSet"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "创建新的 StatefulSet 或更新已存在的 StatefulSet",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StatefulSet 管理"
+                ],
+                "summary": "创建或更新 StatefulSet",
+                "parameters": [
+                    {
+                        "description": "StatefulSet 配置信息",
+                        "name": "pod",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.StatefulSet"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "操作成功；副本数由生效中的 HPA 管理时 msg 为覆盖提示，预演时该提示位于 data.warnings",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误(code=20001)或验证错误(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "删除指定命名空间下的指定 StatefulSet",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StatefulSet 管理"
+                ],
+                "summary": "删除 StatefulSet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "StatefulSet 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "删除成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/statefulset/list": {
+            "get": {
+                "description": "获取指定命名空间下的所有StatefulSet列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StatefulSet 管理"
+                ],
+                "summary": "获取StatefulSet列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "关键词",
+                        "name": "keyword",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "返回StatefulSet列表",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.StatefulSet"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/statefulset/pods": {
+            "get": {
+                "description": "按序号列出 Pod 的阶段、就绪状态与所属版本，用于观察分区(partition)滚动更新的进度；尚未创建的序号标记为 Missing",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StatefulSet 管理"
+                ],
+                "summary": "获取 StatefulSet 各序号 Pod 状态",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "StatefulSet 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "返回当前/更新版本与各序号 Pod 状态",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.StatefulSetRollout"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/statefulset/pvc": {
+            "get": {
+                "description": "列出由 volumeClaimTemplates 创建的 PVC(名称为 \u003c模板名\u003e-\u003cStatefulSet名\u003e-\u003c序号\u003e)，缩容后序号超出副本数且未被 Pod 使用的标记为孤立",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StatefulSet 管理"
+                ],
+                "summary": "获取 StatefulSet 的 PVC",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "StatefulSet 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "返回 PVC 列表",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.StatefulSetPVC"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "删除缩容后遗留、序号超出副本数且未被任何 Pod 使用的 PVC，数据将随 PV 回收策略一同释放",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StatefulSet 管理"
+                ],
+                "summary": "清理 StatefulSet 孤立的 PVC",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "StatefulSet 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "返回已删除的 PVC 名称",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "type": "string"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)，data 为出错前已删除的 PVC",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/statefulset/scale": {
+            "post": {
+                "description": "通过 scale 子资源设置副本数，OrderedReady 策略下按序号依次创建或从最大序号开始删除 Pod",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StatefulSet 管理"
+                ],
+                "summary": "扩缩容 StatefulSet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "StatefulSet 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "副本数",
+                        "name": "replicas",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "操作成功；副本数由生效中的 HPA 管理时 msg 为覆盖提示",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误(code=20001)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/storage": {
+            "get": {
+                "description": "获取所有 StorageClass 存储类信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StorageClass 管理"
+                ],
+                "summary": "获取 StorageClass 列表",
+                "responses": {
+                    "200": {
+                        "description": "获取成功",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.StorageClass"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "创建 StorageClass，供应器(provisioner)需在配置中注册，参数键、必填参数、回收策略与绑定模式按注册项校验；已存在时只能更新标签、mountOptions 与 allowVolumeExpansion，不再按注册项校验，未填写的不可变字段沿用现有值",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StorageClass 管理"
+                ],
+                "summary": "创建或更新 StorageClass",
+                "parameters": [
+                    {
+                        "description": "StorageClass 信息",
+                        "name": "pod",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.StorageClass"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时仅在服务端预演不落库，返回与现有对象的差异",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "操作成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "参数错误(code=20001)或验证错误(code=20002)，如供应器未注册、参数不允许或修改了不可变字段",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "删除一个 StorageClass 存储类",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StorageClass 管理"
+                ],
+                "summary": "删除 StorageClass",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "StorageClass 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "删除 StorageClass 成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/storage/default": {
+            "post": {
+                "description": "POST 将其设为集群默认 StorageClass 并取消其他默认类，未指定 StorageClass 的 PVC 使用它；DELETE 取消默认",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StorageClass 管理"
+                ],
+                "summary": "设置或取消默认 StorageClass",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "StorageClass 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "操作成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "POST 将其设为集群默认 StorageClass 并取消其他默认类，未指定 StorageClass 的 PVC 使用它；DELETE 取消默认",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StorageClass 管理"
+                ],
+                "summary": "设置或取消默认 StorageClass",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "StorageClass 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "操作成功",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/storage/detail": {
+            "get": {
+                "description": "以创建请求的结构返回 StorageClass，用于编辑",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "StorageClass 管理"
+                ],
+                "summary": "获取 StorageClass 详情",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "StorageClass 名称",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "返回 StorageClass 详情",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.StorageClass"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/tunnel/pod/{namespace}/{name}/{port}": {
+            "get": {
+                "description": "升级为 WebSocket 后，二进制消息与 Pod 端口的 TCP 字节流双向转发，空闲超时后自动断开",
+                "tags": [
+                    "PortForward 管理"
+                ],
+                "summary": "建立到 Pod 端口的 TCP WebSocket 隧道",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pod 名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "端口号或容器端口名",
+                        "name": "port",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "切换为 WebSocket 协议"
+                    },
+                    "400": {
+                        "description": "目标端口不存在或 Pod 未运行(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "port-forward 会话数达到上限(code=30001)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/tunnel/service/{namespace}/{name}/{port}": {
+            "get": {
+                "description": "将 Service 端口解析到一个就绪的后端 Pod，升级为 WebSocket 后双向转发 TCP 字节流",
+                "tags": [
+                    "PortForward 管理"
+                ],
+                "summary": "建立到 Service 端口的 TCP WebSocket 隧道",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Service 名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Service 端口号或端口名",
+                        "name": "port",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "切换为 WebSocket 协议"
+                    },
+                    "400": {
+                        "description": "端口不存在或没有就绪的后端(code=20002)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "429": {
+                        "description": "port-forward 会话数达到上限(code=30001)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "系统错误(code=30000)",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_pkg_response.Response"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.AutoscalerRef": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "description": "false when the HPA can not compute a scale, e.g. metrics are missing",
+                    "type": "boolean"
+                },
+                "currentReplicas": {
+                    "type": "integer"
+                },
+                "desiredReplicas": {
+                    "type": "integer"
+                },
+                "maxReplicas": {
+                    "type": "integer"
+                },
+                "minReplicas": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.Base": {
+            "type": "object",
+            "properties": {
+                "imagePullSecrets": {
+                    "description": "secret names of private registries",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "priorityClassName": {
+                    "type": "string"
+                },
+                "restartPolicy": {
+                    "description": "reboot strategy: Always | Never | On-Failure",
+                    "type": "string"
+                },
+                "serviceAccountName": {
+                    "type": "string"
+                },
+                "terminationGracePeriodSeconds": {
+                    "description": "TerminationGracePeriodSeconds is how long the containers have to stop after SIGTERM, nil means 30s",
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.BasicAuthSecret": {
+            "type": "object",
+            "properties": {
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "resourceVersion": {
+                    "description": "version the edit is based on, empty skips the conflict check",
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.CSIVolumeSource": {
+            "type": "object",
+            "properties": {
+                "controllerExpandSecretRef": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.SecretRef"
+                },
+                "controllerPublishSecretRef": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.SecretRef"
+                },
+                "driver": {
+                    "type": "string"
+                },
+                "fsType": {
+                    "type": "string"
+                },
+                "nodeExpandSecretRef": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.SecretRef"
+                },
+                "nodePublishSecretRef": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.SecretRef"
+                },
+                "nodeStageSecretRef": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.SecretRef"
+                },
+                "readOnly": {
+                    "type": "boolean"
+                },
+                "volumeAttributes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "volumeHandle": {
+                    "description": "存储系统中卷的唯一标识",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.Capabilities": {
+            "type": "object",
+            "properties": {
+                "add": {
+                    "description": "e.g. NET_BIND_SERVICE",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "drop": {
+                    "description": "e.g. ALL",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.ConfigMap": {
+            "type": "object",
+            "properties": {
+                "binaryData": {
+                    "description": "values are base64 encoded, sets these keys and keeps the other existing binary keys",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "immutable": {
+                    "description": "cannot be reverted, the data can no longer change",
+                    "type": "boolean"
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "removeBinaryKeys": {
+                    "description": "RemoveBinaryKeys drops existing binary keys, the detail never returns their content so leaving one out keeps it",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "resourceVersion": {
+                    "description": "version the edit is based on, empty skips the conflict check",
+                    "type": "string"
+                },
+                "restartConsumers": {
+                    "description": "RestartConsumers rolls out the Deployments, StatefulSets and DaemonSets using it after saving",
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.ConfigMapRefVolume": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "optional": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.Container": {
+            "type": "object",
+            "properties": {
+                "args": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "command": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "env": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.EnvVar"
+                    }
+                },
+                "envsFrom": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.EnvVarFromResource"
+                    }
+                },
+                "image": {
+                    "type": "string"
+                },
+                "imagePullPolicy": {
+                    "description": "Always | IfNotPresent | Never",
+                    "type": "string"
+                },
+                "lifecycle": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Lifecycle"
+                },
+                "livenessProbe": {
+                    "description": "Survival probes",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ContainerProbe"
+                        }
+                    ]
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ContainerPort"
+                    }
+                },
+                "privileged": {
+                    "description": "Whether to enable privileged mode (e.g. root)",
+                    "type": "boolean"
+                },
+                "readyProbe": {
+                    "description": "Readiness Probe",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ContainerProbe"
+                        }
+                    ]
+                },
+                "resources": {
+                    "description": "Container application quota",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ResourceRequirement"
+                    }
+                },
+                "securityContext": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.SecurityContext"
+                },
+                "startUpProbe": {
+                    "description": "Start the probe",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ContainerProbe"
+                        }
+                    ]
+                },
+                "tty": {
+                    "type": "boolean"
+                },
+                "volumeMounts": {
+                    "description": "Mounted volumes",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.VolumeMount"
+                    }
+                },
+                "workingDir": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.ContainerPort": {
+            "type": "object",
+            "properties": {
+                "containerPort": {
+                    "type": "integer"
+                },
+                "hostPort": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.ContainerProbe": {
+            "type": "object",
+            "properties": {
+                "command": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ProbeCommand"
+                },
+                "enable": {
+                    "description": "Whether to turn on the probe",
+                    "type": "boolean"
+                },
+                "failureThreshold": {
+                    "description": "Threshold number of probes to consider a probe failure",
+                    "type": "integer"
+                },
+                "httpGet": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ProbeHTTPGet"
+                },
+                "initialDelaySeconds": {
+                    "description": "Initialize for a number of seconds before starting the probe",
+                    "type": "integer"
+                },
+                "periodSeconds": {
+                    "description": "Probe after every few seconds",
+                    "type": "integer"
+                },
+                "successThreshold": {
+                    "description": "Threshold number of probes to consider a probe successful",
+                    "type": "integer"
+                },
+                "tcpSocket": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ProbeTcpSocket"
+                },
+                "timeoutSeconds": {
+                    "description": "Probe timeout time",
+                    "type": "integer"
+                },
+                "type": {
+                    "description": "Probe type: tcp | http | command",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.CronJob": {
+            "type": "object",
+            "properties": {
+                "concurrencyPolicy": {
+                    "description": "并发策略",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.ConcurrencyPolicy"
+                        }
+                    ]
+                },
+                "failedJobsHistoryLimit": {
+                    "type": "integer"
+                },
+                "jobBase": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.JobBase"
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "resourceVersion": {
+                    "description": "version the edit is based on, empty skips the conflict check",
+                    "type": "string"
+                },
+                "schedule": {
+                    "description": "cron 表达式",
+                    "type": "string"
+                },
+                "successfulJobsHistoryLimit": {
+                    "type": "integer"
+                },
+                "suspend": {
+                    "description": "是否暂停 cronjob",
+                    "type": "boolean"
+                },
+                "template": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Pod"
+                },
+                "timeZone": {
+                    "description": "IANA 时区名，如 Asia/Shanghai，为空时使用控制器所在时区",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.DaemonSet": {
+            "type": "object",
+            "properties": {
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "minReadySeconds": {
+                    "description": "新 Pod 就绪后需保持的秒数才视为可用",
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "resourceVersion": {
+                    "description": "version the edit is based on, empty skips the conflict check",
+                    "type": "string"
+                },
+                "selector": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "template": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Pod"
+                },
+                "updateStrategy": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.DaemonSetUpdateStrategy"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.DaemonSetUpdateStrategy": {
+            "type": "object",
+            "properties": {
+                "maxSurge": {
+                    "description": "MaxSurge 滚动更新时先在节点上启动新 Pod 再删除旧 Pod 的节点数或百分比，适合不能中断的 agent",
+                    "type": "string"
+                },
+                "maxUnavailable": {
+                    "description": "MaxUnavailable 滚动更新时最多不可用的节点数或百分比，为空时为 1",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "RollingUpdate(默认) | OnDelete",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.DaemonSetUpdateStrategyType"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.Deployment": {
+            "type": "object",
+            "properties": {
+                "autoscaler": {
+                    "description": "HPA scaling the workload, ignored on write",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.AutoscalerRef"
+                        }
+                    ]
+                },
+                "disruptionBudget": {
+                    "description": "DisruptionBudget sums up the PDBs protecting the pods, ignored on write",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.DisruptionBudgetRef"
+                        }
+                    ]
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "replicas": {
+                    "type": "integer"
+                },
+                "resourceVersion": {
+                    "description": "version the edit is based on, empty skips the conflict check",
+                    "type": "string"
+                },
+                "selector": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "template": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Pod"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.DisruptionBudgetRef": {
+            "type": "object",
+            "properties": {
+                "budgets": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "disruptionsAllowed": {
+                    "description": "the smallest budget left among the PDBs",
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "None | Blocking | Allowed",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.DnsConfig": {
+            "type": "object",
+            "properties": {
+                "nameservers": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.DockerConfigSecret": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "registry": {
+                    "description": "e.g. https://index.docker.io/v1/ | harbor.example.com",
+                    "type": "string"
+                },
+                "resourceVersion": {
+                    "description": "version the edit is based on, empty skips the conflict check",
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.DownwardAPIVolume": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.DownwardAPIVolumeItem"
+                    }
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.DownwardAPIVolumeItem": {
+            "type": "object",
+            "properties": {
+                "fieldPath": {
+                    "type": "string"
+                },
+                "path": {
+                    "description": "pod internal path",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.EnvVar": {
+            "type": "object",
+            "properties": {
+                "containerName": {
+                    "description": "ContainerName is the container whose resource is read, empty means this one",
+                    "type": "string"
+                },
+                "divisor": {
+                    "description": "unit of the resource, e.g. 1m or 1Mi, empty means 1",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "optional": {
+                    "description": "the configMap or secret key may be missing",
+                    "type": "boolean"
+                },
+                "refName": {
+                    "description": "configMap or secret name",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "configMap | secret | field | resource | default(k/v)",
+                    "type": "string"
+                },
+                "value": {
+                    "description": "Value is the value, the key of the configMap or secret, the field path such as status.podIP,\nor the resource such as limits.cpu",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.EnvVarFromResource": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "prefix": {
+                    "type": "string"
+                },
+                "refType": {
+                    "description": "configMap | secret",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.EphemeralVolume": {
+            "type": "object",
+            "properties": {
+                "accessModes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.PersistentVolumeAccessMode"
+                    }
+                },
+                "capacity": {
+                    "type": "string"
+                },
+                "storageClassName": {
+                    "description": "empty uses the default StorageClass",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.HPA": {
+            "type": "object",
+            "properties": {
+                "behavior": {
+                    "description": "Behavior tunes how fast it scales up and down, nil keeps the defaults",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v2.HorizontalPodAutoscalerBehavior"
+                        }
+                    ]
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "maxReplicas": {
+                    "type": "integer"
+                },
+                "metrics": {
+                    "description": "Metrics defaults to 80% CPU utilization like the API server when empty",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.HPAMetric"
+                    }
+                },
+                "minReplicas": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "resourceVersion": {
+                    "description": "version the edit is based on, empty skips the conflict check",
+                    "type": "string"
+                },
+                "targetKind": {
+                    "description": "Deployment | StatefulSet",
+                    "type": "string"
+                },
+                "targetName": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.HPAMetric": {
+            "type": "object",
+            "properties": {
+                "container": {
+                    "description": "container whose usage counts, only for ContainerResource",
+                    "type": "string"
+                },
+                "describedObject": {
+                    "description": "DescribedObject is the object the metric describes, only for Object",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v2.CrossVersionObjectReference"
+                        }
+                    ]
+                },
+                "metricName": {
+                    "description": "custom or external metric, for Pods | Object | External",
+                    "type": "string"
+                },
+                "resourceName": {
+                    "description": "cpu | memory, only for Resource | ContainerResource",
+                    "type": "string"
+                },
+                "selector": {
+                    "description": "labels narrowing the metric series",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "targetType": {
+                    "description": "Utilization | AverageValue | Value",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v2.MetricTargetType"
+                        }
+                    ]
+                },
+                "type": {
+                    "description": "Resource | ContainerResource | Pods | Object | External",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v2.MetricSourceType"
+                        }
+                    ]
+                },
+                "utilization": {
+                    "description": "percentage of the requests, for Utilization",
+                    "type": "integer"
+                },
+                "value": {
+                    "description": "quantity such as 500m or 1k, for AverageValue | Value",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.HostPathVolume": {
+            "type": "object",
+            "properties": {
+                "path": {
+                    "description": "host path",
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/v1.HostPathType"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.HostPathVolumeSource": {
+            "type": "object",
+            "properties": {
+                "path": {
+                    "type": "string"
+                },
+                "type": {
+                    "description": "为空时不检查，DirectoryOrCreate | Directory | FileOrCreate | File ...",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.HostPathType"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.ISCSIVolumeSource": {
+            "type": "object",
+            "properties": {
+                "chapAuthDiscovery": {
+                    "type": "boolean"
+                },
+                "chapAuthSession": {
+                    "type": "boolean"
+                },
+                "fsType": {
+                    "type": "string"
+                },
+                "initiatorName": {
+                    "type": "string"
+                },
+                "iqn": {
+                    "type": "string"
+                },
+                "iscsiInterface": {
+                    "description": "默认 default(tcp)",
+                    "type": "string"
+                },
+                "lun": {
+                    "type": "integer"
+                },
+                "portals": {
+                    "description": "多路径的其他 portal",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "readOnly": {
+                    "type": "boolean"
+                },
+                "secretRef": {
+                    "description": "CHAP 认证的 Secret",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.SecretRef"
+                        }
+                    ]
+                },
+                "targetPortal": {
+                    "description": "ip:port，端口默认 3260",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.Ingress": {
+            "type": "object",
+            "properties": {
+                "annotations": {
+                    "description": "most controllers are configured via annotations",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "defaultBackend": {
+                    "description": "requests that match no rule",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.IngressBackend"
+                        }
+                    ]
+                },
+                "ingressClassName": {
+                    "description": "\"\" means the cluster default class",
+                    "type": "string"
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "resourceVersion": {
+                    "description": "version the edit is based on, empty skips the conflict check",
+                    "type": "string"
+                },
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.IngressRule"
+                    }
+                },
+                "tls": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.IngressTLS"
+                    }
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.IngressRoute": {
+            "type": "object",
+            "properties": {
+                "ingressRouteSpec": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.IngressRouteSpec"
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "resourceVersion": {
+                    "description": "version the edit is based on, empty skips the conflict check",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.IngressRouteSpec": {
+            "type": "object",
+            "properties": {
+                "entryPoints": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "routes": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "kind": {
+                                "type": "string"
+                            },
+                            "match": {
+                                "type": "string"
+                            },
+                            "middlewares": {
+                                "type": "array",
+                                "items": {
+                                    "type": "object",
+                                    "properties": {
+                                        "name": {
+                                            "type": "string"
+                                        }
+                                    }
+                                }
+                            },
+                            "services": {
+                                "type": "array",
+                                "items": {
+                                    "type": "object",
+                                    "properties": {
+                                        "name": {
+                                            "type": "string"
+                                        },
+                                        "port": {
+                                            "type": "integer"
+                                        }
+                                    }
+                                }
+                            }
+                        }
+                    }
+                },
+                "tls": {
+                    "type": "object",
+                    "properties": {
+                        "secretName": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.IngressRule": {
+            "type": "object",
+            "properties": {
+                "host": {
+                    "type": "string"
+                },
+                "value": {
+                    "$ref": "#/definitions/v1.IngressRuleValue"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.IngressTLS": {
+            "type": "object",
+            "properties": {
+                "hosts": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "secretName": {
+                    "description": "kubernetes.io/tls secret in the same namespace",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.Item": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.Job": {
+            "type": "object",
+            "properties": {
+                "activeDeadlineSeconds": {
+                    "description": "ActiveDeadlineSeconds fails the Job once it has run that long, 0 means no deadline",
+                    "type": "integer"
+                },
+                "backoffLimit": {
+                    "description": "BackoffLimit is the retries before the Job fails, nil keeps the default of 6",
+                    "type": "integer"
+                },
+                "completionMode": {
+                    "description": "NonIndexed | Indexed, Indexed gives every pod a completion index",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.CompletionMode"
+                        }
+                    ]
+                },
+                "completions": {
+                    "description": "Job 的 Pod 副本数，全部副本数运行成功，才能代表job运行成功",
+                    "type": "integer"
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "parallelism": {
+                    "description": "同时运行的 Pod 数，0 表示与 completions 相同",
+                    "type": "integer"
+                },
+                "podFailurePolicy": {
+                    "description": "requires restartPolicy Never",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.PodFailurePolicy"
+                        }
+                    ]
+                },
+                "resourceVersion": {
+                    "description": "version the edit is based on, empty skips the conflict check",
+                    "type": "string"
+                },
+                "suspend": {
+                    "description": "create the Job without starting pods",
+                    "type": "boolean"
+                },
+                "template": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Pod"
+                },
+                "ttlSecondsAfterFinished": {
+                    "description": "TTLSecondsAfterFinished deletes the finished Job after that delay, nil keeps it",
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.JobBase": {
+            "type": "object",
+            "properties": {
+                "backoffLimit": {
+                    "type": "integer"
+                },
+                "completions": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.Lifecycle": {
+            "type": "object",
+            "properties": {
+                "postStart": {
+                    "description": "runs right after the container is created",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.LifecycleHandler"
+                        }
+                    ]
+                },
+                "preStop": {
+                    "description": "runs before the container is sent SIGTERM",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.LifecycleHandler"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.LifecycleHandler": {
+            "type": "object",
+            "properties": {
+                "command": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ProbeCommand"
+                },
+                "enable": {
+                    "type": "boolean"
+                },
+                "httpGet": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ProbeHTTPGet"
+                },
+                "sleep": {
+                    "description": "seconds",
+                    "type": "integer"
+                },
+                "type": {
+                    "description": "exec | http | sleep",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.LocalVolumeSource": {
+            "type": "object",
+            "properties": {
+                "fsType": {
+                    "description": "仅块设备",
+                    "type": "string"
+                },
+                "nodes": {
+                    "description": "Nodes 为本地盘所在节点的 kubernetes.io/hostname，local 卷必须限定节点",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "path": {
+                    "description": "节点上的目录或块设备",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.NFSVolumeSource": {
+            "type": "object",
+            "properties": {
+                "nfsPath": {
+                    "type": "string"
+                },
+                "nfsServer": {
+                    "type": "string"
+                },
+                "readOnly": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.Network": {
+            "type": "object",
+            "properties": {
+                "dnsConfig": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.DnsConfig"
+                },
+                "dnsPolicy": {
+                    "type": "string"
+                },
+                "hostAliases": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "hostName": {
+                    "type": "string"
+                },
+                "hostNetwork": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.NetworkPolicy": {
+            "type": "object",
+            "properties": {
+                "egress": {
+                    "description": "no rule with Egress type denies all outgoing traffic",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.NetworkPolicyRule"
+                    }
+                },
+                "ingress": {
+                    "description": "no rule with Ingress type denies all incoming traffic",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.NetworkPolicyRule"
+                    }
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "podSelector": {
+                    "description": "PodSelector picks the pods the policy applies to, empty selects every pod in the namespace",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.LabelSelector"
+                        }
+                    ]
+                },
+                "policyTypes": {
+                    "description": "PolicyTypes defaults to Ingress, plus Egress when there are egress rules",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.PolicyType"
+                    }
+                },
+                "resourceVersion": {
+                    "description": "version the edit is based on, empty skips the conflict check",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.NetworkPolicyPeer": {
+            "type": "object",
+            "properties": {
+                "ipBlock": {
+                    "$ref": "#/definitions/v1.IPBlock"
+                },
+                "namespaceSelector": {
+                    "description": "{} matches every namespace",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.LabelSelector"
+                        }
+                    ]
+                },
+                "podSelector": {
+                    "$ref": "#/definitions/v1.LabelSelector"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.NetworkPolicyPort": {
+            "type": "object",
+            "properties": {
+                "endPort": {
+                    "description": "makes Port..EndPort a range, Port must be a number",
+                    "type": "integer"
+                },
+                "port": {
+                    "description": "number or named container port, nil matches every port",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/intstr.IntOrString"
+                        }
+                    ]
+                },
+                "protocol": {
+                    "description": "TCP | UDP | SCTP, defaults to TCP",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.Protocol"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.NetworkPolicyRule": {
+            "type": "object",
+            "properties": {
+                "peers": {
+                    "description": "from of an ingress rule, to of an egress rule; empty allows every peer",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.NetworkPolicyPeer"
+                    }
+                },
+                "ports": {
+                    "description": "empty allows every port",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.NetworkPolicyPort"
+                    }
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.NodeAffinityTermExpressions": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "string"
+                },
+                "operator": {
+                    "$ref": "#/definitions/v1.NodeSelectorOperator"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.NodeScheduling": {
+            "type": "object",
+            "properties": {
+                "nodeAffinity": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.NodeAffinityTermExpressions"
+                    }
+                },
+                "nodeName": {
+                    "type": "string"
+                },
+                "nodeSelector": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "type": {
+                    "description": "nodeName | nodeSelector | nodeAffinity",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.PDB": {
+            "type": "object",
+            "properties": {
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "maxUnavailable": {
+                    "type": "string"
+                },
+                "minAvailable": {
+                    "description": "exactly one of MinAvailable and MaxUnavailable, as a number such as 2 or a percentage such as 50%",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "resourceVersion": {
+                    "description": "version the edit is based on, empty skips the conflict check",
+                    "type": "string"
+                },
+                "selector": {
+                    "description": "Selector picks the protected pods, matchLabels and matchExpressions both count",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.LabelSelector"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.PVCVolume": {
+            "type": "object",
+            "properties": {
+                "claimName": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.PVClaimRef": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.PersistentVolume": {
+            "type": "object",
+            "properties": {
+                "accessModes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.PersistentVolumeAccessMode"
+                    }
+                },
+                "capacity": {
+                    "type": "string"
+                },
+                "claimRef": {
+                    "description": "预绑定到指定 PVC，其他 PVC 无法使用",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.PVClaimRef"
+                        }
+                    ]
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "mountOptions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "reclaimPolicy": {
+                    "$ref": "#/definitions/v1.PersistentVolumeReclaimPolicy"
+                },
+                "storageClassName": {
+                    "description": "只有同名 StorageClass 的 PVC 才能绑定，为空时绑定不指定 StorageClass 的 PVC",
+                    "type": "string"
+                },
+                "volumeMode": {
+                    "description": "Filesystem(默认) | Block",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.PersistentVolumeMode"
+                        }
+                    ]
+                },
+                "volumeSource": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.VolumeSource"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.PersistentVolumeClaim": {
+            "type": "object",
+            "properties": {
+                "accessModes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.PersistentVolumeAccessMode"
+                    }
+                },
+                "capacity": {
+                    "type": "string"
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "selector": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "storageClassName": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.Pod": {
+            "type": "object",
+            "properties": {
+                "base": {
+                    "description": "base definition info",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Base"
+                        }
+                    ]
+                },
+                "containers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Container"
+                    }
+                },
+                "initContainers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Container"
+                    }
+                },
+                "network": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Network"
+                },
+                "nodeScheduling": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.NodeScheduling"
+                },
+                "podAffinity": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.PodAffinity"
+                },
+                "resourceVersion": {
+                    "description": "version the edit is based on, empty skips the conflict check",
+                    "type": "string"
+                },
+                "securityContext": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.PodSecurityContext"
+                },
+                "tolerations": {
+                    "description": "pod toleration params",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.Toleration"
+                    }
+                },
+                "topologySpreadConstraints": {
+                    "description": "spread pods over zones, nodes...",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.TopologySpreadRule"
+                    }
+                },
+                "volume": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Volume"
+                    }
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.PodAffinity": {
+            "type": "object",
+            "properties": {
+                "affinity": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.PodAffinityTerm"
+                    }
+                },
+                "antiAffinity": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.PodAffinityTerm"
+                    }
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.PodAffinityTerm": {
+            "type": "object",
+            "properties": {
+                "labelSelector": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "namespaces": {
+                    "description": "empty means the namespace of the pod",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "topologyKey": {
+                    "description": "e.g. kubernetes.io/hostname, topology.kubernetes.io/zone",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "required | preferred",
+                    "type": "string"
+                },
+                "weight": {
+                    "description": "1-100, only for preferred",
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.PodSecurityContext": {
+            "type": "object",
+            "properties": {
+                "fsGroup": {
+                    "description": "group owning the volumes",
+                    "type": "integer"
+                },
+                "fsGroupChangePolicy": {
+                    "description": "OnRootMismatch | Always",
+                    "type": "string"
+                },
+                "runAsGroup": {
+                    "type": "integer"
+                },
+                "runAsNonRoot": {
+                    "type": "boolean"
+                },
+                "runAsUser": {
+                    "type": "integer"
+                },
+                "seccompProfile": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.SeccompProfile"
+                },
+                "supplementalGroups": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "sysctls": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.ProbeCommand": {
+            "type": "object",
+            "properties": {
+                "command": {
+                    "description": "cat /test/test.txt",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.ProbeHTTPGet": {
+            "type": "object",
+            "properties": {
+                "headers": {
+                    "description": "http headers",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "host": {
+                    "description": "If \"\", it's an in-pod request.",
+                    "type": "string"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "port": {
+                    "type": "integer"
+                },
+                "scheme": {
+                    "description": "http | https",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.ProbeTcpSocket": {
+            "type": "object",
+            "properties": {
+                "host": {
+                    "type": "string"
+                },
+                "port": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.ProjectedSource": {
+            "type": "object",
+            "properties": {
+                "downwardAPI": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.DownwardAPIVolumeItem"
+                    }
+                },
+                "items": {
+                    "description": "Items maps keys of the configMap or secret to file paths, empty projects every key",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "name": {
+                    "description": "configMap or secret name",
+                    "type": "string"
+                },
+                "optional": {
+                    "type": "boolean"
+                },
+                "serviceAccountToken": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ServiceAccountToken"
+                },
+                "type": {
+                    "description": "configMap | secret | downwardAPI | serviceAccountToken",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.ProjectedVolume": {
+            "type": "object",
+            "properties": {
+                "sources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ProjectedSource"
+                    }
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.Reachability": {
+            "type": "object",
+            "properties": {
+                "destinationNamespace": {
+                    "type": "string"
+                },
+                "destinationPod": {
+                    "type": "string"
+                },
+                "port": {
+                    "description": "number or named container port of the destination",
+                    "type": "string"
+                },
+                "protocol": {
+                    "description": "defaults to TCP",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.Protocol"
+                        }
+                    ]
+                },
+                "sourceNamespace": {
+                    "type": "string"
+                },
+                "sourcePod": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.ResourceRequirement": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "string"
+                },
+                "name": {
+                    "description": "cpu | memory | ephemeral-storage | hugepages-2Mi | extended resources such as nvidia.com/gpu",
+                    "type": "string"
+                },
+                "request": {
+                    "description": "quantity such as 250m or 512Mi, empty for none",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.Role": {
+            "type": "object",
+            "properties": {
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace == \"\" ? ClusterRole : Role",
+                    "type": "string"
+                },
+                "resourceVersion": {
+                    "description": "version the edit is based on, empty skips the conflict check",
+                    "type": "string"
+                },
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.PolicyRule"
+                    }
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.RoleBinding": {
+            "type": "object",
+            "properties": {
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace == \"\" ?  ClusterRoleBinding: RoleBinding",
+                    "type": "string"
+                },
+                "resourceVersion": {
+                    "description": "version the edit is based on, empty skips the conflict check",
+                    "type": "string"
+                },
+                "roleRef": {
+                    "type": "string"
+                },
+                "subjects": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Subject"
+                    }
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.SSHAuthSecret": {
+            "type": "object",
+            "properties": {
+                "knownHosts": {
+                    "description": "optional, stored as known_hosts",
+                    "type": "string"
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "privateKey": {
+                    "description": "PEM encoded",
+                    "type": "string"
+                },
+                "resourceVersion": {
+                    "description": "version the edit is based on, empty skips the conflict check",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.SeccompProfile": {
+            "type": "object",
+            "properties": {
+                "localhostProfile": {
+                    "description": "profile path on the node, only for Localhost",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "RuntimeDefault | Localhost | Unconfined, empty for none",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.Secret": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "plain values, a masked value keeps the stored one on update",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "resourceVersion": {
+                    "description": "version the edit is based on, empty skips the conflict check",
+                    "type": "string"
+                },
+                "restartConsumers": {
+                    "description": "RestartConsumers rolls out the Deployments, StatefulSets and DaemonSets using it after saving",
+                    "type": "boolean"
+                },
+                "type": {
+                    "description": "Opaque | kubernetes.io/dockerconfigjson",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.SecretType"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.SecretRef": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.SecretRefVolume": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "optional": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.SecretReveal": {
+            "type": "object",
+            "properties": {
+                "keys": {
+                    "description": "empty reveals every key",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "recorded in the audit event",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.SecurityContext": {
+            "type": "object",
+            "properties": {
+                "allowPrivilegeEscalation": {
+                    "description": "nil keeps the runtime default",
+                    "type": "boolean"
+                },
+                "capabilities": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Capabilities"
+                },
+                "readOnlyRootFilesystem": {
+                    "type": "boolean"
+                },
+                "runAsGroup": {
+                    "type": "integer"
+                },
+                "runAsNonRoot": {
+                    "type": "boolean"
+                },
+                "runAsUser": {
+                    "type": "integer"
+                },
+                "seccompProfile": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.SeccompProfile"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.Service": {
+            "type": "object",
+            "properties": {
+                "externalIPs": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "externalName": {
+                    "description": "DNS name, only for ExternalName",
+                    "type": "string"
+                },
+                "externalTrafficPolicy": {
+                    "description": "Cluster | Local, only for NodePort/LoadBalancer",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.ServiceExternalTrafficPolicy"
+                        }
+                    ]
+                },
+                "headless": {
+                    "description": "clusterIP: None",
+                    "type": "boolean"
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "loadBalancerSourceRanges": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "ports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ServicePort"
+                    }
+                },
+                "resourceVersion": {
+                    "description": "version the edit is based on, empty skips the conflict check",
+                    "type": "string"
+                },
+                "selector": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "sessionAffinity": {
+                    "description": "None | ClientIP",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.ServiceAffinity"
+                        }
+                    ]
+                },
+                "sessionAffinityTimeout": {
+                    "description": "ClientIP sticky seconds, 0 means default(10800)",
+                    "type": "integer"
+                },
+                "type": {
+                    "description": "ClusterIP | NodePort | LoadBalancer | ExternalName",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.ServiceType"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.ServiceAccount": {
+            "type": "object",
+            "properties": {
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.ServiceAccountToken": {
+            "type": "object",
+            "properties": {
+                "audience": {
+                    "description": "empty means the api server",
+                    "type": "string"
+                },
+                "expirationSeconds": {
+                    "description": "at least 600, 0 means 1h",
+                    "type": "integer"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.ServicePort": {
+            "type": "object",
+            "properties": {
+                "appProtocol": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "nodePort": {
+                    "type": "integer"
+                },
+                "port": {
+                    "type": "integer"
+                },
+                "protocol": {
+                    "description": "TCP | UDP | SCTP",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.Protocol"
+                        }
+                    ]
+                },
+                "targetPort": {
+                    "description": "port number or container port name",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.StatefulSet": {
+            "type": "object",
+            "properties": {
+                "autoscaler": {
+                    "description": "HPA scaling the workload, ignored on write",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.AutoscalerRef"
+                        }
+                    ]
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "persistentVolumeClaimRetentionPolicy": {
+                    "description": "为空时 PVC 一律保留",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.StatefulSetPVCRetentionPolicy"
+                        }
+                    ]
+                },
+                "podManagementPolicy": {
+                    "description": "OrderedReady(默认) | Parallel，创建后不可修改",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.PodManagementPolicyType"
+                        }
+                    ]
+                },
+                "replicas": {
+                    "type": "integer"
+                },
+                "resourceVersion": {
+                    "description": "version the edit is based on, empty skips the conflict check",
+                    "type": "string"
+                },
+                "selector": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "serviceName": {
+                    "type": "string"
+                },
+                "template": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Pod"
+                },
+                "updateStrategy": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.StatefulSetUpdateStrategy"
+                },
+                "volumeClaimTemplates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.PersistentVolumeClaim"
+                    }
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.StatefulSetPVCRetentionPolicy": {
+            "type": "object",
+            "properties": {
+                "whenDeleted": {
+                    "description": "Retain | Delete，删除 StatefulSet 时",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.PersistentVolumeClaimRetentionPolicyType"
+                        }
+                    ]
+                },
+                "whenScaled": {
+                    "description": "Retain | Delete，缩容时",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.PersistentVolumeClaimRetentionPolicyType"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.StatefulSetUpdateStrategy": {
+            "type": "object",
+            "properties": {
+                "maxUnavailable": {
+                    "description": "MaxUnavailable 滚动更新时最多不可用的 Pod 数或百分比，为空时为 1，需开启 MaxUnavailableStatefulSet 特性",
+                    "type": "string"
+                },
+                "partition": {
+                    "description": "Partition 只更新序号不小于该值的 Pod，用于金丝雀发布，仅 RollingUpdate 生效",
+                    "type": "integer"
+                },
+                "type": {
+                    "description": "RollingUpdate(默认) | OnDelete",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.StatefulSetUpdateStrategyType"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.StorageClass": {
+            "type": "object",
+            "properties": {
+                "allowVolumeExpansion": {
+                    "description": "whether permit expand",
+                    "type": "boolean"
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                },
+                "mountOptions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parameters": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
                     }
                 },
-                "env": {
+                "provisioner": {
+                    "description": "must be registered in storageClass.provisioners of the config",
+                    "type": "string"
+                },
+                "reclaimPolicy": {
+                    "description": "Delete(default) | Retain",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.PersistentVolumeReclaimPolicy"
+                        }
+                    ]
+                },
+                "resourceVersion": {
+                    "description": "version the edit is based on, empty skips the conflict check",
+                    "type": "string"
+                },
+                "volumeBindingMode": {
+                    "description": "Immediate(default) | WaitForFirstConsumer",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.VolumeBindingMode"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.Subject": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.TopologySpreadRule": {
+            "type": "object",
+            "properties": {
+                "labelSelector": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.EnvVar"
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
                     }
                 },
-                "envsFrom": {
+                "maxSkew": {
+                    "type": "integer"
+                },
+                "minDomains": {
+                    "description": "only with DoNotSchedule",
+                    "type": "integer"
+                },
+                "topologyKey": {
+                    "type": "string"
+                },
+                "whenUnsatisfiable": {
+                    "description": "DoNotSchedule | ScheduleAnyway",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.UpdateLabelReq": {
+            "type": "object",
+            "properties": {
+                "labels": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.EnvVarFromResource"
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
                     }
                 },
-                "image": {
+                "name": {
                     "type": "string"
                 },
-                "imagePullPolicy": {
-                    "description": "Always | IfNotPresent | Never",
+                "resourceVersion": {
+                    "description": "version the edit is based on, empty skips the conflict check",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.UpdateTaintReq": {
+            "type": "object",
+            "properties": {
+                "name": {
                     "type": "string"
                 },
-                "livenessProbe": {
-                    "description": "Survival probes",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ContainerProbe"
-                        }
-                    ]
+                "resourceVersion": {
+                    "description": "version the edit is based on, empty skips the conflict check",
+                    "type": "string"
+                },
+                "taints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.Taint"
+                    }
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.Volume": {
+            "type": "object",
+            "properties": {
+                "PVCVolume": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.PVCVolume"
+                },
+                "configMapRefVolume": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ConfigMapRefVolume"
+                },
+                "downwardAPIVolume": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.DownwardAPIVolume"
+                },
+                "ephemeralVolume": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.EphemeralVolume"
+                },
+                "hostPathVolume": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.HostPathVolume"
                 },
                 "name": {
                     "type": "string"
                 },
-                "ports": {
+                "projectedVolume": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ProjectedVolume"
+                },
+                "secretRefVolume": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.SecretRefVolume"
+                },
+                "type": {
+                    "description": "emptyDir | configMap | secret | hostPath | downward | pvc | projected | ephemeral",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.VolumeMount": {
+            "type": "object",
+            "properties": {
+                "mountName": {
+                    "type": "string"
+                },
+                "mountPath": {
+                    "description": "Mounted volume -\u003e path in the corresponding container",
+                    "type": "string"
+                },
+                "readOnly": {
+                    "description": "Read-only or not",
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.VolumeSnapshot": {
+            "type": "object",
+            "properties": {
+                "labels": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ContainerPort"
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
                     }
                 },
-                "privileged": {
-                    "description": "Whether to enable privileged mode (e.g. root)",
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "pvcName": {
+                    "description": "要快照的 PVC，需与快照在同一命名空间",
+                    "type": "string"
+                },
+                "snapshotClassName": {
+                    "description": "SnapshotClassName 为空时使用默认 VolumeSnapshotClass",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.VolumeSnapshotClass": {
+            "type": "object",
+            "properties": {
+                "deletionPolicy": {
+                    "description": "Delete(默认) | Retain",
+                    "type": "string"
+                },
+                "driver": {
+                    "description": "CSI 驱动名，需与 StorageClass 的 provisioner 一致",
+                    "type": "string"
+                },
+                "isDefault": {
+                    "description": "设为该驱动的默认快照类",
                     "type": "boolean"
                 },
-                "readyProbe": {
-                    "description": "Readiness Probe",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ContainerProbe"
-                        }
-                    ]
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
                 },
-                "resources": {
-                    "description": "Container application quota",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Resource"
-                        }
-                    ]
+                "name": {
+                    "type": "string"
+                },
+                "parameters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                    }
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.VolumeSnapshotRestore": {
+            "type": "object",
+            "properties": {
+                "accessModes": {
+                    "description": "AccessModes 为空时沿用源 PVC 的访问模式",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.PersistentVolumeAccessMode"
+                    }
+                },
+                "capacity": {
+                    "description": "为空时取快照的 restoreSize，不能小于 restoreSize",
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "pvcName": {
+                    "description": "新建的 PVC 名称",
+                    "type": "string"
+                },
+                "snapshotName": {
+                    "type": "string"
+                },
+                "storageClassName": {
+                    "description": "StorageClassName 为空时沿用源 PVC 的 StorageClass",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_req.VolumeSource": {
+            "type": "object",
+            "properties": {
+                "csi": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.CSIVolumeSource"
+                },
+                "hostPath": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.HostPathVolumeSource"
+                },
+                "iscsi": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ISCSIVolumeSource"
+                },
+                "local": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.LocalVolumeSource"
+                },
+                "nfs": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.NFSVolumeSource"
+                },
+                "type": {
+                    "description": "nfs | hostPath | local | csi | iscsi",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.BinaryItem": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.ConfigMap": {
+            "type": "object",
+            "properties": {
+                "age": {
+                    "type": "integer"
+                },
+                "dataNum": {
+                    "type": "integer"
+                },
+                "immutable": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.ConfigMapDetail": {
+            "type": "object",
+            "properties": {
+                "age": {
+                    "type": "integer"
+                },
+                "binaryData": {
+                    "description": "content is fetched by download",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.BinaryItem"
+                    }
                 },
-                "startUpProbe": {
-                    "description": "Start the probe",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ContainerProbe"
-                        }
-                    ]
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Item"
+                    }
                 },
-                "tty": {
+                "dataNum": {
+                    "type": "integer"
+                },
+                "immutable": {
                     "type": "boolean"
                 },
-                "volumeMounts": {
-                    "description": "Mounted volumes",
+                "labels": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.VolumeMount"
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Item"
                     }
                 },
-                "workingDir": {
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "resourceVersion": {
                     "type": "string"
+                },
+                "usedBy": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Consumer"
+                    }
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.ContainerPort": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.Consumer": {
             "type": "object",
             "properties": {
-                "containerPort": {
-                    "type": "integer"
-                },
-                "hostPort": {
-                    "type": "integer"
+                "kind": {
+                    "description": "Deployment | StatefulSet | DaemonSet | CronJob | Job | Pod",
+                    "type": "string"
                 },
                 "name": {
                     "type": "string"
+                },
+                "refs": {
+                    "description": "where it is referenced, e.g. volume:config | env:app/LOG_LEVEL",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "restartable": {
+                    "description": "supports rollout restart",
+                    "type": "boolean"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.ContainerProbe": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.ContainerFile": {
             "type": "object",
             "properties": {
-                "command": {
-                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ProbeCommand"
+                "modTime": {
+                    "type": "integer"
                 },
-                "enable": {
-                    "description": "Whether to turn on the probe",
-                    "type": "boolean"
+                "mode": {
+                    "description": "octal permission bits, e.g. 644",
+                    "type": "string"
                 },
-                "failureThreshold": {
-                    "description": "Threshold number of probes to consider a probe failure",
-                    "type": "integer"
+                "name": {
+                    "type": "string"
                 },
-                "httpGet": {
-                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ProbeHTTPGet"
+                "path": {
+                    "type": "string"
                 },
-                "initialDelaySeconds": {
-                    "description": "Initialize for a number of seconds before starting the probe",
+                "size": {
+                    "description": "bytes, the total size for a directory in Stat",
                     "type": "integer"
                 },
-                "periodSeconds": {
-                    "description": "Probe after every few seconds",
-                    "type": "integer"
+                "type": {
+                    "description": "file | dir | link | other",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.ContainerResource": {
+            "type": "object",
+            "properties": {
+                "limit": {
+                    "type": "string"
                 },
-                "successThreshold": {
-                    "description": "Threshold number of probes to consider a probe successful",
-                    "type": "integer"
+                "limitSource": {
+                    "type": "string"
                 },
-                "tcpSocket": {
-                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ProbeTcpSocket"
+                "name": {
+                    "type": "string"
                 },
-                "timeoutSeconds": {
-                    "description": "Probe timeout time",
-                    "type": "integer"
+                "request": {
+                    "type": "string"
                 },
-                "type": {
-                    "description": "Probe type: tcp | http | command",
+                "requestSource": {
+                    "description": "where a value comes from: empty when the container sets it, limit when the request\ndefaults to the limit, otherwise the name of the LimitRange that defaults it",
                     "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.CronJob": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.ContainerResourcePreview": {
             "type": "object",
             "properties": {
-                "concurrencyPolicy": {
-                    "description": "并发策略",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/v1.ConcurrencyPolicy"
-                        }
-                    ]
-                },
-                "failedJobsHistoryLimit": {
-                    "type": "integer"
+                "init": {
+                    "type": "boolean"
                 },
-                "jobBase": {
-                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.JobBase"
+                "name": {
+                    "type": "string"
                 },
-                "labels": {
+                "resources": {
+                    "description": "effective requests and limits",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.ContainerResource"
                     }
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.CronJob": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "integer"
+                },
+                "age": {
+                    "type": "integer"
+                },
+                "lastScheduleTime": {
+                    "type": "integer"
                 },
                 "name": {
                     "type": "string"
@@ -3868,56 +10237,111 @@ const docTemplate = `{
                 "namespace": {
                     "type": "string"
                 },
+                "nextScheduleTime": {
+                    "description": "0 when suspended",
+                    "type": "integer"
+                },
                 "schedule": {
-                    "description": "cron 表达式",
                     "type": "string"
                 },
-                "successfulJobsHistoryLimit": {
-                    "type": "integer"
-                },
                 "suspend": {
-                    "description": "是否暂停 cronjob",
                     "type": "boolean"
                 },
-                "template": {
-                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Pod"
+                "timeZone": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.DaemonSet": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.CronJobHistory": {
             "type": "object",
             "properties": {
-                "labels": {
+                "active": {
+                    "type": "integer"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "jobs": {
+                    "description": "newest first",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.CronJobRun"
                     }
                 },
+                "lastScheduleTime": {
+                    "type": "integer"
+                },
+                "lastSuccessfulTime": {
+                    "type": "integer"
+                },
+                "succeeded": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.CronJobRun": {
+            "type": "object",
+            "properties": {
+                "completionTime": {
+                    "type": "integer"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "manual": {
+                    "description": "created by run now instead of the schedule",
+                    "type": "boolean"
+                },
                 "name": {
                     "type": "string"
                 },
-                "namespace": {
+                "startTime": {
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "Running | Complete | Failed | Suspended",
                     "type": "string"
                 },
-                "selector": {
+                "succeeded": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.CronJobSchedule": {
+            "type": "object",
+            "properties": {
+                "nextRuns": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                        "type": "integer"
                     }
                 },
-                "template": {
-                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Pod"
+                "schedule": {
+                    "type": "string"
+                },
+                "timeZone": {
+                    "description": "the zone the schedule is read in, UTC when the CronJob sets none",
+                    "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.Deployment": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.DaemonSet": {
             "type": "object",
             "properties": {
-                "labels": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
-                    }
+                "age": {
+                    "type": "integer"
+                },
+                "available": {
+                    "description": "表示可用的守护进程副本数，即已经就绪并且可以提供服务的守护进程副本数量",
+                    "type": "integer"
+                },
+                "current": {
+                    "description": "表示当前正在运行的守护进程副本数，即当前在集群中实际运行的守护进程副本数量。",
+                    "type": "integer"
+                },
+                "desired": {
+                    "description": "表示 DaemonSet 所需的守护进程副本数，即期望在集群中运行的守护进程副本数量。",
+                    "type": "integer"
                 },
                 "name": {
                     "type": "string"
@@ -3925,386 +10349,388 @@ const docTemplate = `{
                 "namespace": {
                     "type": "string"
                 },
-                "replicas": {
+                "ready": {
+                    "description": "表示已经就绪的守护进程副本数，即当前运行且已准备好接收工作负载的守护进程副本数量。",
                     "type": "integer"
                 },
-                "selector": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
-                    }
-                },
-                "template": {
-                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Pod"
+                "upToDate": {
+                    "description": "表示已经更新到最新版本的守护进程副本数，即已经与 DaemonSet 的定义保持一致的守护进程副本数量",
+                    "type": "integer"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.DnsConfig": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.DaemonSetCoverage": {
             "type": "object",
             "properties": {
-                "nameservers": {
+                "desired": {
+                    "type": "integer"
+                },
+                "misscheduled": {
+                    "description": "pods on nodes that should not run them",
+                    "type": "integer"
+                },
+                "nodes": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.DaemonSetNode"
                     }
+                },
+                "ready": {
+                    "type": "integer"
+                },
+                "scheduled": {
+                    "type": "integer"
+                },
+                "upToDate": {
+                    "type": "integer"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.DownwardAPIVolume": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.DaemonSetNode": {
             "type": "object",
             "properties": {
-                "items": {
+                "node": {
+                    "type": "string"
+                },
+                "pod": {
+                    "type": "string"
+                },
+                "ready": {
+                    "type": "boolean"
+                },
+                "reasons": {
+                    "description": "Reasons explain why the node is skipped, or why the pod is not running on it",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.DownwardAPIVolumeItem"
+                        "type": "string"
                     }
-                }
-            }
-        },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.DownwardAPIVolumeItem": {
-            "type": "object",
-            "properties": {
-                "fieldPath": {
-                    "type": "string"
-                },
-                "path": {
-                    "description": "pod internal path",
+                },
+                "state": {
+                    "description": "Running | Pending | Missing | Skipped | Misscheduled",
                     "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.EnvVar": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.Deployment": {
             "type": "object",
             "properties": {
+                "age": {
+                    "type": "integer"
+                },
+                "available": {
+                    "description": "Available 字段表示 Deployment 中可用的 Pod 副本数",
+                    "type": "integer"
+                },
                 "name": {
                     "type": "string"
                 },
-                "refName": {
+                "namespace": {
                     "type": "string"
                 },
-                "type": {
-                    "description": "configMap | secret | default(k/v)",
-                    "type": "string"
+                "ready": {
+                    "description": "Ready 字段表示 Deployment 中正在运行的 Pod 副本的数量",
+                    "type": "integer"
                 },
-                "value": {
-                    "type": "string"
+                "replicas": {
+                    "type": "integer"
+                },
+                "upToDate": {
+                    "description": "UpToDate 字段表示与 Deployment 所期望的副本数相比，有多少个 Pod 副本是最新的",
+                    "type": "integer"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.EnvVarFromResource": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.HPA": {
             "type": "object",
             "properties": {
+                "age": {
+                    "type": "integer"
+                },
+                "currentReplicas": {
+                    "type": "integer"
+                },
+                "desiredReplicas": {
+                    "type": "integer"
+                },
+                "maxReplicas": {
+                    "type": "integer"
+                },
+                "minReplicas": {
+                    "type": "integer"
+                },
                 "name": {
                     "type": "string"
                 },
-                "prefix": {
+                "namespace": {
                     "type": "string"
                 },
-                "refType": {
-                    "description": "configMap | secret",
-                    "type": "string"
-                }
-            }
-        },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.HostPathVolume": {
-            "type": "object",
-            "properties": {
-                "path": {
-                    "description": "host path",
+                "target": {
+                    "description": "Kind/Name of the scaled workload",
                     "type": "string"
                 },
-                "type": {
-                    "$ref": "#/definitions/v1.HostPathType"
+                "targets": {
+                    "description": "current/target of every metric, like kubectl get hpa",
+                    "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.Ingress": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.HPAConditionStatus": {
             "type": "object",
             "properties": {
-                "labels": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
-                    }
+                "lastTransitionTime": {
+                    "type": "integer"
                 },
-                "name": {
+                "message": {
                     "type": "string"
                 },
-                "namespace": {
+                "reason": {
                     "type": "string"
                 },
-                "rules": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.IngressRule"
-                    }
+                "status": {
+                    "type": "string"
+                },
+                "type": {
+                    "description": "AbleToScale | ScalingActive | ScalingLimited",
+                    "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.IngressRoute": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.HPAMetricStatus": {
             "type": "object",
             "properties": {
-                "ingressRouteSpec": {
-                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.IngressRouteSpec"
-                },
-                "labels": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
-                    }
+                "current": {
+                    "description": "\u003cunknown\u003e until the metric is collected",
+                    "type": "string"
                 },
                 "name": {
+                    "description": "resource or metric name",
                     "type": "string"
                 },
-                "namespace": {
+                "target": {
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Resource | Pods | Object | External",
                     "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.IngressRouteSpec": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.HPAStatus": {
             "type": "object",
             "properties": {
-                "entryPoints": {
+                "conditions": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.HPAConditionStatus"
                     }
                 },
-                "routes": {
+                "currentReplicas": {
+                    "type": "integer"
+                },
+                "desiredReplicas": {
+                    "type": "integer"
+                },
+                "lastScaleTime": {
+                    "description": "0 when it has never scaled",
+                    "type": "integer"
+                },
+                "maxReplicas": {
+                    "type": "integer"
+                },
+                "metrics": {
                     "type": "array",
                     "items": {
-                        "type": "object",
-                        "properties": {
-                            "kind": {
-                                "type": "string"
-                            },
-                            "match": {
-                                "type": "string"
-                            },
-                            "middlewares": {
-                                "type": "array",
-                                "items": {
-                                    "type": "object",
-                                    "properties": {
-                                        "name": {
-                                            "type": "string"
-                                        }
-                                    }
-                                }
-                            },
-                            "services": {
-                                "type": "array",
-                                "items": {
-                                    "type": "object",
-                                    "properties": {
-                                        "name": {
-                                            "type": "string"
-                                        },
-                                        "port": {
-                                            "type": "integer"
-                                        }
-                                    }
-                                }
-                            }
-                        }
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.HPAMetricStatus"
                     }
                 },
-                "tls": {
-                    "type": "object",
-                    "properties": {
-                        "secretName": {
-                            "type": "string"
-                        }
-                    }
-                }
-            }
-        },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.IngressRule": {
-            "type": "object",
-            "properties": {
-                "host": {
+                "minReplicas": {
+                    "type": "integer"
+                },
+                "name": {
                     "type": "string"
                 },
-                "value": {
-                    "$ref": "#/definitions/v1.IngressRuleValue"
-                }
-            }
-        },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.Item": {
-            "type": "object",
-            "properties": {
-                "key": {
+                "namespace": {
                     "type": "string"
                 },
-                "value": {
+                "target": {
                     "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.Job": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.Ingress": {
             "type": "object",
             "properties": {
-                "completions": {
-                    "description": "Job 的 Pod 副本数，全部副本数运行成功，才能代表job运行成功",
+                "age": {
                     "type": "integer"
                 },
-                "labels": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
-                    }
+                "class": {
+                    "type": "string"
+                },
+                "hosts": {
+                    "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
                 "namespace": {
                     "type": "string"
-                },
-                "template": {
-                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Pod"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.JobBase": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.IngressRoute": {
             "type": "object",
             "properties": {
-                "backoffLimit": {
+                "age": {
                     "type": "integer"
                 },
-                "completions": {
-                    "type": "integer"
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.NFSVolumeSource": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.Item": {
             "type": "object",
             "properties": {
-                "nfsPath": {
+                "key": {
                     "type": "string"
                 },
-                "nfsServer": {
+                "value": {
                     "type": "string"
-                },
-                "readOnly": {
-                    "type": "boolean"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.Network": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.Job": {
             "type": "object",
             "properties": {
-                "dnsConfig": {
-                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.DnsConfig"
+                "active": {
+                    "description": "正在运行的 Pod 数",
+                    "type": "integer"
                 },
-                "dnsPolicy": {
+                "age": {
+                    "type": "integer"
+                },
+                "completions": {
+                    "description": "控制 Job 成功完成的实例数目的  当指定的实例数目达到 Completions 字段所设定的值时，Job 将被标记为成功完成",
+                    "type": "integer"
+                },
+                "duration": {
+                    "description": "Job 的持续时间",
+                    "type": "integer"
+                },
+                "failed": {
+                    "description": "失败的 Pod 数",
+                    "type": "integer"
+                },
+                "name": {
                     "type": "string"
                 },
-                "hostAliases": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
-                    }
+                "namespace": {
+                    "type": "string"
                 },
-                "hostName": {
+                "status": {
+                    "description": "Running | Complete | Failed | Suspended",
                     "type": "string"
                 },
-                "hostNetwork": {
-                    "type": "boolean"
+                "succeeded": {
+                    "description": "就绪的 Job 个数",
+                    "type": "integer"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.NodeAffinityTermExpressions": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.JobContainerStatus": {
             "type": "object",
             "properties": {
-                "key": {
+                "exitCode": {
+                    "description": "exit code of the terminated container, or of its last run",
+                    "type": "integer"
+                },
+                "name": {
                     "type": "string"
                 },
-                "operator": {
-                    "$ref": "#/definitions/v1.NodeSelectorOperator"
+                "reason": {
+                    "type": "string"
                 },
-                "value": {
+                "restarts": {
+                    "type": "integer"
+                },
+                "state": {
+                    "description": "Waiting | Running | Terminated",
                     "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.NodeScheduling": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.JobPod": {
             "type": "object",
             "properties": {
-                "nodeAffinity": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.NodeAffinityTermExpressions"
-                    }
-                },
-                "nodeName": {
+                "completionIndex": {
+                    "description": "only for Indexed Jobs",
                     "type": "string"
                 },
-                "nodeSelector": {
+                "containers": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.JobContainerStatus"
                     }
                 },
-                "type": {
-                    "description": "nodeName | nodeSelector | nodeAffinity",
+                "name": {
+                    "type": "string"
+                },
+                "node": {
+                    "type": "string"
+                },
+                "phase": {
                     "type": "string"
+                },
+                "startTime": {
+                    "type": "integer"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.PVCVolume": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.MetricsItem": {
             "type": "object",
             "properties": {
-                "claimName": {
+                "color": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "value": {
                     "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.PersistentVolume": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.Namespace": {
             "type": "object",
             "properties": {
-                "accessModes": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/v1.PersistentVolumeAccessMode"
-                    }
-                },
-                "capacity": {
-                    "type": "string"
-                },
-                "labels": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
-                    }
+                "createTime": {
+                    "type": "integer"
                 },
                 "name": {
                     "type": "string"
                 },
-                "reclaimPolicy": {
-                    "$ref": "#/definitions/v1.PersistentVolumeReclaimPolicy"
-                },
-                "volumeSource": {
-                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.VolumeSource"
+                "status": {
+                    "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.PersistentVolumeClaim": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.NetworkPolicy": {
             "type": "object",
             "properties": {
-                "accessModes": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/v1.PersistentVolumeAccessMode"
-                    }
+                "age": {
+                    "type": "integer"
                 },
-                "capacity": {
-                    "type": "string"
+                "egressRules": {
+                    "type": "integer"
                 },
-                "labels": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
-                    }
+                "ingressRules": {
+                    "type": "integer"
                 },
                 "name": {
                     "type": "string"
@@ -4312,198 +10738,140 @@ const docTemplate = `{
                 "namespace": {
                     "type": "string"
                 },
-                "selector": {
+                "podSelector": {
+                    "description": "label selector string, \u003cnone\u003e selects every pod",
+                    "type": "string"
+                },
+                "policyTypes": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                        "type": "string"
                     }
-                },
-                "storageClassName": {
-                    "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.Pod": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.NodeDetail": {
             "type": "object",
             "properties": {
-                "base": {
-                    "description": "base definition info",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Base"
-                        }
-                    ]
+                "OSImage": {
+                    "type": "string"
                 },
-                "containers": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Container"
-                    }
+                "age": {
+                    "type": "integer"
                 },
-                "initContainers": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Container"
-                    }
+                "containerRuntime": {
+                    "type": "string"
                 },
-                "network": {
-                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Network"
+                "externalIP": {
+                    "type": "string"
                 },
-                "nodeScheduling": {
-                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.NodeScheduling"
+                "internalIP": {
+                    "type": "string"
                 },
-                "tolerations": {
-                    "description": "pod toleration params",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/v1.Toleration"
-                    }
+                "kernelVersion": {
+                    "type": "string"
                 },
-                "volume": {
+                "labels": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Volume"
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Item"
                     }
-                }
-            }
-        },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.ProbeCommand": {
-            "type": "object",
-            "properties": {
-                "command": {
-                    "description": "cat /test/test.txt",
+                },
+                "name": {
+                    "type": "string"
+                },
+                "resourceVersion": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "taints": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/v1.Taint"
                     }
+                },
+                "version": {
+                    "description": "kubelet version",
+                    "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.ProbeHTTPGet": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.NodeListItem": {
             "type": "object",
             "properties": {
-                "headers": {
-                    "description": "http headers",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
-                    }
+                "OSImage": {
+                    "type": "string"
                 },
-                "host": {
-                    "description": "If \"\", it's an in-pod request.",
+                "age": {
+                    "type": "integer"
+                },
+                "containerRuntime": {
                     "type": "string"
                 },
-                "path": {
+                "externalIP": {
                     "type": "string"
                 },
-                "port": {
-                    "type": "integer"
+                "internalIP": {
+                    "type": "string"
                 },
-                "scheme": {
-                    "description": "http | https",
+                "kernelVersion": {
                     "type": "string"
-                }
-            }
-        },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.ProbeTcpSocket": {
-            "type": "object",
-            "properties": {
-                "host": {
+                },
+                "name": {
                     "type": "string"
                 },
-                "port": {
-                    "type": "integer"
+                "status": {
+                    "type": "string"
+                },
+                "version": {
+                    "description": "kubelet version",
+                    "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.Resource": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.PDB": {
             "type": "object",
             "properties": {
-                "CPULimit": {
+                "age": {
                     "type": "integer"
                 },
-                "CPUReq": {
-                    "description": "cpu m",
+                "currentHealthy": {
                     "type": "integer"
                 },
-                "enable": {
-                    "description": "Whether to configure container quotas",
-                    "type": "boolean"
+                "desiredHealthy": {
+                    "type": "integer"
                 },
-                "memory": {
-                    "description": "memory mi",
+                "disruptionsAllowed": {
                     "type": "integer"
                 },
-                "memoryLimit": {
+                "expectedPods": {
                     "type": "integer"
-                }
-            }
-        },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.Role": {
-            "type": "object",
-            "properties": {
-                "labels": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
-                    }
                 },
-                "name": {
+                "maxUnavailable": {
                     "type": "string"
                 },
-                "namespace": {
-                    "description": "Namespace == \"\" ? ClusterRole : Role",
+                "minAvailable": {
                     "type": "string"
                 },
-                "rules": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/v1.PolicyRule"
-                    }
-                }
-            }
-        },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.RoleBinding": {
-            "type": "object",
-            "properties": {
-                "labels": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
-                    }
-                },
                 "name": {
                     "type": "string"
                 },
                 "namespace": {
-                    "description": "Namespace == \"\" ?  ClusterRoleBinding: RoleBinding",
                     "type": "string"
                 },
-                "roleRef": {
+                "selector": {
+                    "description": "label selector string",
                     "type": "string"
-                },
-                "subjects": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Subject"
-                    }
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.Secret": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.PDBViolation": {
             "type": "object",
             "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
-                    }
-                },
-                "labels": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
-                    }
+                "disruptionsAllowed": {
+                    "type": "integer"
                 },
                 "name": {
                     "type": "string"
@@ -4511,148 +10879,173 @@ const docTemplate = `{
                 "namespace": {
                     "type": "string"
                 },
-                "type": {
-                    "description": "Opaque | kubernetes.io/dockerconfigjson",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/v1.SecretType"
-                        }
-                    ]
+                "pods": {
+                    "description": "the evicted pods it protects",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.SecretRefVolume": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.PVCCondition": {
             "type": "object",
             "properties": {
-                "name": {
+                "lastTransitionTime": {
+                    "type": "integer"
+                },
+                "message": {
                     "type": "string"
                 },
-                "optional": {
-                    "type": "boolean"
+                "reason": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Resizing | FileSystemResizePending | ...",
+                    "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.Service": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.PVCDiagnosis": {
             "type": "object",
             "properties": {
-                "labels": {
+                "bindingMode": {
+                    "type": "string"
+                },
+                "cause": {
+                    "description": "Cause is Bound | Lost | WaitForFirstConsumer | NoMatchingVolume | StorageClassNotFound |\nSelectorNotSupported | ProvisionerMissing | ProvisioningFailed | Provisioning",
+                    "type": "string"
+                },
+                "events": {
+                    "description": "recent events of the claim, newest last",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                        "type": "string"
                     }
                 },
-                "name": {
+                "message": {
                     "type": "string"
                 },
-                "namespace": {
+                "provisioner": {
                     "type": "string"
                 },
-                "ports": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ServicePort"
-                    }
+                "status": {
+                    "$ref": "#/definitions/v1.PersistentVolumeClaimPhase"
                 },
-                "selector": {
+                "storageClass": {
+                    "type": "string"
+                },
+                "volumes": {
+                    "description": "why the volumes of the class do not match the claim",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                        "type": "string"
                     }
-                },
-                "type": {
-                    "$ref": "#/definitions/v1.ServiceType"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.ServiceAccount": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.PVCMount": {
             "type": "object",
             "properties": {
-                "labels": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
-                    }
+                "node": {
+                    "type": "string"
                 },
-                "name": {
+                "phase": {
                     "type": "string"
                 },
-                "namespace": {
+                "pod": {
                     "type": "string"
+                },
+                "readOnly": {
+                    "type": "boolean"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.ServicePort": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.PVCVolume": {
             "type": "object",
             "properties": {
-                "name": {
+                "capacity": {
                     "type": "string"
                 },
-                "nodePort": {
-                    "type": "integer"
+                "name": {
+                    "type": "string"
                 },
-                "port": {
-                    "type": "integer"
+                "reclaimPolicy": {
+                    "$ref": "#/definitions/v1.PersistentVolumeReclaimPolicy"
                 },
-                "targetPort": {
-                    "type": "integer"
+                "status": {
+                    "$ref": "#/definitions/v1.PersistentVolumePhase"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.StatefulSet": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.PersistentVolumeClaim": {
             "type": "object",
             "properties": {
-                "labels": {
+                "accessModes": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                        "$ref": "#/definitions/v1.PersistentVolumeAccessMode"
                     }
                 },
+                "age": {
+                    "type": "integer"
+                },
+                "capacity": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
                 "namespace": {
                     "type": "string"
                 },
-                "replicas": {
-                    "type": "integer"
+                "status": {
+                    "$ref": "#/definitions/v1.PersistentVolumeClaimPhase"
                 },
-                "selector": {
+                "volume": {
+                    "description": "PV name",
+                    "type": "string"
+                },
+                "volumeAttributeClass": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.PersistentVolumeClaimDetail": {
+            "type": "object",
+            "properties": {
+                "accessModes": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                        "$ref": "#/definitions/v1.PersistentVolumeAccessMode"
                     }
                 },
-                "serviceName": {
-                    "type": "string"
+                "age": {
+                    "type": "integer"
                 },
-                "template": {
-                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Pod"
+                "capacity": {
+                    "description": "capacity of the bound volume, empty while pending",
+                    "type": "string"
                 },
-                "volumeClaimTemplates": {
+                "conditions": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.PersistentVolumeClaim"
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.PVCCondition"
                     }
-                }
-            }
-        },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.StorageClass": {
-            "type": "object",
-            "properties": {
-                "allowVolumeExpansion": {
-                    "description": "whether permit expand",
-                    "type": "boolean"
                 },
                 "labels": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Item"
                     }
                 },
-                "mountOptions": {
+                "mountedBy": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.PVCMount"
                     }
                 },
                 "name": {
@@ -4661,642 +11054,649 @@ const docTemplate = `{
                 "namespace": {
                     "type": "string"
                 },
-                "parameters": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
-                    }
+                "requested": {
+                    "description": "spec.resources.requests.storage",
+                    "type": "string"
                 },
-                "provisioner": {
+                "resizing": {
+                    "description": "Resizing is set while an expansion is in progress, from the request up to the volume capacity",
+                    "type": "boolean"
+                },
+                "status": {
+                    "$ref": "#/definitions/v1.PersistentVolumeClaimPhase"
+                },
+                "storageClass": {
+                    "description": "empty for a claim bound only to volumes without a class",
                     "type": "string"
                 },
-                "reclaimPolicy": {
-                    "description": "Delete | Retain",
+                "volume": {
+                    "description": "nil while pending",
                     "allOf": [
                         {
-                            "$ref": "#/definitions/v1.PersistentVolumeReclaimPolicy"
+                            "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.PVCVolume"
                         }
                     ]
                 },
-                "volumeBindingMode": {
-                    "$ref": "#/definitions/v1.VolumeBindingMode"
+                "volumeMode": {
+                    "$ref": "#/definitions/v1.PersistentVolumeMode"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.Subject": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.PersistentVolumeItem": {
             "type": "object",
             "properties": {
-                "kind": {
-                    "type": "string"
+                "accessModes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.PersistentVolumeAccessMode"
+                    }
                 },
-                "name": {
+                "age": {
+                    "type": "integer"
+                },
+                "capacity": {
                     "type": "string"
                 },
-                "namespace": {
+                "claim": {
+                    "description": "bind for pvc",
                     "type": "string"
-                }
-            }
-        },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.UpdateLabelReq": {
-            "type": "object",
-            "properties": {
+                },
                 "labels": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.Item"
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Item"
                     }
                 },
-                "name": {
-                    "type": "string"
-                }
-            }
-        },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.UpdateTaintReq": {
-            "type": "object",
-            "properties": {
                 "name": {
                     "type": "string"
                 },
-                "taints": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/v1.Taint"
-                    }
-                }
-            }
-        },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.Volume": {
-            "type": "object",
-            "properties": {
-                "PVCVolume": {
-                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.PVCVolume"
+                "reason": {
+                    "type": "string"
                 },
-                "configMapRefVolume": {
-                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.ConfigMapRefVolume"
+                "reclaimPolicy": {
+                    "$ref": "#/definitions/v1.PersistentVolumeReclaimPolicy"
                 },
-                "downwardAPIVolume": {
-                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.DownwardAPIVolume"
+                "source": {
+                    "description": "nfs | hostPath | local | csi | iscsi, empty for other types",
+                    "type": "string"
                 },
-                "hostPathVolume": {
-                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.HostPathVolume"
+                "status": {
+                    "$ref": "#/definitions/v1.PersistentVolumePhase"
                 },
-                "name": {
+                "storageClass": {
                     "type": "string"
                 },
-                "secretRefVolume": {
-                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.SecretRefVolume"
-                },
-                "type": {
-                    "description": "emptyDir | configMap | secret | hostPath | downward | pvc",
+                "volumeAttributeClass": {
                     "type": "string"
+                },
+                "volumeMode": {
+                    "$ref": "#/definitions/v1.PersistentVolumeMode"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.VolumeMount": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.PodListItem": {
             "type": "object",
             "properties": {
-                "mountName": {
+                "age": {
+                    "description": "Runtime",
+                    "type": "integer"
+                },
+                "ip": {
+                    "description": "Pod id",
                     "type": "string"
                 },
-                "mountPath": {
-                    "description": "Mounted volume -\u003e path in the corresponding container",
+                "name": {
                     "type": "string"
                 },
-                "readOnly": {
-                    "description": "Read-only or not",
-                    "type": "boolean"
-                }
-            }
-        },
-        "github_com_crazyfrankie_kube-ctl_internal_model_req.VolumeSource": {
-            "type": "object",
-            "properties": {
-                "nfs": {
-                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_req.NFSVolumeSource"
+                "node": {
+                    "description": "Which Node the Pod is dispatched to",
+                    "type": "string"
                 },
-                "type": {
+                "ready": {
+                    "description": "0/1 | 1/1",
+                    "type": "string"
+                },
+                "restarts": {
+                    "description": "Number of restarts",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "Running | Error",
                     "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_resp.ConfigMap": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.PodResourcePreview": {
             "type": "object",
             "properties": {
-                "age": {
-                    "type": "integer"
+                "containers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.ContainerResourcePreview"
+                    }
                 },
-                "dataNum": {
-                    "type": "integer"
+                "limitRanges": {
+                    "description": "LimitRanges of the namespace, in the order they apply",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "name": {
+                "qosClass": {
+                    "description": "Guaranteed | Burstable | BestEffort",
                     "type": "string"
                 },
-                "namespace": {
-                    "type": "string"
+                "violations": {
+                    "description": "min, max and ratio bounds the LimitRanges would reject the pod for",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_resp.ConfigMapDetail": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.PolicyVerdict": {
             "type": "object",
             "properties": {
-                "age": {
-                    "type": "integer"
+                "allowed": {
+                    "description": "the traffic passes in this direction",
+                    "type": "boolean"
                 },
-                "data": {
+                "decidedBy": {
+                    "description": "the policies with a rule allowing the traffic",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Item"
+                        "type": "string"
                     }
                 },
-                "dataNum": {
-                    "type": "integer"
+                "isolated": {
+                    "description": "some policy selects the pod for this direction, so unmatched traffic is denied",
+                    "type": "boolean"
                 },
-                "labels": {
+                "policies": {
+                    "description": "the policies selecting the pod for this direction",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Item"
+                        "type": "string"
                     }
                 },
-                "name": {
-                    "type": "string"
-                },
-                "namespace": {
+                "reason": {
                     "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_resp.CronJob": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.Reachability": {
             "type": "object",
             "properties": {
-                "active": {
-                    "type": "integer"
-                },
-                "age": {
-                    "type": "integer"
-                },
-                "lastScheduleTime": {
-                    "type": "integer"
-                },
-                "name": {
-                    "type": "string"
+                "allowed": {
+                    "type": "boolean"
                 },
-                "namespace": {
-                    "type": "string"
+                "egress": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.PolicyVerdict"
                 },
-                "schedule": {
-                    "type": "string"
+                "ingress": {
+                    "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.PolicyVerdict"
                 },
-                "suspend": {
-                    "type": "boolean"
+                "port": {
+                    "description": "destination port the named port resolved to",
+                    "type": "integer"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_resp.DaemonSet": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.Role": {
             "type": "object",
             "properties": {
                 "age": {
                     "type": "integer"
                 },
-                "available": {
-                    "description": "表示可用的守护进程副本数，即已经就绪并且可以提供服务的守护进程副本数量",
-                    "type": "integer"
-                },
-                "current": {
-                    "description": "表示当前正在运行的守护进程副本数，即当前在集群中实际运行的守护进程副本数量。",
-                    "type": "integer"
-                },
-                "desired": {
-                    "description": "表示 DaemonSet 所需的守护进程副本数，即期望在集群中运行的守护进程副本数量。",
-                    "type": "integer"
-                },
                 "name": {
                     "type": "string"
                 },
                 "namespace": {
                     "type": "string"
-                },
-                "ready": {
-                    "description": "表示已经就绪的守护进程副本数，即当前运行且已准备好接收工作负载的守护进程副本数量。",
-                    "type": "integer"
-                },
-                "upToDate": {
-                    "description": "表示已经更新到最新版本的守护进程副本数，即已经与 DaemonSet 的定义保持一致的守护进程副本数量",
-                    "type": "integer"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_resp.Deployment": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.RoleBinding": {
             "type": "object",
             "properties": {
                 "age": {
                     "type": "integer"
                 },
-                "available": {
-                    "description": "Available 字段表示 Deployment 中可用的 Pod 副本数",
-                    "type": "integer"
-                },
                 "name": {
                     "type": "string"
                 },
                 "namespace": {
                     "type": "string"
-                },
-                "ready": {
-                    "description": "Ready 字段表示 Deployment 中正在运行的 Pod 副本的数量",
-                    "type": "integer"
-                },
-                "replicas": {
-                    "type": "integer"
-                },
-                "upToDate": {
-                    "description": "UpToDate 字段表示与 Deployment 所期望的副本数相比，有多少个 Pod 副本是最新的",
-                    "type": "integer"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_resp.Ingress": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.Secret": {
             "type": "object",
             "properties": {
                 "age": {
                     "type": "integer"
                 },
-                "class": {
-                    "type": "string"
-                },
-                "hosts": {
-                    "type": "string"
+                "dataNum": {
+                    "type": "integer"
                 },
                 "name": {
                     "type": "string"
                 },
                 "namespace": {
                     "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/v1.SecretType"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_resp.IngressRoute": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.SecretDetail": {
             "type": "object",
             "properties": {
                 "age": {
                     "type": "integer"
                 },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.SecretItem"
+                    }
+                },
+                "dataNum": {
+                    "type": "integer"
+                },
+                "labels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Item"
+                    }
+                },
                 "name": {
                     "type": "string"
                 },
                 "namespace": {
                     "type": "string"
+                },
+                "resourceVersion": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/v1.SecretType"
+                },
+                "usedBy": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Consumer"
+                    }
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_resp.Item": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.SecretItem": {
             "type": "object",
             "properties": {
+                "base64": {
+                    "description": "revealed binary value is base64 encoded",
+                    "type": "boolean"
+                },
                 "key": {
                     "type": "string"
                 },
+                "size": {
+                    "description": "decoded length in bytes",
+                    "type": "integer"
+                },
                 "value": {
+                    "description": "masked unless revealed",
                     "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_resp.Job": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.Service": {
             "type": "object",
             "properties": {
                 "age": {
                     "type": "integer"
                 },
-                "completions": {
-                    "description": "控制 Job 成功完成的实例数目的  当指定的实例数目达到 Completions 字段所设定的值时，Job 将被标记为成功完成",
-                    "type": "integer"
-                },
-                "duration": {
-                    "description": "Job 的持续时间",
-                    "type": "integer"
-                },
-                "name": {
+                "clusterIP": {
                     "type": "string"
                 },
-                "namespace": {
-                    "type": "string"
+                "externalIP": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "succeeded": {
-                    "description": "就绪的 Job 个数",
-                    "type": "integer"
-                }
-            }
-        },
-        "github_com_crazyfrankie_kube-ctl_internal_model_resp.MetricsItem": {
-            "type": "object",
-            "properties": {
-                "color": {
+                "externalName": {
                     "type": "string"
                 },
-                "label": {
+                "name": {
                     "type": "string"
                 },
-                "title": {
+                "namespace": {
                     "type": "string"
                 },
-                "value": {
-                    "type": "string"
+                "type": {
+                    "$ref": "#/definitions/v1.ServiceType"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_resp.Namespace": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.ServiceAccount": {
             "type": "object",
             "properties": {
-                "createTime": {
+                "age": {
                     "type": "integer"
                 },
                 "name": {
                     "type": "string"
                 },
-                "status": {
+                "namespace": {
                     "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_resp.NodeDetail": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.ServiceEndpoint": {
             "type": "object",
             "properties": {
-                "OSImage": {
-                    "type": "string"
-                },
-                "age": {
-                    "type": "integer"
-                },
-                "containerRuntime": {
+                "address": {
                     "type": "string"
                 },
-                "externalIP": {
-                    "type": "string"
-                },
-                "internalIP": {
+                "node": {
                     "type": "string"
                 },
-                "kernelVersion": {
+                "pod": {
                     "type": "string"
                 },
-                "labels": {
+                "ports": {
+                    "description": "name/port/protocol",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Item"
+                        "type": "string"
                     }
                 },
-                "name": {
-                    "type": "string"
-                },
-                "status": {
-                    "type": "string"
+                "ready": {
+                    "type": "boolean"
                 },
-                "taints": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/v1.Taint"
-                    }
+                "serving": {
+                    "type": "boolean"
                 },
-                "version": {
-                    "description": "kubelet version",
-                    "type": "string"
+                "terminating": {
+                    "type": "boolean"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_resp.NodeListItem": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.ServicePortCheck": {
             "type": "object",
             "properties": {
-                "OSImage": {
+                "name": {
                     "type": "string"
                 },
-                "age": {
+                "port": {
                     "type": "integer"
                 },
-                "containerRuntime": {
-                    "type": "string"
-                },
-                "externalIP": {
-                    "type": "string"
-                },
-                "internalIP": {
-                    "type": "string"
-                },
-                "kernelVersion": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "status": {
-                    "type": "string"
+                "resolved": {
+                    "description": "whether the targetPort exists on the matched containers",
+                    "type": "boolean"
                 },
-                "version": {
-                    "description": "kubelet version",
+                "targetPort": {
                     "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_resp.PersistentVolumeClaim": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.ServiceRoute": {
             "type": "object",
             "properties": {
-                "accessModes": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/v1.PersistentVolumeAccessMode"
-                    }
-                },
-                "age": {
-                    "type": "integer"
-                },
-                "capacity": {
+                "host": {
+                    "description": "ingress host or IngressRoute match rule",
                     "type": "string"
                 },
-                "name": {
+                "kind": {
+                    "description": "Ingress | IngressRoute",
                     "type": "string"
                 },
-                "namespace": {
+                "name": {
                     "type": "string"
                 },
-                "status": {
-                    "$ref": "#/definitions/v1.PersistentVolumeClaimPhase"
-                },
-                "volume": {
-                    "description": "PV name",
+                "path": {
                     "type": "string"
                 },
-                "volumeAttributeClass": {
+                "port": {
                     "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_resp.PersistentVolumeItem": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.ServiceTopology": {
             "type": "object",
             "properties": {
-                "accessModes": {
+                "clusterIP": {
+                    "type": "string"
+                },
+                "endpoints": {
+                    "description": "resolved from EndpointSlices",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/v1.PersistentVolumeAccessMode"
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.ServiceEndpoint"
                     }
                 },
-                "age": {
-                    "type": "integer"
-                },
-                "capacity": {
+                "name": {
                     "type": "string"
                 },
-                "claim": {
-                    "description": "bind for pvc",
+                "namespace": {
                     "type": "string"
                 },
-                "labels": {
+                "pods": {
+                    "description": "pods matched by the selector",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Item"
+                        "type": "string"
                     }
                 },
-                "name": {
-                    "type": "string"
-                },
-                "reason": {
-                    "type": "string"
+                "ports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.ServicePortCheck"
+                    }
                 },
-                "reclaimPolicy": {
-                    "$ref": "#/definitions/v1.PersistentVolumeReclaimPolicy"
+                "routes": {
+                    "description": "Ingresses / IngressRoutes that route to the service",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.ServiceRoute"
+                    }
                 },
-                "status": {
-                    "$ref": "#/definitions/v1.PersistentVolumePhase"
+                "selector": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Item"
+                    }
                 },
-                "storageClass": {
-                    "type": "string"
+                "type": {
+                    "$ref": "#/definitions/v1.ServiceType"
                 },
-                "volumeAttributeClass": {
-                    "type": "string"
+                "warnings": {
+                    "description": "why the service may answer 503",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_resp.PodListItem": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.StatefulSet": {
             "type": "object",
             "properties": {
                 "age": {
-                    "description": "Runtime",
                     "type": "integer"
                 },
-                "ip": {
-                    "description": "Pod id",
-                    "type": "string"
-                },
                 "name": {
                     "type": "string"
                 },
-                "node": {
-                    "description": "Which Node the Pod is dispatched to",
+                "namespace": {
                     "type": "string"
                 },
                 "ready": {
-                    "description": "0/1 | 1/1",
-                    "type": "string"
+                    "type": "integer"
                 },
-                "restarts": {
-                    "description": "Number of restarts",
+                "replicas": {
                     "type": "integer"
                 },
-                "status": {
-                    "description": "Running | Error",
-                    "type": "string"
+                "updated": {
+                    "description": "pods at the update revision",
+                    "type": "integer"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_resp.Role": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.StatefulSetPVC": {
             "type": "object",
             "properties": {
                 "age": {
                     "type": "integer"
                 },
+                "capacity": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
-                "namespace": {
+                "ordinal": {
+                    "type": "integer"
+                },
+                "orphaned": {
+                    "description": "Orphaned is set when the ordinal is outside the replicas and no pod uses the claim any more",
+                    "type": "boolean"
+                },
+                "status": {
+                    "$ref": "#/definitions/v1.PersistentVolumeClaimPhase"
+                },
+                "storageClass": {
+                    "type": "string"
+                },
+                "template": {
+                    "description": "volumeClaimTemplates entry it was created from",
                     "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_resp.RoleBinding": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.StatefulSetPod": {
             "type": "object",
             "properties": {
-                "age": {
+                "name": {
+                    "type": "string"
+                },
+                "node": {
+                    "type": "string"
+                },
+                "ordinal": {
                     "type": "integer"
                 },
-                "name": {
+                "phase": {
+                    "description": "pod phase, Missing when the ordinal has no pod yet",
                     "type": "string"
                 },
-                "namespace": {
+                "ready": {
+                    "type": "boolean"
+                },
+                "restarts": {
+                    "type": "integer"
+                },
+                "revision": {
                     "type": "string"
+                },
+                "updated": {
+                    "description": "the pod runs the update revision",
+                    "type": "boolean"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_resp.Secret": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.StatefulSetRollout": {
             "type": "object",
             "properties": {
-                "age": {
-                    "type": "integer"
+                "currentRevision": {
+                    "type": "string"
                 },
-                "dataNum": {
+                "partition": {
                     "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "pods": {
+                    "description": "ordered by ordinal",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.StatefulSetPod"
+                    }
                 },
-                "namespace": {
+                "replicas": {
+                    "type": "integer"
+                },
+                "updateRevision": {
                     "type": "string"
                 },
-                "type": {
-                    "$ref": "#/definitions/v1.SecretType"
+                "updated": {
+                    "type": "integer"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_resp.SecretDetail": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.StorageClass": {
             "type": "object",
             "properties": {
                 "age": {
                     "type": "integer"
                 },
-                "data": {
+                "allowVolumeExpansion": {
+                    "type": "boolean"
+                },
+                "isDefault": {
+                    "description": "claims without a StorageClass get this one",
+                    "type": "boolean"
+                },
+                "labels": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Item"
                     }
                 },
-                "dataNum": {
-                    "type": "integer"
-                },
-                "labels": {
+                "mountOptions": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Item"
+                        "type": "string"
                     }
                 },
                 "name": {
                     "type": "string"
                 },
-                "namespace": {
+                "parameters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Item"
+                    }
+                },
+                "provisioner": {
                     "type": "string"
                 },
-                "type": {
-                    "$ref": "#/definitions/v1.SecretType"
+                "reclaimPolicy": {
+                    "$ref": "#/definitions/v1.PersistentVolumeReclaimPolicy"
+                },
+                "volumeBindingMode": {
+                    "$ref": "#/definitions/v1.VolumeBindingMode"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_resp.Service": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.VolumeSnapshot": {
             "type": "object",
             "properties": {
                 "age": {
                     "type": "integer"
                 },
-                "clusterIP": {
+                "creationTime": {
+                    "description": "when the storage system took the snapshot, 0 until taken",
+                    "type": "integer"
+                },
+                "error": {
                     "type": "string"
                 },
-                "externalIP": {
+                "labels": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Item"
                     }
                 },
                 "name": {
@@ -5305,52 +11705,39 @@ const docTemplate = `{
                 "namespace": {
                     "type": "string"
                 },
-                "type": {
-                    "$ref": "#/definitions/v1.ServiceType"
-                }
-            }
-        },
-        "github_com_crazyfrankie_kube-ctl_internal_model_resp.ServiceAccount": {
-            "type": "object",
-            "properties": {
-                "age": {
-                    "type": "integer"
+                "pvc": {
+                    "description": "source claim, empty for a snapshot imported from an existing content",
+                    "type": "string"
                 },
-                "name": {
+                "readyToUse": {
+                    "type": "boolean"
+                },
+                "restoreSize": {
+                    "description": "minimum size of a claim restored from it, empty until taken",
                     "type": "string"
                 },
-                "namespace": {
+                "snapshotClass": {
+                    "type": "string"
+                },
+                "snapshotContent": {
+                    "description": "the bound VolumeSnapshotContent",
                     "type": "string"
                 }
             }
         },
-        "github_com_crazyfrankie_kube-ctl_internal_model_resp.StatefulSet": {
+        "github_com_crazyfrankie_kube-ctl_internal_model_resp.VolumeSnapshotClass": {
             "type": "object",
             "properties": {
                 "age": {
                     "type": "integer"
                 },
-                "name": {
+                "deletionPolicy": {
                     "type": "string"
                 },
-                "namespace": {
+                "driver": {
                     "type": "string"
                 },
-                "ready": {
-                    "type": "integer"
-                },
-                "replicas": {
-                    "type": "integer"
-                }
-            }
-        },
-        "github_com_crazyfrankie_kube-ctl_internal_model_resp.StorageClass": {
-            "type": "object",
-            "properties": {
-                "age": {
-                    "type": "integer"
-                },
-                "allowVolumeExpansion": {
+                "isDefault": {
                     "type": "boolean"
                 },
                 "labels": {
@@ -5359,12 +11746,6 @@ const docTemplate = `{
                         "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Item"
                     }
                 },
-                "mountOptions": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
                 "name": {
                     "type": "string"
                 },
@@ -5373,15 +11754,6 @@ const docTemplate = `{
                     "items": {
                         "$ref": "#/definitions/github_com_crazyfrankie_kube-ctl_internal_model_resp.Item"
                     }
-                },
-                "provisioner": {
-                    "type": "string"
-                },
-                "reclaimPolicy": {
-                    "$ref": "#/definitions/v1.PersistentVolumeReclaimPolicy"
-                },
-                "volumeBindingMode": {
-                    "$ref": "#/definitions/v1.VolumeBindingMode"
                 }
             }
         },
@@ -5397,6 +11769,82 @@ const docTemplate = `{
                 }
             }
         },
+        "intstr.IntOrString": {
+            "type": "object",
+            "properties": {
+                "intVal": {
+                    "type": "integer"
+                },
+                "strVal": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/intstr.Type"
+                }
+            }
+        },
+        "intstr.Type": {
+            "type": "integer",
+            "enum": [
+                0,
+                1
+            ],
+            "x-enum-comments": {
+                "Int": "The IntOrString holds an int.",
+                "String": "The IntOrString holds a string."
+            },
+            "x-enum-varnames": [
+                "Int",
+                "String"
+            ]
+        },
+        "k8s_io_api_core_v1.ConditionStatus": {
+            "type": "string",
+            "enum": [
+                "True",
+                "False",
+                "Unknown"
+            ],
+            "x-enum-varnames": [
+                "ConditionTrue",
+                "ConditionFalse",
+                "ConditionUnknown"
+            ]
+        },
+        "resource.Quantity": {
+            "type": "object",
+            "properties": {
+                "Format": {
+                    "type": "string",
+                    "enum": [
+                        "DecimalExponent",
+                        "BinarySI",
+                        "DecimalSI"
+                    ],
+                    "x-enum-comments": {
+                        "BinarySI": "e.g., 12Mi (12 * 2^20)",
+                        "DecimalExponent": "e.g., 12e6",
+                        "DecimalSI": "e.g., 12M  (12 * 10^6)"
+                    },
+                    "x-enum-varnames": [
+                        "DecimalExponent",
+                        "BinarySI",
+                        "DecimalSI"
+                    ]
+                }
+            }
+        },
+        "v1.CompletionMode": {
+            "type": "string",
+            "enum": [
+                "NonIndexed",
+                "Indexed"
+            ],
+            "x-enum-varnames": [
+                "NonIndexedCompletion",
+                "IndexedCompletion"
+            ]
+        },
         "v1.ConcurrencyPolicy": {
             "type": "string",
             "enum": [
@@ -5410,6 +11858,17 @@ const docTemplate = `{
                 "ReplaceConcurrent"
             ]
         },
+        "v1.DaemonSetUpdateStrategyType": {
+            "type": "string",
+            "enum": [
+                "RollingUpdate",
+                "OnDelete"
+            ],
+            "x-enum-varnames": [
+                "RollingUpdateDaemonSetStrategyType",
+                "OnDeleteDaemonSetStrategyType"
+            ]
+        },
         "v1.HTTPIngressPath": {
             "type": "object",
             "properties": {
@@ -5470,6 +11929,22 @@ const docTemplate = `{
                 "HostPathBlockDev"
             ]
         },
+        "v1.IPBlock": {
+            "type": "object",
+            "properties": {
+                "cidr": {
+                    "description": "cidr is a string representing the IPBlock\nValid examples are \"192.168.1.0/24\" or \"2001:db8::/64\"",
+                    "type": "string"
+                },
+                "except": {
+                    "description": "except is a slice of CIDRs that should not be included within an IPBlock\nValid examples are \"192.168.1.0/24\" or \"2001:db8::/64\"\nExcept values will be rejected if they are outside the cidr range\n+optional\n+listType=atomic",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "v1.IngressBackend": {
             "type": "object",
             "properties": {
@@ -5521,6 +11996,64 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.LabelSelector": {
+            "type": "object",
+            "properties": {
+                "matchExpressions": {
+                    "description": "matchExpressions is a list of label selector requirements. The requirements are ANDed.\n+optional\n+listType=atomic",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.LabelSelectorRequirement"
+                    }
+                },
+                "matchLabels": {
+                    "description": "matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels\nmap is equivalent to an element of matchExpressions, whose key field is \"key\", the\noperator is \"In\", and the values array contains only \"value\". The requirements are ANDed.\n+optional",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "v1.LabelSelectorOperator": {
+            "type": "string",
+            "enum": [
+                "In",
+                "NotIn",
+                "Exists",
+                "DoesNotExist"
+            ],
+            "x-enum-varnames": [
+                "LabelSelectorOpIn",
+                "LabelSelectorOpNotIn",
+                "LabelSelectorOpExists",
+                "LabelSelectorOpDoesNotExist"
+            ]
+        },
+        "v1.LabelSelectorRequirement": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "description": "key is the label key that the selector applies to.",
+                    "type": "string"
+                },
+                "operator": {
+                    "description": "operator represents a key's relationship to a set of values.\nValid operators are In, NotIn, Exists and DoesNotExist.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.LabelSelectorOperator"
+                        }
+                    ]
+                },
+                "values": {
+                    "description": "values is an array of string values. If the operator is In or NotIn,\nthe values array must be non-empty. If the operator is Exists or DoesNotExist,\nthe values array must be empty. This array is replaced during a strategic\nmerge patch.\n+optional\n+listType=atomic",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "v1.NodeSelectorOperator": {
             "type": "string",
             "enum": [
@@ -5581,6 +12114,28 @@ const docTemplate = `{
                 "ClaimLost"
             ]
         },
+        "v1.PersistentVolumeClaimRetentionPolicyType": {
+            "type": "string",
+            "enum": [
+                "Retain",
+                "Delete"
+            ],
+            "x-enum-varnames": [
+                "RetainPersistentVolumeClaimRetentionPolicyType",
+                "DeletePersistentVolumeClaimRetentionPolicyType"
+            ]
+        },
+        "v1.PersistentVolumeMode": {
+            "type": "string",
+            "enum": [
+                "Block",
+                "Filesystem"
+            ],
+            "x-enum-varnames": [
+                "PersistentVolumeBlock",
+                "PersistentVolumeFilesystem"
+            ]
+        },
         "v1.PersistentVolumePhase": {
             "type": "string",
             "enum": [
@@ -5611,6 +12166,151 @@ const docTemplate = `{
                 "PersistentVolumeReclaimRetain"
             ]
         },
+        "v1.PodConditionType": {
+            "type": "string",
+            "enum": [
+                "ContainersReady",
+                "Initialized",
+                "Ready",
+                "PodScheduled",
+                "DisruptionTarget",
+                "PodReadyToStartContainers",
+                "PodResizePending",
+                "PodResizeInProgress"
+            ],
+            "x-enum-varnames": [
+                "ContainersReady",
+                "PodInitialized",
+                "PodReady",
+                "PodScheduled",
+                "DisruptionTarget",
+                "PodReadyToStartContainers",
+                "PodResizePending",
+                "PodResizeInProgress"
+            ]
+        },
+        "v1.PodFailurePolicy": {
+            "type": "object",
+            "properties": {
+                "rules": {
+                    "description": "A list of pod failure policy rules. The rules are evaluated in order.\nOnce a rule matches a Pod failure, the remaining of the rules are ignored.\nWhen no rule matches the Pod failure, the default handling applies - the\ncounter of pod failures is incremented and it is checked against\nthe backoffLimit. At most 20 elements are allowed.\n+listType=atomic",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.PodFailurePolicyRule"
+                    }
+                }
+            }
+        },
+        "v1.PodFailurePolicyAction": {
+            "type": "string",
+            "enum": [
+                "FailJob",
+                "FailIndex",
+                "Ignore",
+                "Count"
+            ],
+            "x-enum-varnames": [
+                "PodFailurePolicyActionFailJob",
+                "PodFailurePolicyActionFailIndex",
+                "PodFailurePolicyActionIgnore",
+                "PodFailurePolicyActionCount"
+            ]
+        },
+        "v1.PodFailurePolicyOnExitCodesOperator": {
+            "type": "string",
+            "enum": [
+                "In",
+                "NotIn"
+            ],
+            "x-enum-varnames": [
+                "PodFailurePolicyOnExitCodesOpIn",
+                "PodFailurePolicyOnExitCodesOpNotIn"
+            ]
+        },
+        "v1.PodFailurePolicyOnExitCodesRequirement": {
+            "type": "object",
+            "properties": {
+                "containerName": {
+                    "description": "Restricts the check for exit codes to the container with the\nspecified name. When null, the rule applies to all containers.\nWhen specified, it should match one the container or initContainer\nnames in the pod template.\n+optional",
+                    "type": "string"
+                },
+                "operator": {
+                    "description": "Represents the relationship between the container exit code(s) and the\nspecified values. Containers completed with success (exit code 0) are\nexcluded from the requirement check. Possible values are:\n\n- In: the requirement is satisfied if at least one container exit code\n  (might be multiple if there are multiple containers not restricted\n  by the 'containerName' field) is in the set of specified values.\n- NotIn: the requirement is satisfied if at least one container exit code\n  (might be multiple if there are multiple containers not restricted\n  by the 'containerName' field) is not in the set of specified values.\nAdditional values are considered to be added in the future. Clients should\nreact to an unknown operator by assuming the requirement is not satisfied.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.PodFailurePolicyOnExitCodesOperator"
+                        }
+                    ]
+                },
+                "values": {
+                    "description": "Specifies the set of values. Each returned container exit code (might be\nmultiple in case of multiple containers) is checked against this set of\nvalues with respect to the operator. The list of values must be ordered\nand must not contain duplicates. Value '0' cannot be used for the In operator.\nAt least one element is required. At most 255 elements are allowed.\n+listType=set",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "v1.PodFailurePolicyOnPodConditionsPattern": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "description": "Specifies the required Pod condition status. To match a pod condition\nit is required that the specified status equals the pod condition status.\nDefaults to True.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/k8s_io_api_core_v1.ConditionStatus"
+                        }
+                    ]
+                },
+                "type": {
+                    "description": "Specifies the required Pod condition type. To match a pod condition\nit is required that specified type equals the pod condition type.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.PodConditionType"
+                        }
+                    ]
+                }
+            }
+        },
+        "v1.PodFailurePolicyRule": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "Specifies the action taken on a pod failure when the requirements are satisfied.\nPossible values are:\n\n- FailJob: indicates that the pod's job is marked as Failed and all\n  running pods are terminated.\n- FailIndex: indicates that the pod's index is marked as Failed and will\n  not be restarted.\n- Ignore: indicates that the counter towards the .backoffLimit is not\n  incremented and a replacement pod is created.\n- Count: indicates that the pod is handled in the default way - the\n  counter towards the .backoffLimit is incremented.\nAdditional values are considered to be added in the future. Clients should\nreact to an unknown action by skipping the rule.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.PodFailurePolicyAction"
+                        }
+                    ]
+                },
+                "onExitCodes": {
+                    "description": "Represents the requirement on the container exit codes.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v1.PodFailurePolicyOnExitCodesRequirement"
+                        }
+                    ]
+                },
+                "onPodConditions": {
+                    "description": "Represents the requirement on the pod conditions. The requirement is represented\nas a list of pod condition patterns. The requirement is satisfied if at\nleast one pattern matches an actual pod condition. At most 20 elements are allowed.\n+listType=atomic\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.PodFailurePolicyOnPodConditionsPattern"
+                    }
+                }
+            }
+        },
+        "v1.PodManagementPolicyType": {
+            "type": "string",
+            "enum": [
+                "OrderedReady",
+                "Parallel"
+            ],
+            "x-enum-varnames": [
+                "OrderedReadyPodManagement",
+                "ParallelPodManagement"
+            ]
+        },
         "v1.PolicyRule": {
             "type": "object",
             "properties": {
@@ -5651,6 +12351,30 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.PolicyType": {
+            "type": "string",
+            "enum": [
+                "Ingress",
+                "Egress"
+            ],
+            "x-enum-varnames": [
+                "PolicyTypeIngress",
+                "PolicyTypeEgress"
+            ]
+        },
+        "v1.Protocol": {
+            "type": "string",
+            "enum": [
+                "TCP",
+                "UDP",
+                "SCTP"
+            ],
+            "x-enum-varnames": [
+                "ProtocolTCP",
+                "ProtocolUDP",
+                "ProtocolSCTP"
+            ]
+        },
         "v1.SecretType": {
             "type": "string",
             "enum": [
@@ -5674,6 +12398,17 @@ const docTemplate = `{
                 "SecretTypeBootstrapToken"
             ]
         },
+        "v1.ServiceAffinity": {
+            "type": "string",
+            "enum": [
+                "ClientIP",
+                "None"
+            ],
+            "x-enum-varnames": [
+                "ServiceAffinityClientIP",
+                "ServiceAffinityNone"
+            ]
+        },
         "v1.ServiceBackendPort": {
             "type": "object",
             "properties": {
@@ -5687,6 +12422,21 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.ServiceExternalTrafficPolicy": {
+            "type": "string",
+            "enum": [
+                "Cluster",
+                "Local",
+                "Local",
+                "Cluster"
+            ],
+            "x-enum-varnames": [
+                "ServiceExternalTrafficPolicyCluster",
+                "ServiceExternalTrafficPolicyLocal",
+                "ServiceExternalTrafficPolicyTypeLocal",
+                "ServiceExternalTrafficPolicyTypeCluster"
+            ]
+        },
         "v1.ServiceType": {
             "type": "string",
             "enum": [
@@ -5702,6 +12452,17 @@ const docTemplate = `{
                 "ServiceTypeExternalName"
             ]
         },
+        "v1.StatefulSetUpdateStrategyType": {
+            "type": "string",
+            "enum": [
+                "RollingUpdate",
+                "OnDelete"
+            ],
+            "x-enum-varnames": [
+                "RollingUpdateStatefulSetStrategyType",
+                "OnDeleteStatefulSetStrategyType"
+            ]
+        },
         "v1.Taint": {
             "type": "object",
             "properties": {
@@ -5811,6 +12572,151 @@ const docTemplate = `{
                 "VolumeBindingImmediate",
                 "VolumeBindingWaitForFirstConsumer"
             ]
+        },
+        "v2.CrossVersionObjectReference": {
+            "type": "object",
+            "properties": {
+                "apiVersion": {
+                    "description": "apiVersion is the API version of the referent\n+optional",
+                    "type": "string"
+                },
+                "kind": {
+                    "description": "kind is the kind of the referent; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "name is the name of the referent; More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names",
+                    "type": "string"
+                }
+            }
+        },
+        "v2.HPAScalingPolicy": {
+            "type": "object",
+            "properties": {
+                "periodSeconds": {
+                    "description": "periodSeconds specifies the window of time for which the policy should hold true.\nPeriodSeconds must be greater than zero and less than or equal to 1800 (30 min).",
+                    "type": "integer"
+                },
+                "type": {
+                    "description": "type is used to specify the scaling policy.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v2.HPAScalingPolicyType"
+                        }
+                    ]
+                },
+                "value": {
+                    "description": "value contains the amount of change which is permitted by the policy.\nIt must be greater than zero",
+                    "type": "integer"
+                }
+            }
+        },
+        "v2.HPAScalingPolicyType": {
+            "type": "string",
+            "enum": [
+                "Pods",
+                "Percent"
+            ],
+            "x-enum-varnames": [
+                "PodsScalingPolicy",
+                "PercentScalingPolicy"
+            ]
+        },
+        "v2.HPAScalingRules": {
+            "type": "object",
+            "properties": {
+                "policies": {
+                    "description": "policies is a list of potential scaling polices which can be used during scaling.\nIf not set, use the default values:\n- For scale up: allow doubling the number of pods, or an absolute change of 4 pods in a 15s window.\n- For scale down: allow all pods to be removed in a 15s window.\n+listType=atomic\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v2.HPAScalingPolicy"
+                    }
+                },
+                "selectPolicy": {
+                    "description": "selectPolicy is used to specify which policy should be used.\nIf not set, the default value Max is used.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v2.ScalingPolicySelect"
+                        }
+                    ]
+                },
+                "stabilizationWindowSeconds": {
+                    "description": "stabilizationWindowSeconds is the number of seconds for which past recommendations should be\nconsidered while scaling up or scaling down.\nStabilizationWindowSeconds must be greater than or equal to zero and less than or equal to 3600 (one hour).\nIf not set, use the default values:\n- For scale up: 0 (i.e. no stabilization is done).\n- For scale down: 300 (i.e. the stabilization window is 300 seconds long).\n+optional",
+                    "type": "integer"
+                },
+                "tolerance": {
+                    "description": "tolerance is the tolerance on the ratio between the current and desired\nmetric value under which no updates are made to the desired number of\nreplicas (e.g. 0.01 for 1%). Must be greater than or equal to zero. If not\nset, the default cluster-wide tolerance is applied (by default 10%).\n\nFor example, if autoscaling is configured with a memory consumption target of 100Mi,\nand scale-down and scale-up tolerances of 5% and 1% respectively, scaling will be\ntriggered when the actual consumption falls below 95Mi or exceeds 101Mi.\n\nThis is an alpha field and requires enabling the HPAConfigurableTolerance\nfeature gate.\n\n+featureGate=HPAConfigurableTolerance\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/resource.Quantity"
+                        }
+                    ]
+                }
+            }
+        },
+        "v2.HorizontalPodAutoscalerBehavior": {
+            "type": "object",
+            "properties": {
+                "scaleDown": {
+                    "description": "scaleDown is scaling policy for scaling Down.\nIf not set, the default value is to allow to scale down to minReplicas pods, with a\n300 second stabilization window (i.e., the highest recommendation for\nthe last 300sec is used).\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v2.HPAScalingRules"
+                        }
+                    ]
+                },
+                "scaleUp": {
+                    "description": "scaleUp is scaling policy for scaling Up.\nIf not set, the default value is the higher of:\n  * increase no more than 4 pods per 60 seconds\n  * double the number of pods per 60 seconds\nNo stabilization is used.\n+optional",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/v2.HPAScalingRules"
+                        }
+                    ]
+                }
+            }
+        },
+        "v2.MetricSourceType": {
+            "type": "string",
+            "enum": [
+                "Object",
+                "Pods",
+                "Resource",
+                "ContainerResource",
+                "External"
+            ],
+            "x-enum-varnames": [
+                "ObjectMetricSourceType",
+                "PodsMetricSourceType",
+                "ResourceMetricSourceType",
+                "ContainerResourceMetricSourceType",
+                "ExternalMetricSourceType"
+            ]
+        },
+        "v2.MetricTargetType": {
+            "type": "string",
+            "enum": [
+                "Utilization",
+                "Value",
+                "AverageValue"
+            ],
+            "x-enum-varnames": [
+                "UtilizationMetricType",
+                "ValueMetricType",
+                "AverageValueMetricType"
+            ]
+        },
+        "v2.ScalingPolicySelect": {
+            "type": "string",
+            "enum": [
+                "Max",
+                "Min",
+                "Disabled"
+            ],
+            "x-enum-varnames": [
+                "MaxChangePolicySelect",
+                "MinChangePolicySelect",
+                "DisabledPolicySelect"
+            ]
         }
     }
 }`
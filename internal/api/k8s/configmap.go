@@ -2,6 +2,9 @@ package k8s
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -11,7 +14,9 @@ import (
 	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
 	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+	"github.com/crazyfrankie/kube-ctl/internal/model/validate"
 	"github.com/crazyfrankie/kube-ctl/internal/service"
+	"github.com/crazyfrankie/kube-ctl/pkg/consts"
 	"github.com/crazyfrankie/kube-ctl/pkg/response"
 )
 
@@ -27,6 +32,8 @@ func (h *ConfigMapHandler) RegisterRoute(r *gin.Engine) {
 	cmGroup := r.Group("api/configmap")
 	{
 		cmGroup.POST("", h.CreateOrUpdateConfigMap())
+		cmGroup.POST("upload", h.UploadConfigMapFiles())
+		cmGroup.GET("download", h.DownloadConfigMapKey())
 		cmGroup.GET("", h.GetConfigMap())
 		cmGroup.GET("list", h.GetConfigMapList())
 		cmGroup.DELETE("", h.DeleteConfigMap())
@@ -35,13 +42,17 @@ func (h *ConfigMapHandler) RegisterRoute(r *gin.Engine) {
 
 // CreateOrUpdateConfigMap
 // @Summary 创建或更新 ConfigMap
-// @Description 创建新的 ConfigMap 或更新已存在的 ConfigMap
+// @Description 创建新的 ConfigMap 或更新已存在的 ConfigMap，binaryData 的值为 base64 编码，只设置所列的键，未列出的已有二进制键保留，删除需放入 removeBinaryKeys；合并后 data 与 binaryData 总大小不超过 1MiB；immutable 一旦开启不可关闭且数据不可再修改；restartConsumers 为 true 时保存后滚动重启使用它的 Deployment/StatefulSet/DaemonSet
 // @Tags ConfigMap管理
 // @Accept json
 // @Produce json
 // @Param pod body req.ConfigMap true "ConfigMap 配置信息"
-// @Success 200 {object} response.Response "操作成功，返回成功消息"
-// @Failure 400 {object} response.Response "参数错误(code=20001)"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
+// @Param force query bool false "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段"
+// @Success 200 {object} response.Response{data=[]resp.Consumer} "操作成功；开启重启时返回已重启的工作负载"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或验证错误、ConfigMap 不可变(code=20002)"
+// @Failure 409 {object} response.Response "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段"
+// @Failure 413 {object} response.Response "合并后超过 1MiB 大小上限(code=20002)"
 // @Failure 500 {object} response.Response "系统错误(code=30000)"
 // @Router /api/configmap [post]
 func (h *ConfigMapHandler) CreateOrUpdateConfigMap() gin.HandlerFunc {
@@ -52,9 +63,30 @@ func (h *ConfigMapHandler) CreateOrUpdateConfigMap() gin.HandlerFunc {
 			return
 		}
 
-		err := h.svc.CreateOrUpdateConfigMap(context.Background(), &cmReq)
+		if err := validate.ConfigMapValidate(&cmReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "validate configmap err: "+err.Error()))
+			return
+		}
+
+		ctx, rec := writeContext(c)
+		err := h.svc.CreateOrUpdateConfigMap(ctx, &cmReq)
 		if err != nil {
-			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			configMapError(c, err)
+			return
+		}
+
+		if dryRunResponse(c, rec) {
+			return
+		}
+
+		if cmReq.RestartConsumers {
+			restarted, err := h.svc.RestartConsumers(context.Background(), cmReq.Name, cmReq.Namespace)
+			if err != nil {
+				response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+				return
+			}
+
+			response.SuccessWithData(c, restarted)
 			return
 		}
 
@@ -62,6 +94,115 @@ func (h *ConfigMapHandler) CreateOrUpdateConfigMap() gin.HandlerFunc {
 	}
 }
 
+// UploadConfigMapFiles
+// @Summary 上传文件到 ConfigMap
+// @Description 以 multipart 表单上传文件，每个文件以文件名为 key 写入 ConfigMap(同 kubectl create configmap --from-file)，文本写入 data，二进制写入 binaryData；ConfigMap 不存在时自动创建
+// @Tags ConfigMap管理
+// @Accept multipart/form-data
+// @Produce json
+// @Param namespace formData string true "命名空间"
+// @Param name formData string true "ConfigMap名称"
+// @Param files formData file true "要上传的文件，可多个"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
+// @Success 200 {object} response.Response "操作成功，返回成功消息"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或 key 非法、ConfigMap 不可变(code=20002)"
+// @Failure 413 {object} response.Response "超过 1MiB 大小上限(code=20002)"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/configmap/upload [post]
+func (h *ConfigMapHandler) UploadConfigMapFiles() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, 2*consts.ConfigMapMaxSize)
+		form, err := c.MultipartForm()
+		if err != nil {
+			var maxErr *http.MaxBytesError
+			if errors.As(err, &maxErr) {
+				configMapError(c, fmt.Errorf("%w: limit %d bytes", service.ErrConfigMapTooLarge, consts.ConfigMapMaxSize))
+				return
+			}
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20001, "bind error "+err.Error()))
+			return
+		}
+		ns := c.PostForm("namespace")
+		name := c.PostForm("name")
+		if ns == "" || name == "" || len(form.File["files"]) == 0 {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "configmap namespace, name and files are necessary"))
+			return
+		}
+
+		files := make(map[string][]byte, len(form.File["files"]))
+		for _, fh := range form.File["files"] {
+			if err := validate.ConfigMapKeyValidate(fh.Filename); err != nil {
+				response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, err.Error()))
+				return
+			}
+			f, err := fh.Open()
+			if err != nil {
+				response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20001, "bind error "+err.Error()))
+				return
+			}
+			content, err := io.ReadAll(f)
+			f.Close()
+			if err != nil {
+				response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20001, "bind error "+err.Error()))
+				return
+			}
+			files[fh.Filename] = content
+		}
+
+		ctx, rec := writeContext(c)
+		err = h.svc.UploadConfigMapFiles(ctx, ns, name, files)
+		if err != nil {
+			configMapError(c, err)
+			return
+		}
+
+		if dryRunResponse(c, rec) {
+			return
+		}
+
+		response.SuccessWithMsg(c, fmt.Sprintf("ConfigMap[namespace=%s,name=%s] upload %d files success", ns, name, len(files)))
+	}
+}
+
+// DownloadConfigMapKey
+// @Summary 下载 ConfigMap 的某个 key
+// @Description 将 data 或 binaryData 中指定 key 的内容作为文件下载，文件名即 key
+// @Tags ConfigMap管理
+// @Produce octet-stream
+// @Param namespace query string true "命名空间"
+// @Param name query string true "ConfigMap名称"
+// @Param key query string true "要下载的 key"
+// @Success 200 {file} file "key 的内容"
+// @Failure 400 {object} response.Response "key 不存在(code=20002)"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/configmap/download [get]
+func (h *ConfigMapHandler) DownloadConfigMapKey() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ns := c.Query("namespace")
+		name := c.Query("name")
+		key := c.Query("key")
+
+		res, err := h.svc.GetConfigMap(context.Background(), name, ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		var content []byte
+		if v, ok := res.Data[key]; ok {
+			content = []byte(v)
+		} else if v, ok := res.BinaryData[key]; ok {
+			content = v
+		} else {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, fmt.Sprintf("configmap %s has no key %s", name, key)))
+			return
+		}
+
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", key))
+		c.Data(http.StatusOK, "application/octet-stream", content)
+	}
+}
+
 // GetConfigMap
 // @Summary 获取ConfigMap详情
 // @Description 获取指定命名空间下指定ConfigMap的详细信息
@@ -70,7 +211,7 @@ func (h *ConfigMapHandler) CreateOrUpdateConfigMap() gin.HandlerFunc {
 // @Produce json
 // @Param namespace query string true "命名空间"
 // @Param name query string true "ConfigMap名称"
-// @Success 200 {object} response.Response{data=resp.ConfigMapDetail} "返回ConfigMap的详细信息"
+// @Success 200 {object} response.Response{data=resp.ConfigMapDetail} "返回ConfigMap的详细信息，usedBy 为引用它的工作负载和 Pod"
 // @Failure 500 {object} response.Response "系统错误(code=30000)"
 // @Router /api/configmap [get]
 func (h *ConfigMapHandler) GetConfigMap() gin.HandlerFunc {
@@ -85,6 +226,11 @@ func (h *ConfigMapHandler) GetConfigMap() gin.HandlerFunc {
 		}
 
 		cm := convert.CMConvertDetailResp(res)
+		cm.UsedBy, err = h.svc.GetConsumers(context.Background(), name, ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
 
 		response.SuccessWithData(c, cm)
 	}
@@ -148,3 +294,17 @@ func (h *ConfigMapHandler) DeleteConfigMap() gin.HandlerFunc {
 		response.Success(c)
 	}
 }
+
+func configMapError(c *gin.Context, err error) {
+	if conflictResponse(c, err, convert.CMConvertDetailResp) {
+		return
+	}
+	switch {
+	case errors.Is(err, service.ErrConfigMapTooLarge):
+		response.Error(c, http.StatusRequestEntityTooLarge, gerrors.NewBizError(20002, err.Error()))
+	case errors.Is(err, service.ErrConfigMapImmutable):
+		response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, err.Error()))
+	default:
+		response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+	}
+}
@@ -0,0 +1,49 @@
+package k8s
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/crazyfrankie/gem/gerrors"
+	"github.com/gin-gonic/gin"
+
+	"github.com/crazyfrankie/kube-ctl/internal/service"
+	"github.com/crazyfrankie/kube-ctl/pkg/response"
+)
+
+// conflictResponse answers an update based on a stale resourceVersion with 409(code=30002).
+// The data is the stored object rendered by detail the same way as the detail endpoint,
+// so the client can merge or reload; it is nil when the object has been deleted.
+// An apply that conflicts with fields owned by other managers gets 409(code=30003) with the fields.
+func conflictResponse[T any, R any](c *gin.Context, err error, detail func(T) R) bool {
+	var applyConflict *service.ApplyConflictError
+	if errors.As(err, &applyConflict) {
+		response.ErrorWithData(c, http.StatusConflict, gerrors.NewBizError(30003, applyConflict.Error()), applyConflict.Conflicts)
+		return true
+	}
+
+	var conflict *service.ConflictError
+	if !errors.As(err, &conflict) {
+		return false
+	}
+
+	var data any
+	if current, ok := conflict.Current.(T); ok {
+		data = detail(current)
+	}
+	response.ErrorWithData(c, http.StatusConflict, gerrors.NewBizError(30002, conflict.Error()), data)
+
+	return true
+}
+
+// disruptionResponse answers a write that would evict pods below their PDB budget with 409(code=30004),
+// the data lists the violated PDBs. Repeating it with ?force=true goes ahead.
+func disruptionResponse(c *gin.Context, err error) bool {
+	var disruption *service.DisruptionError
+	if !errors.As(err, &disruption) {
+		return false
+	}
+	response.ErrorWithData(c, http.StatusConflict, gerrors.NewBizError(30004, disruption.Error()), disruption.Violations)
+
+	return true
+}
@@ -3,7 +3,9 @@ package k8s
 import (
 	"context"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/crazyfrankie/gem/gerrors"
 	"github.com/gin-gonic/gin"
@@ -11,8 +13,10 @@ import (
 	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
 	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+	"github.com/crazyfrankie/kube-ctl/internal/model/validate"
 	"github.com/crazyfrankie/kube-ctl/internal/service"
 	"github.com/crazyfrankie/kube-ctl/pkg/response"
+	"github.com/crazyfrankie/kube-ctl/pkg/utils"
 )
 
 type CronJobHandler struct {
@@ -30,6 +34,11 @@ func (h *CronJobHandler) RegisterRoute(r *gin.Engine) {
 		cronCronJobGroup.DELETE("", h.DeleteCronJob())
 		cronCronJobGroup.GET("", h.GetCronJobDetail())
 		cronCronJobGroup.GET("list", h.GetCronJobList())
+		cronCronJobGroup.POST("run", h.RunCronJob())
+		cronCronJobGroup.POST("suspend", h.SuspendCronJob(true))
+		cronCronJobGroup.POST("resume", h.SuspendCronJob(false))
+		cronCronJobGroup.GET("schedule", h.GetCronJobSchedule())
+		cronCronJobGroup.GET("jobs", h.GetCronJobJobs())
 	}
 }
 
@@ -40,8 +49,10 @@ func (h *CronJobHandler) RegisterRoute(r *gin.Engine) {
 // @Accept json
 // @Produce json
 // @Param pod body req.CronJob true "CronJob 配置信息"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
 // @Success 200 {object} response.Response "操作成功，返回成功消息"
-// @Failure 400 {object} response.Response "参数错误(code=20001)"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或验证错误(code=20002)"
+// @Failure 409 {object} response.Response "资源在读取后已被修改或删除(code=30002)，data 为当前对象，可合并或重新加载"
 // @Failure 500 {object} response.Response "系统错误(code=30000)"
 // @Router /api/cronjob [post]
 func (h *CronJobHandler) CreateOrUpdateCronJob() gin.HandlerFunc {
@@ -52,12 +63,25 @@ func (h *CronJobHandler) CreateOrUpdateCronJob() gin.HandlerFunc {
 			return
 		}
 
-		err := h.svc.CreateOrUpdateCronJob(context.Background(), &creatReq)
+		if err := validate.CronJobValidate(&creatReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "validate cronjob err: "+err.Error()))
+			return
+		}
+
+		ctx, rec := writeContext(c)
+		err := h.svc.CreateOrUpdateCronJob(ctx, &creatReq)
 		if err != nil {
+			if conflictResponse(c, err, convert.CronJobConvertReq) {
+				return
+			}
 			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
 			return
 		}
 
+		if dryRunResponse(c, rec) {
+			return
+		}
+
 		response.Success(c)
 	}
 }
@@ -148,3 +172,143 @@ func (h *CronJobHandler) GetCronJobList() gin.HandlerFunc {
 		response.SuccessWithData(c, cronJobs)
 	}
 }
+
+// RunCronJob
+// @Summary 立即运行 CronJob
+// @Description 不等待调度，立即以 CronJob 的 jobTemplate 创建一个 Job(名称追加 -manual-xxxxx，带 cronjob.kubernetes.io/instantiate: manual 注解)，该 Job 归属于 CronJob 并计入执行历史
+// @Tags CronJob 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "CronJob 名称"
+// @Success 200 {object} response.Response{data=req.Job} "返回新创建的 Job"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/cronjob/run [post]
+func (h *CronJobHandler) RunCronJob() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+		ns := c.Query("namespace")
+
+		res, err := h.svc.RunCronJob(context.Background(), name, ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.SuccessWithData(c, convert.JobConvertReq(res))
+	}
+}
+
+// SuspendCronJob
+// @Summary 暂停或恢复 CronJob
+// @Description suspend 暂停调度新的 Job，已在运行的 Job 不受影响；resume 恢复调度
+// @Tags CronJob 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "CronJob 名称"
+// @Success 200 {object} response.Response "操作成功"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/cronjob/suspend [post]
+// @Router /api/cronjob/resume [post]
+func (h *CronJobHandler) SuspendCronJob(suspend bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+		ns := c.Query("namespace")
+
+		err := h.svc.SuspendCronJob(context.Background(), name, ns, suspend)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.Success(c)
+	}
+}
+
+// GetCronJobSchedule
+// @Summary 预览 CronJob 调度时间
+// @Description 解析 cron 表达式并按 timeZone 计算接下来的运行时间。传入 schedule 时预览该表达式(用于创建前校验)，否则预览指定 CronJob 的调度
+// @Tags CronJob 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string false "命名空间"
+// @Param name query string false "CronJob 名称"
+// @Param schedule query string false "待预览的 cron 表达式"
+// @Param timeZone query string false "待预览的时区，如 Asia/Shanghai，为空时按 UTC 计算"
+// @Param count query int false "返回的运行次数，默认 5，最多 100"
+// @Success 200 {object} response.Response{data=resp.CronJobSchedule} "返回接下来的运行时间"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或表达式、时区无效(code=20002)"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/cronjob/schedule [get]
+func (h *CronJobHandler) GetCronJobSchedule() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		count, err := strconv.Atoi(c.DefaultQuery("count", "5"))
+		if err != nil || count <= 0 || count > 100 {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20001, "bind error count must be between 1 and 100"))
+			return
+		}
+
+		schedule, timeZone := c.Query("schedule"), c.Query("timeZone")
+		if schedule == "" {
+			cron, err := h.svc.GetCronJobDetail(context.Background(), c.Query("name"), c.Query("namespace"))
+			if err != nil {
+				response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+				return
+			}
+			schedule = cron.Spec.Schedule
+			timeZone = ""
+			if cron.Spec.TimeZone != nil {
+				timeZone = *cron.Spec.TimeZone
+			}
+		}
+
+		sched, loc, err := utils.ParseSchedule(schedule, timeZone)
+		if err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "validate schedule err: "+err.Error()))
+			return
+		}
+
+		res := resp.CronJobSchedule{
+			Schedule: schedule,
+			TimeZone: loc.String(),
+			NextRuns: make([]int64, 0, count),
+		}
+		for _, t := range utils.NextRuns(sched, loc, time.Now(), count) {
+			res.NextRuns = append(res.NextRuns, t.Unix())
+		}
+
+		response.SuccessWithData(c, res)
+	}
+}
+
+// GetCronJobJobs
+// @Summary 获取 CronJob 执行历史
+// @Description 获取 CronJob 所属的 Job 列表(按创建时间倒序)及成功、失败次数、最近调度时间和最近成功时间，保留的 Job 数量受历史记录限制
+// @Tags CronJob 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "CronJob 名称"
+// @Success 200 {object} response.Response{data=resp.CronJobHistory} "返回执行历史"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/cronjob/jobs [get]
+func (h *CronJobHandler) GetCronJobJobs() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+		ns := c.Query("namespace")
+
+		cron, err := h.svc.GetCronJobDetail(context.Background(), name, ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+		jobs, err := h.svc.GetCronJobJobs(context.Background(), cron)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.SuccessWithData(c, convert.CronJobHistoryConvertResp(cron, jobs))
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
 	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+	"github.com/crazyfrankie/kube-ctl/internal/model/validate"
 	"github.com/crazyfrankie/kube-ctl/internal/service"
 	"github.com/crazyfrankie/kube-ctl/pkg/response"
 )
@@ -30,6 +31,8 @@ func (h *DaemonSetHandler) RegisterRoute(r *gin.Engine) {
 		daemonGroup.DELETE("", h.DeleteDaemonSet())
 		daemonGroup.GET("", h.GetDaemonSetDetail())
 		daemonGroup.GET("list", h.GetDaemonSetList())
+		daemonGroup.GET("coverage", h.GetCoverage())
+		daemonGroup.POST("restart", h.RestartDaemonSet())
 	}
 }
 
@@ -40,8 +43,11 @@ func (h *DaemonSetHandler) RegisterRoute(r *gin.Engine) {
 // @Accept json
 // @Produce json
 // @Param pod body req.DaemonSet true "DaemonSet 配置信息"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
+// @Param force query bool false "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段"
 // @Success 200 {object} response.Response "操作成功，返回成功消息"
-// @Failure 400 {object} response.Response "参数错误(code=20001)"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或验证错误(code=20002)"
+// @Failure 409 {object} response.Response "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段"
 // @Failure 500 {object} response.Response "系统错误(code=30000)"
 // @Router /api/daemonset [post]
 func (h *DaemonSetHandler) CreateOrUpdateDaemonSet() gin.HandlerFunc {
@@ -52,12 +58,25 @@ func (h *DaemonSetHandler) CreateOrUpdateDaemonSet() gin.HandlerFunc {
 			return
 		}
 
-		err := h.svc.CreateOrUpdateDaemonSet(context.Background(), &createReq)
+		if err := validate.DaemonSetValidate(&createReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "validate daemonset err: "+err.Error()))
+			return
+		}
+
+		ctx, rec := writeContext(c)
+		err := h.svc.CreateOrUpdateDaemonSet(ctx, &createReq)
 		if err != nil {
+			if conflictResponse(c, err, convert.DaemonSetConvertReq) {
+				return
+			}
 			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
 			return
 		}
 
+		if dryRunResponse(c, rec) {
+			return
+		}
+
 		response.Success(c)
 	}
 }
@@ -148,3 +167,55 @@ func (h *DaemonSetHandler) GetDaemonSetList() gin.HandlerFunc {
 		response.SuccessWithData(c, daemons)
 	}
 }
+
+// GetCoverage
+// @Summary 获取 DaemonSet 节点覆盖情况
+// @Description 逐个节点列出守护 Pod 是否运行：Running/Pending、未被调度的节点(Skipped)及原因(污点未容忍、nodeSelector 或节点亲和性不匹配)、应运行但缺失的节点(Missing，如资源不足)以及运行在不该运行节点上的 Pod(Misscheduled)
+// @Tags DaemonSet 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "DaemonSet 名称"
+// @Success 200 {object} response.Response{data=resp.DaemonSetCoverage} "返回各节点覆盖情况"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/daemonset/coverage [get]
+func (h *DaemonSetHandler) GetCoverage() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+		ns := c.Query("namespace")
+
+		res, err := h.svc.GetCoverage(context.Background(), name, ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.SuccessWithData(c, res)
+	}
+}
+
+// RestartDaemonSet
+// @Summary 重启 DaemonSet
+// @Description 与 kubectl rollout restart 相同，按更新策略逐节点重建守护 Pod；OnDelete 策略下需手动删除 Pod 才会生效
+// @Tags DaemonSet 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "DaemonSet 名称"
+// @Success 200 {object} response.Response "操作成功"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/daemonset/restart [post]
+func (h *DaemonSetHandler) RestartDaemonSet() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+		ns := c.Query("namespace")
+
+		err := h.svc.RestartDaemonSet(context.Background(), name, ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.Success(c)
+	}
+}
@@ -7,10 +7,11 @@ import (
 
 	"github.com/crazyfrankie/gem/gerrors"
 	"github.com/gin-gonic/gin"
-	
+
 	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
 	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+	"github.com/crazyfrankie/kube-ctl/internal/model/validate"
 	"github.com/crazyfrankie/kube-ctl/internal/service"
 	"github.com/crazyfrankie/kube-ctl/pkg/response"
 )
@@ -40,8 +41,11 @@ func (h *DeploymentHandler) RegisterRoute(r *gin.Engine) {
 // @Accept json
 // @Produce json
 // @Param pod body req.Deployment true "Deployment 配置信息"
-// @Success 200 {object} response.Response "操作成功，返回成功消息"
-// @Failure 400 {object} response.Response "参数错误(code=20001)"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
+// @Param force query bool false "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段"
+// @Success 200 {object} response.Response "操作成功；副本数由生效中的 HPA 管理时 msg 为覆盖提示，预演时该提示位于 data.warnings"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或验证错误(code=20002)"
+// @Failure 409 {object} response.Response "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段"
 // @Failure 500 {object} response.Response "系统错误(code=30000)"
 // @Router /api/deployment [post]
 func (h *DeploymentHandler) CreateOrUpdateDeployment() gin.HandlerFunc {
@@ -52,12 +56,31 @@ func (h *DeploymentHandler) CreateOrUpdateDeployment() gin.HandlerFunc {
 			return
 		}
 
-		err := h.svc.CreateOrUpdateDeployment(context.Background(), &createReq)
+		if err := validate.DeploymentValidate(&createReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "validate deployment err: "+err.Error()))
+			return
+		}
+
+		// looked up before the write so a dry run shows it as well
+		warning := autoscalerWarning("Deployment", createReq.Name, createReq.Namespace, createReq.Replicas, h.svc.GetAutoscaler)
+		ctx, rec := writeContext(c)
+		err := h.svc.CreateOrUpdateDeployment(ctx, &createReq)
 		if err != nil {
+			if conflictResponse(c, err, convert.DeploymentConvertReq) {
+				return
+			}
 			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
 			return
 		}
 
+		if dryRunResponse(c, rec, warning) {
+			return
+		}
+		if warning != "" {
+			response.SuccessWithMsg(c, warning)
+			return
+		}
+
 		response.Success(c)
 	}
 }
@@ -96,7 +119,7 @@ func (h *DeploymentHandler) DeleteDeployment() gin.HandlerFunc {
 // @Produce json
 // @Param namespace query string true "命名空间"
 // @Param name query string true "Deployment 名称"
-// @Success 200 {object} response.Response{data=req.Deployment} "返回Deployment的详细信息"
+// @Success 200 {object} response.Response{data=req.Deployment} "返回Deployment的详细信息，autoscaler 为管理其副本数的 HPA，disruptionBudget 为保护其 Pod 的 PDB 情况(None/Blocking/Allowed)"
 // @Failure 500 {object} response.Response "系统错误(code=30000)"
 // @Router /api/deployment [get]
 func (h *DeploymentHandler) GetDeploymentDetail() gin.HandlerFunc {
@@ -111,6 +134,20 @@ func (h *DeploymentHandler) GetDeploymentDetail() gin.HandlerFunc {
 		}
 
 		deploy := convert.DeploymentConvertReq(res)
+		hpa, err := h.svc.GetAutoscaler(context.Background(), name, ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+		if hpa != nil {
+			deploy.Autoscaler = convert.AutoscalerRefConvert(hpa)
+		}
+		pdbs, err := h.svc.GetDisruptionBudgets(context.Background(), res)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+		deploy.DisruptionBudget = convert.DisruptionBudgetRefConvert(pdbs, deploy.Replicas)
 
 		response.SuccessWithData(c, deploy)
 	}
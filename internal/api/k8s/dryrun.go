@@ -0,0 +1,54 @@
+package k8s
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/crazyfrankie/gem/gerrors"
+	"github.com/gin-gonic/gin"
+
+	"github.com/crazyfrankie/kube-ctl/internal/service"
+	"github.com/crazyfrankie/kube-ctl/pkg/response"
+)
+
+// writeContext carries the write options of the request: ?force=true takes over the fields
+// other managers own on apply, ?dryRun=true turns the writes into server-side dry runs.
+func writeContext(c *gin.Context) (context.Context, *service.DryRunRecorder) {
+	ctx := forceContext(c)
+	if c.Query("dryRun") != "true" {
+		return ctx, nil
+	}
+
+	return service.WithDryRun(ctx)
+}
+
+// forceContext carries ?force=true alone, for writes that can not be dry run such as deletes.
+func forceContext(c *gin.Context) context.Context {
+	if c.Query("force") == "true" {
+		return service.WithForceConflicts(context.Background())
+	}
+
+	return context.Background()
+}
+
+// dryRunResponse answers a dry-run request with the diff against the live object and the non-empty warnings.
+// It reports false for a normal request, which is then answered as usual.
+func dryRunResponse(c *gin.Context, rec *service.DryRunRecorder, warnings ...string) bool {
+	if rec == nil {
+		return false
+	}
+
+	diff, err := rec.Diff()
+	if err != nil {
+		response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+		return true
+	}
+	for _, w := range warnings {
+		if w != "" {
+			diff.Warnings = append(diff.Warnings, w)
+		}
+	}
+	response.SuccessWithData(c, diff)
+
+	return true
+}
@@ -0,0 +1,216 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/crazyfrankie/gem/gerrors"
+	"github.com/gin-gonic/gin"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+
+	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
+	"github.com/crazyfrankie/kube-ctl/internal/model/req"
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+	"github.com/crazyfrankie/kube-ctl/internal/model/validate"
+	"github.com/crazyfrankie/kube-ctl/internal/service"
+	"github.com/crazyfrankie/kube-ctl/pkg/response"
+)
+
+type HPAHandler struct {
+	svc service.HPAService
+}
+
+func NewHPAHandler(svc service.HPAService) *HPAHandler {
+	return &HPAHandler{svc: svc}
+}
+
+func (h *HPAHandler) RegisterRoute(r *gin.Engine) {
+	hpaGroup := r.Group("api/hpa")
+	{
+		hpaGroup.POST("", h.CreateOrUpdateHPA())
+		hpaGroup.DELETE("", h.DeleteHPA())
+		hpaGroup.GET("", h.GetHPADetail())
+		hpaGroup.GET("list", h.GetHPAList())
+		hpaGroup.GET("status", h.GetHPAStatus())
+	}
+}
+
+// CreateOrUpdateHPA
+// @Summary 创建或更新 HPA
+// @Description 创建或更新以 Deployment/StatefulSet 为目标的 autoscaling/v2 HorizontalPodAutoscaler，支持 CPU/内存利用率(整个 Pod 或指定容器)、自定义与外部指标以及扩缩容行为策略；未指定指标时默认 CPU 利用率 80%
+// @Tags HPA 管理
+// @Accept json
+// @Produce json
+// @Param hpa body req.HPA true "HPA 配置信息"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
+// @Param force query bool false "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段"
+// @Success 200 {object} response.Response "操作成功，返回成功消息"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或验证错误、目标工作负载不存在或已被其他 HPA 管理(code=20002)"
+// @Failure 409 {object} response.Response "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/hpa [post]
+func (h *HPAHandler) CreateOrUpdateHPA() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var createReq req.HPA
+		if err := c.ShouldBind(&createReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20001, "bind error "+err.Error()))
+			return
+		}
+
+		if err := validate.HPAValidate(&createReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "validate hpa err: "+err.Error()))
+			return
+		}
+
+		ctx, rec := writeContext(c)
+		err := h.svc.CreateOrUpdateHPA(ctx, &createReq)
+		if err != nil {
+			if conflictResponse(c, err, convert.HPAConvertReq) {
+				return
+			}
+			if errors.Is(err, service.ErrHPATarget) {
+				response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, err.Error()))
+				return
+			}
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		if dryRunResponse(c, rec) {
+			return
+		}
+
+		response.Success(c)
+	}
+}
+
+// DeleteHPA
+// @Summary 删除 HPA
+// @Description 删除指定命名空间下的指定 HPA，目标工作负载保持当前副本数
+// @Tags HPA 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "HPA 名称"
+// @Success 200 {object} response.Response "删除成功"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/hpa [delete]
+func (h *HPAHandler) DeleteHPA() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+		ns := c.Query("namespace")
+
+		err := h.svc.DeleteHPA(context.Background(), name, ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.Success(c)
+	}
+}
+
+// GetHPADetail
+// @Summary 获取 HPA 详情
+// @Description 获取指定命名空间下指定 HPA 的配置，可修改后直接提交更新
+// @Tags HPA 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "HPA 名称"
+// @Success 200 {object} response.Response{data=req.HPA} "返回 HPA 的详细信息"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/hpa [get]
+func (h *HPAHandler) GetHPADetail() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+		ns := c.Query("namespace")
+
+		res, err := h.svc.GetHPADetail(context.Background(), name, ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.SuccessWithData(c, convert.HPAConvertReq(res))
+	}
+}
+
+// GetHPAList
+// @Summary 获取 HPA 列表
+// @Description 获取指定命名空间下的所有 HPA，包含当前/期望副本数与各指标的当前值/目标值
+// @Tags HPA 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param keyword query string false "关键词"
+// @Success 200 {object} response.Response{data=[]resp.HPA} "返回 HPA 列表"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/hpa/list [get]
+func (h *HPAHandler) GetHPAList() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ns := c.Query("namespace")
+		keyword := c.Query("keyword")
+
+		res, err := h.svc.GetHPAList(context.Background(), ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		hpas := make([]resp.HPA, 0, len(res))
+		for _, i := range res {
+			if strings.Contains(i.Name, keyword) {
+				hpas = append(hpas, convert.HPAConvertResp(&i))
+			}
+		}
+
+		response.SuccessWithData(c, hpas)
+	}
+}
+
+// GetHPAStatus
+// @Summary 获取 HPA 状态
+// @Description 获取 HPA 的当前与期望副本数、最近扩缩容时间、各指标当前值以及 AbleToScale/ScalingActive/ScalingLimited 等状态条件
+// @Tags HPA 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "HPA 名称"
+// @Success 200 {object} response.Response{data=resp.HPAStatus} "返回 HPA 状态"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/hpa/status [get]
+func (h *HPAHandler) GetHPAStatus() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+		ns := c.Query("namespace")
+
+		res, err := h.svc.GetHPADetail(context.Background(), name, ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.SuccessWithData(c, convert.HPAStatusConvertResp(res))
+	}
+}
+
+// autoscalerWarning tells the client that the replicas it sets will be overridden by an active HPA.
+// It is looked up against the HPA state before the write and is best effort, a failed lookup warns nothing.
+func autoscalerWarning(kind string, name string, namespace string, replicas int32,
+	get func(ctx context.Context, name string, namespace string) (*autoscalingv2.HorizontalPodAutoscaler, error)) string {
+	hpa, err := get(context.Background(), name, namespace)
+	if err != nil || hpa == nil {
+		return ""
+	}
+	ref := convert.AutoscalerRefConvert(hpa)
+	if !ref.Active || replicas == ref.DesiredReplicas {
+		return ""
+	}
+
+	return fmt.Sprintf("%s %s is scaled by hpa %s (%d-%d replicas), replicas %d will be reset to %d",
+		kind, name, ref.Name, ref.MinReplicas, ref.MaxReplicas, replicas, ref.DesiredReplicas)
+}
@@ -2,6 +2,7 @@ package k8s
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strings"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
 	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+	"github.com/crazyfrankie/kube-ctl/internal/model/validate"
 	"github.com/crazyfrankie/kube-ctl/internal/service"
 	"github.com/crazyfrankie/kube-ctl/pkg/response"
 )
@@ -40,8 +42,11 @@ func (h *IngressHandler) RegisterRoute(r *gin.Engine) {
 // @Accept json
 // @Produce json
 // @Param pod body req.Ingress true "Ingress 配置信息"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
+// @Param force query bool false "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段"
 // @Success 200 {object} response.Response "操作成功，返回成功消息"
-// @Failure 400 {object} response.Response "参数错误(code=20001)"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或验证错误(code=20002)"
+// @Failure 409 {object} response.Response "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段"
 // @Failure 500 {object} response.Response "系统错误(code=30000)"
 // @Router /api/ingress [post]
 func (h *IngressHandler) CreateOrUpdateIngress() gin.HandlerFunc {
@@ -52,12 +57,30 @@ func (h *IngressHandler) CreateOrUpdateIngress() gin.HandlerFunc {
 			return
 		}
 
-		err := h.svc.CreateOrUpdateIngress(context.Background(), &createReq)
+		err := validate.IngressValidate(&createReq)
 		if err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "validate ingress err: "+err.Error()))
+			return
+		}
+
+		ctx, rec := writeContext(c)
+		err = h.svc.CreateOrUpdateIngress(ctx, &createReq)
+		if err != nil {
+			if conflictResponse(c, err, convert.IngressConvertReq) {
+				return
+			}
+			if errors.Is(err, service.ErrIngressReference) {
+				response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, err.Error()))
+				return
+			}
 			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
 			return
 		}
 
+		if dryRunResponse(c, rec) {
+			return
+		}
+
 		response.Success(c)
 	}
 }
@@ -42,8 +42,11 @@ func (h *IngressRouteHandler) RegisterRoute(r *gin.Engine) {
 // @Accept json
 // @Produce json
 // @Param pod body req.IngressRoute true "IngressRoute 配置信息"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
+// @Param force query bool false "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段"
 // @Success 200 {object} response.Response "操作成功，返回成功消息"
 // @Failure 400 {object} response.Response "参数错误(code=20001)"
+// @Failure 409 {object} response.Response "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段"
 // @Failure 500 {object} response.Response "系统错误(code=30000)"
 // @Router /api/ingroute [post]
 func (h *IngressRouteHandler) CreateOrUpdateIngresRoute() gin.HandlerFunc {
@@ -54,12 +57,20 @@ func (h *IngressRouteHandler) CreateOrUpdateIngresRoute() gin.HandlerFunc {
 			return
 		}
 
-		err := h.svc.CreateOrUpdateIngressRoute(context.Background(), &createReq)
+		ctx, rec := writeContext(c)
+		err := h.svc.CreateOrUpdateIngressRoute(ctx, &createReq)
 		if err != nil {
+			if conflictResponse(c, err, ingressRouteDetail) {
+				return
+			}
 			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
 			return
 		}
 
+		if dryRunResponse(c, rec) {
+			return
+		}
+
 		response.Success(c)
 	}
 }
@@ -112,12 +123,17 @@ func (h *IngressRouteHandler) GetIngressRouteDetail() gin.HandlerFunc {
 			return
 		}
 
-		response.SuccessWithData(c, req.IngressRoute{
-			Name:             res.Metadata.Name,
-			Namespace:        res.Metadata.Namespace,
-			Labels:           utils.ReqMapToItem(res.Metadata.Labels),
-			IngressRouteSpec: res.Spec,
-		})
+		response.SuccessWithData(c, ingressRouteDetail(res))
+	}
+}
+
+func ingressRouteDetail(ig *service.IngressRoute) req.IngressRoute {
+	return req.IngressRoute{
+		Name:             ig.Metadata.Name,
+		Namespace:        ig.Metadata.Namespace,
+		ResourceVersion:  ig.Metadata.ResourceVersion,
+		Labels:           utils.ReqMapToItem(ig.Metadata.Labels),
+		IngressRouteSpec: ig.Spec,
 	}
 }
 
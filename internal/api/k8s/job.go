@@ -2,6 +2,7 @@ package k8s
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strings"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
 	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+	"github.com/crazyfrankie/kube-ctl/internal/model/validate"
 	"github.com/crazyfrankie/kube-ctl/internal/service"
 	"github.com/crazyfrankie/kube-ctl/pkg/response"
 )
@@ -30,6 +32,10 @@ func (h *JobHandler) RegisterRoute(r *gin.Engine) {
 		jobGroup.DELETE("", h.DeleteJob())
 		jobGroup.GET("", h.GetJobDetail())
 		jobGroup.GET("list", h.GetJobList())
+		jobGroup.GET("pods", h.GetJobPods())
+		jobGroup.POST("rerun", h.RerunJob())
+		jobGroup.POST("suspend", h.SuspendJob(true))
+		jobGroup.POST("resume", h.SuspendJob(false))
 	}
 }
 
@@ -40,8 +46,10 @@ func (h *JobHandler) RegisterRoute(r *gin.Engine) {
 // @Accept json
 // @Produce json
 // @Param pod body req.Job true "Job 配置信息"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
 // @Success 200 {object} response.Response "操作成功，返回成功消息"
-// @Failure 400 {object} response.Response "参数错误(code=20001)"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或验证错误(code=20002)"
+// @Failure 409 {object} response.Response "资源在读取后已被修改或删除(code=30002)，data 为当前对象，可合并或重新加载"
 // @Failure 500 {object} response.Response "系统错误(code=30000)"
 // @Router /api/job [post]
 func (h *JobHandler) CreateOrUpdateJob() gin.HandlerFunc {
@@ -52,12 +60,25 @@ func (h *JobHandler) CreateOrUpdateJob() gin.HandlerFunc {
 			return
 		}
 
-		err := h.svc.CreateOrUpdateJob(context.Background(), &creatReq)
+		if err := validate.JobValidate(&creatReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "validate job err: "+err.Error()))
+			return
+		}
+
+		ctx, rec := writeContext(c)
+		err := h.svc.CreateOrUpdateJob(ctx, &creatReq)
 		if err != nil {
+			if conflictResponse(c, err, convert.JobConvertReq) {
+				return
+			}
 			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
 			return
 		}
 
+		if dryRunResponse(c, rec) {
+			return
+		}
+
 		response.Success(c)
 	}
 }
@@ -148,3 +169,97 @@ func (h *JobHandler) GetJobList() gin.HandlerFunc {
 		response.SuccessWithData(c, jobs)
 	}
 }
+
+// GetJobPods
+// @Summary 获取 Job 的 Pod
+// @Description 获取 Job 创建的所有 Pod 及其状态、各容器的退出码，Indexed Job 附带完成索引
+// @Tags Job 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "Job 名称"
+// @Success 200 {object} response.Response{data=[]resp.JobPod} "返回 Pod 列表，按创建时间排序"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/job/pods [get]
+func (h *JobHandler) GetJobPods() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+		ns := c.Query("namespace")
+
+		res, err := h.svc.GetJobPods(context.Background(), name, ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		pods := make([]resp.JobPod, 0, len(res))
+		for _, p := range res {
+			pods = append(pods, convert.JobPodConvertResp(&p))
+		}
+
+		response.SuccessWithData(c, pods)
+	}
+}
+
+// RerunJob
+// @Summary 重新运行 Job
+// @Description 以已完成或失败的 Job 为模板创建一个新的 Job 副本(名称追加 -rerun-xxxxx)，原 Job 保留
+// @Tags Job 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "Job 名称"
+// @Success 200 {object} response.Response{data=req.Job} "返回新创建的 Job"
+// @Failure 400 {object} response.Response "Job 尚未结束(code=20002)"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/job/rerun [post]
+func (h *JobHandler) RerunJob() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+		ns := c.Query("namespace")
+
+		res, err := h.svc.RerunJob(context.Background(), name, ns)
+		if err != nil {
+			jobStateError(c, err)
+			return
+		}
+
+		response.SuccessWithData(c, convert.JobConvertReq(res))
+	}
+}
+
+// SuspendJob
+// @Summary 暂停或恢复 Job
+// @Description suspend 暂停运行中的 Job 并终止其 Pod，resume 恢复运行；已结束的 Job 请使用重新运行
+// @Tags Job 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "Job 名称"
+// @Success 200 {object} response.Response "操作成功"
+// @Failure 400 {object} response.Response "Job 已结束(code=20002)"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/job/suspend [post]
+// @Router /api/job/resume [post]
+func (h *JobHandler) SuspendJob(suspend bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+		ns := c.Query("namespace")
+
+		err := h.svc.SuspendJob(context.Background(), name, ns, suspend)
+		if err != nil {
+			jobStateError(c, err)
+			return
+		}
+
+		response.Success(c)
+	}
+}
+
+func jobStateError(c *gin.Context, err error) {
+	if errors.Is(err, service.ErrJobState) {
+		response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, err.Error()))
+		return
+	}
+	response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+}
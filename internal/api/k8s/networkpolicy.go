@@ -0,0 +1,200 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/crazyfrankie/gem/gerrors"
+	"github.com/gin-gonic/gin"
+
+	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
+	"github.com/crazyfrankie/kube-ctl/internal/model/req"
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+	"github.com/crazyfrankie/kube-ctl/internal/model/validate"
+	"github.com/crazyfrankie/kube-ctl/internal/service"
+	"github.com/crazyfrankie/kube-ctl/pkg/response"
+)
+
+type NetworkPolicyHandler struct {
+	svc service.NetworkPolicyService
+}
+
+func NewNetworkPolicyHandler(svc service.NetworkPolicyService) *NetworkPolicyHandler {
+	return &NetworkPolicyHandler{svc: svc}
+}
+
+func (h *NetworkPolicyHandler) RegisterRoute(r *gin.Engine) {
+	npGroup := r.Group("api/networkpolicy")
+	{
+		npGroup.POST("", h.CreateOrUpdateNetworkPolicy())
+		npGroup.DELETE("", h.DeleteNetworkPolicy())
+		npGroup.GET("", h.GetNetworkPolicyDetail())
+		npGroup.GET("list", h.GetNetworkPolicyList())
+		npGroup.POST("analyze", h.AnalyzeReachability())
+	}
+}
+
+// CreateOrUpdateNetworkPolicy
+// @Summary 创建或更新 NetworkPolicy
+// @Description 创建或更新 networking.k8s.io/v1 NetworkPolicy，支持入/出站规则、Pod/命名空间选择器、ipBlock 与端口(含端口范围与具名端口)
+// @Tags NetworkPolicy 管理
+// @Accept json
+// @Produce json
+// @Param networkPolicy body req.NetworkPolicy true "NetworkPolicy 配置信息"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
+// @Param force query bool false "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段"
+// @Success 200 {object} response.Response "操作成功，返回成功消息"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或验证错误(code=20002)"
+// @Failure 409 {object} response.Response "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/networkpolicy [post]
+func (h *NetworkPolicyHandler) CreateOrUpdateNetworkPolicy() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var createReq req.NetworkPolicy
+		if err := c.ShouldBind(&createReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20001, "bind error "+err.Error()))
+			return
+		}
+
+		if err := validate.NetworkPolicyValidate(&createReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "validate networkpolicy err: "+err.Error()))
+			return
+		}
+
+		ctx, rec := writeContext(c)
+		err := h.svc.CreateOrUpdateNetworkPolicy(ctx, &createReq)
+		if err != nil {
+			if conflictResponse(c, err, convert.NetworkPolicyConvertReq) {
+				return
+			}
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		if dryRunResponse(c, rec) {
+			return
+		}
+
+		response.Success(c)
+	}
+}
+
+// DeleteNetworkPolicy
+// @Summary 删除 NetworkPolicy
+// @Description 删除指定命名空间下的指定 NetworkPolicy
+// @Tags NetworkPolicy 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "NetworkPolicy 名称"
+// @Success 200 {object} response.Response "删除成功"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/networkpolicy [delete]
+func (h *NetworkPolicyHandler) DeleteNetworkPolicy() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+		ns := c.Query("namespace")
+
+		err := h.svc.DeleteNetworkPolicy(context.Background(), name, ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.Success(c)
+	}
+}
+
+// GetNetworkPolicyDetail
+// @Summary 获取 NetworkPolicy 详情
+// @Description 获取指定命名空间下指定 NetworkPolicy 的配置
+// @Tags NetworkPolicy 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "NetworkPolicy 名称"
+// @Success 200 {object} response.Response{data=req.NetworkPolicy} "返回 NetworkPolicy 的详细信息"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/networkpolicy [get]
+func (h *NetworkPolicyHandler) GetNetworkPolicyDetail() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+		ns := c.Query("namespace")
+
+		res, err := h.svc.GetNetworkPolicyDetail(context.Background(), name, ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.SuccessWithData(c, convert.NetworkPolicyConvertReq(res))
+	}
+}
+
+// GetNetworkPolicyList
+// @Summary 获取 NetworkPolicy 列表
+// @Description 获取指定命名空间下的所有 NetworkPolicy
+// @Tags NetworkPolicy 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param keyword query string false "关键词"
+// @Success 200 {object} response.Response{data=[]resp.NetworkPolicy} "返回 NetworkPolicy 列表"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/networkpolicy/list [get]
+func (h *NetworkPolicyHandler) GetNetworkPolicyList() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ns := c.Query("namespace")
+		keyword := c.Query("keyword")
+
+		res, err := h.svc.GetNetworkPolicyList(context.Background(), ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		nps := make([]resp.NetworkPolicy, 0, len(res))
+		for _, i := range res {
+			if strings.Contains(i.Name, keyword) {
+				nps = append(nps, convert.NetworkPolicyConvertResp(&i))
+			}
+		}
+
+		response.SuccessWithData(c, nps)
+	}
+}
+
+// AnalyzeReachability
+// @Summary 网络可达性分析
+// @Description 综合源 Pod 所在命名空间的出站策略与目标 Pod 所在命名空间的入站策略，判断源 Pod 能否访问目标 Pod 的端口，并给出起决定作用的策略
+// @Tags NetworkPolicy 管理
+// @Accept json
+// @Produce json
+// @Param reachability body req.Reachability true "源 Pod 与目标 Pod:端口"
+// @Success 200 {object} response.Response{data=resp.Reachability} "返回是否放行以及出站、入站两个方向的判定"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或 Pod 不存在、端口无法解析(code=20002)"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/networkpolicy/analyze [post]
+func (h *NetworkPolicyHandler) AnalyzeReachability() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var analyzeReq req.Reachability
+		if err := c.ShouldBind(&analyzeReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20001, "bind error "+err.Error()))
+			return
+		}
+
+		res, err := h.svc.AnalyzeReachability(context.Background(), &analyzeReq)
+		if err != nil {
+			if errors.Is(err, service.ErrReachabilityTarget) {
+				response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, err.Error()))
+				return
+			}
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.SuccessWithData(c, res)
+	}
+}
@@ -31,6 +31,7 @@ func (n *NodeHandler) RegisterRoute(r *gin.Engine) {
 		nodeGroup.PUT("label", n.UpdateNodeLabel())
 		nodeGroup.PUT("taint", n.UpdateNodeTaint())
 		nodeGroup.GET("pods", n.GetNodePods())
+		nodeGroup.GET("drain-check", n.CheckDrain())
 	}
 }
 
@@ -98,7 +99,9 @@ func (n *NodeHandler) NodeDetail() gin.HandlerFunc {
 // @Accept json
 // @Produce json
 // @Param node body req.UpdateLabelReq true "node name and labels"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
 // @Success 200 {object} response.Response "更新成功"
+// @Failure 409 {object} response.Response "资源在读取后已被修改或删除(code=30002)，data 为当前对象，可合并或重新加载"
 // @Failure 500 {object} response.Response "系统错误(code=30000)"
 // @Router /api/node/label [put]
 func (n *NodeHandler) UpdateNodeLabel() gin.HandlerFunc {
@@ -109,12 +112,20 @@ func (n *NodeHandler) UpdateNodeLabel() gin.HandlerFunc {
 			return
 		}
 
-		err := n.svc.UpdateNodeLabel(context.Background(), updateReq)
+		ctx, rec := writeContext(c)
+		err := n.svc.UpdateNodeLabel(ctx, updateReq)
 		if err != nil {
+			if conflictResponse(c, err, convert.NodeDetailConvertResp) {
+				return
+			}
 			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
 			return
 		}
 
+		if dryRunResponse(c, rec) {
+			return
+		}
+
 		response.SuccessWithMsg(c, "update label successfully")
 	}
 }
@@ -126,7 +137,10 @@ func (n *NodeHandler) UpdateNodeLabel() gin.HandlerFunc {
 // @Accept json
 // @Produce json
 // @Param node body req.UpdateTaintReq true "node name and taints"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
+// @Param force query bool false "为 true 时即使新增的 NoExecute 污点驱逐 Pod 会违反 PodDisruptionBudget 也更新"
 // @Success 200 {object} response.Response "更新成功"
+// @Failure 409 {object} response.Response "资源在读取后已被修改或删除(code=30002)，data 为当前对象，可合并或重新加载；或新增 NoExecute 污点驱逐的 Pod 会违反 PodDisruptionBudget(code=30004)，data 为被违反的 PDB"
 // @Failure 500 {object} response.Response "系统错误(code=30000)"
 // @Router /api/node/taint [put]
 func (n *NodeHandler) UpdateNodeTaint() gin.HandlerFunc {
@@ -137,12 +151,20 @@ func (n *NodeHandler) UpdateNodeTaint() gin.HandlerFunc {
 			return
 		}
 
-		err := n.svc.UpdateNodeTaints(context.Background(), updateReq)
+		ctx, rec := writeContext(c)
+		err := n.svc.UpdateNodeTaints(ctx, updateReq)
 		if err != nil {
+			if conflictResponse(c, err, convert.NodeDetailConvertResp) || disruptionResponse(c, err) {
+				return
+			}
 			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
 			return
 		}
 
+		if dryRunResponse(c, rec) {
+			return
+		}
+
 		response.SuccessWithMsg(c, "update taints successfully")
 	}
 }
@@ -177,3 +199,27 @@ func (n *NodeHandler) GetNodePods() gin.HandlerFunc {
 		response.SuccessWithData(c, pods)
 	}
 }
+
+// CheckDrain
+// @Summary Node 驱逐检查
+// @Description 检查驱逐节点上的所有 Pod(与 kubectl drain 一样跳过 DaemonSet 与静态 Pod)是否会违反 PodDisruptionBudget
+// @Tags Node管理
+// @Accept json
+// @Produce json
+// @Param node query string true "Node 名称"
+// @Success 200 {object} response.Response{data=[]resp.PDBViolation} "返回会被违反的 PDB，为空表示可以安全驱逐"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/node/drain-check [get]
+func (n *NodeHandler) CheckDrain() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		nodeName := c.Query("node")
+
+		res, err := n.svc.CheckDrain(context.Background(), nodeName)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.SuccessWithData(c, res)
+	}
+}
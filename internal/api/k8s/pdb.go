@@ -0,0 +1,165 @@
+package k8s
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/crazyfrankie/gem/gerrors"
+	"github.com/gin-gonic/gin"
+
+	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
+	"github.com/crazyfrankie/kube-ctl/internal/model/req"
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+	"github.com/crazyfrankie/kube-ctl/internal/model/validate"
+	"github.com/crazyfrankie/kube-ctl/internal/service"
+	"github.com/crazyfrankie/kube-ctl/pkg/response"
+)
+
+type PDBHandler struct {
+	svc service.PDBService
+}
+
+func NewPDBHandler(svc service.PDBService) *PDBHandler {
+	return &PDBHandler{svc: svc}
+}
+
+func (h *PDBHandler) RegisterRoute(r *gin.Engine) {
+	pdbGroup := r.Group("api/pdb")
+	{
+		pdbGroup.POST("", h.CreateOrUpdatePDB())
+		pdbGroup.DELETE("", h.DeletePDB())
+		pdbGroup.GET("", h.GetPDBDetail())
+		pdbGroup.GET("list", h.GetPDBList())
+	}
+}
+
+// CreateOrUpdatePDB
+// @Summary 创建或更新 PodDisruptionBudget
+// @Description 创建或更新 PDB，minAvailable 与 maxUnavailable 二选一，可为数字或百分比；selector 支持 matchLabels 与 matchExpressions，不能为空
+// @Tags PDB 管理
+// @Accept json
+// @Produce json
+// @Param pdb body req.PDB true "PDB 配置信息"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
+// @Param force query bool false "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段"
+// @Success 200 {object} response.Response "操作成功，返回成功消息"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或验证错误(code=20002)"
+// @Failure 409 {object} response.Response "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/pdb [post]
+func (h *PDBHandler) CreateOrUpdatePDB() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var createReq req.PDB
+		if err := c.ShouldBind(&createReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20001, "bind error "+err.Error()))
+			return
+		}
+
+		if err := validate.PDBValidate(&createReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "validate pdb err: "+err.Error()))
+			return
+		}
+
+		ctx, rec := writeContext(c)
+		err := h.svc.CreateOrUpdatePDB(ctx, &createReq)
+		if err != nil {
+			if conflictResponse(c, err, convert.PDBConvertReq) {
+				return
+			}
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		if dryRunResponse(c, rec) {
+			return
+		}
+
+		response.Success(c)
+	}
+}
+
+// DeletePDB
+// @Summary 删除 PodDisruptionBudget
+// @Description 删除指定命名空间下的指定 PDB
+// @Tags PDB 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "PDB 名称"
+// @Success 200 {object} response.Response "删除成功"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/pdb [delete]
+func (h *PDBHandler) DeletePDB() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+		ns := c.Query("namespace")
+
+		err := h.svc.DeletePDB(context.Background(), name, ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.Success(c)
+	}
+}
+
+// GetPDBDetail
+// @Summary 获取 PodDisruptionBudget 详情
+// @Description 获取指定命名空间下指定 PDB 的配置
+// @Tags PDB 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "PDB 名称"
+// @Success 200 {object} response.Response{data=req.PDB} "返回 PDB 的详细信息"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/pdb [get]
+func (h *PDBHandler) GetPDBDetail() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+		ns := c.Query("namespace")
+
+		res, err := h.svc.GetPDBDetail(context.Background(), name, ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.SuccessWithData(c, convert.PDBConvertReq(res))
+	}
+}
+
+// GetPDBList
+// @Summary 获取 PodDisruptionBudget 列表
+// @Description 获取指定命名空间下的所有 PDB，包含 currentHealthy、desiredHealthy 与 disruptionsAllowed 状态
+// @Tags PDB 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param keyword query string false "关键词"
+// @Success 200 {object} response.Response{data=[]resp.PDB} "返回 PDB 列表"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/pdb/list [get]
+func (h *PDBHandler) GetPDBList() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ns := c.Query("namespace")
+		keyword := c.Query("keyword")
+
+		res, err := h.svc.GetPDBList(context.Background(), ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		pdbs := make([]resp.PDB, 0, len(res))
+		for _, i := range res {
+			if strings.Contains(i.Name, keyword) {
+				pdbs = append(pdbs, convert.PDBConvertResp(&i))
+			}
+		}
+
+		response.SuccessWithData(c, pdbs)
+	}
+}
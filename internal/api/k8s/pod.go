@@ -33,6 +33,7 @@ func (p *PodHandler) RegisterRoute(r *gin.Engine) {
 		podGroup.GET("", p.GetPod())
 		podGroup.GET("list", p.GetPodList())
 		podGroup.DELETE("", p.DeletePod())
+		podGroup.POST("resources/preview", p.PreviewResources())
 	}
 }
 
@@ -73,8 +74,10 @@ func (p *PodHandler) GetNameSpace() gin.HandlerFunc {
 // @Accept json
 // @Produce json
 // @Param pod body req.Pod true "Pod配置信息"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
 // @Success 200 {object} response.Response "操作成功，返回成功消息"
 // @Failure 400 {object} response.Response "参数错误(code=20001)或验证错误(code=20002)"
+// @Failure 409 {object} response.Response "资源在读取后已被修改或删除(code=30002)，data 为当前对象，可合并或重新加载"
 // @Failure 500 {object} response.Response "系统错误(code=30000)"
 // @Router /api/pod [post]
 func (p *PodHandler) CreateOrUpdatePod() gin.HandlerFunc {
@@ -91,12 +94,20 @@ func (p *PodHandler) CreateOrUpdatePod() gin.HandlerFunc {
 			return
 		}
 
-		err = p.svc.CreateOrUpdatePod(context.Background(), &reqPod)
+		ctx, rec := writeContext(c)
+		err = p.svc.CreateOrUpdatePod(ctx, &reqPod)
 		if err != nil {
+			if conflictResponse(c, err, convert.PodConvertReq) {
+				return
+			}
 			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
 			return
 		}
 
+		if dryRunResponse(c, rec) {
+			return
+		}
+
 		response.SuccessWithMsg(c, fmt.Sprintf("Pod[namespace=%s,name=%s] action success", reqPod.Base.Namespace, reqPod.Base.Name))
 	}
 }
@@ -166,7 +177,9 @@ func (p *PodHandler) GetPodList() gin.HandlerFunc {
 // @Produce json
 // @Param namespace query string true "命名空间"
 // @Param name query string true "Pod名称"
+// @Param force query bool false "为 true 时即使违反 PodDisruptionBudget 也删除"
 // @Success 200 {object} response.Response "删除成功"
+// @Failure 409 {object} response.Response "删除会违反 PodDisruptionBudget(code=30004)，data 为被违反的 PDB"
 // @Failure 500 {object} response.Response "系统错误(code=30000)"
 // @Router /api/pod [delete]
 func (p *PodHandler) DeletePod() gin.HandlerFunc {
@@ -174,8 +187,11 @@ func (p *PodHandler) DeletePod() gin.HandlerFunc {
 		namespace := c.Query("namespace")
 		name := c.Query("name")
 
-		err := p.svc.DeletePod(context.Background(), namespace, name)
+		err := p.svc.DeletePod(forceContext(c), namespace, name)
 		if err != nil {
+			if disruptionResponse(c, err) {
+				return
+			}
 			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
 			return
 		}
@@ -211,3 +227,37 @@ func (p *PodHandler) SearchPod() gin.HandlerFunc {
 		response.SuccessWithData(c, pod)
 	}
 }
+
+// PreviewResources
+// @Summary 预览Pod资源配额
+// @Description 按 API Server 的默认规则及命名空间内 LimitRange 的默认值计算各容器最终的 requests 和 limits，返回 Pod 的 QoS 等级及 LimitRange 将拒绝的越界项，不会创建 Pod
+// @Tags Pod管理
+// @Accept json
+// @Produce json
+// @Param pod body req.Pod true "Pod配置信息，需填写命名空间"
+// @Success 200 {object} response.Response{data=resp.PodResourcePreview} "返回 QoS 等级、各容器生效的资源及其来源、越界项"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或验证错误(code=20002)"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/pod/resources/preview [post]
+func (p *PodHandler) PreviewResources() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqPod req.Pod
+		if err := c.ShouldBind(&reqPod); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20001, "bind error "+err.Error()))
+			return
+		}
+
+		if err := validate.PodResourcePreviewValidate(&reqPod); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "validate pod err: "+err.Error()))
+			return
+		}
+
+		res, err := p.svc.PreviewResources(context.Background(), &reqPod)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.SuccessWithData(c, res)
+	}
+}
@@ -0,0 +1,181 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+
+	"github.com/crazyfrankie/gem/gerrors"
+	"github.com/gin-gonic/gin"
+
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+	"github.com/crazyfrankie/kube-ctl/internal/service"
+	"github.com/crazyfrankie/kube-ctl/pkg/response"
+)
+
+type PodFileHandler struct {
+	svc service.PodFileService
+}
+
+func NewPodFileHandler(svc service.PodFileService) *PodFileHandler {
+	return &PodFileHandler{svc: svc}
+}
+
+func (h *PodFileHandler) RegisterRoute(r *gin.Engine) {
+	fileGroup := r.Group("api/pod/file")
+	{
+		fileGroup.GET("list", h.ListDir())
+		fileGroup.GET("download", h.Download())
+		fileGroup.POST("upload", h.Upload())
+	}
+}
+
+// ListDir
+// @Summary 浏览容器目录
+// @Description 通过 exec 在容器内列出目录下的文件，目录排在前面
+// @Tags Pod管理
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "Pod名称"
+// @Param container query string false "容器名称，Pod 只有一个容器时可省略"
+// @Param path query string true "容器内的绝对路径"
+// @Success 200 {object} response.Response{data=[]resp.ContainerFile} "返回目录下的文件列表"
+// @Failure 400 {object} response.Response "路径非法或不存在(code=20002)"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/pod/file/list [get]
+func (h *PodFileHandler) ListDir() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var files []resp.ContainerFile
+		files, err := h.svc.ListDir(context.Background(), c.Query("namespace"), c.Query("name"), c.Query("container"), c.Query("path"))
+		if err != nil {
+			fileError(c, err)
+			return
+		}
+
+		response.SuccessWithData(c, files)
+	}
+}
+
+// Download
+// @Summary 从容器下载文件或目录
+// @Description 通过 exec 执行 tar 将容器内的文件或目录打包下载，支持 tar、zip，普通文件还可以 raw 原样下载，大小超过配置上限时拒绝
+// @Tags Pod管理
+// @Produce octet-stream
+// @Param namespace query string true "命名空间"
+// @Param name query string true "Pod名称"
+// @Param container query string false "容器名称，Pod 只有一个容器时可省略"
+// @Param path query string true "容器内的绝对路径"
+// @Param format query string false "打包格式: tar(默认) | zip | raw"
+// @Success 200 {file} file "文件内容"
+// @Failure 400 {object} response.Response "路径非法、不存在或格式不支持(code=20002)"
+// @Failure 413 {object} response.Response "文件超过下载大小上限(code=20002)"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/pod/file/download [get]
+func (h *PodFileHandler) Download() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		namespace := c.Query("namespace")
+		name := c.Query("name")
+		container := c.Query("container")
+		format := c.DefaultQuery("format", service.FileFormatTar)
+
+		file, err := h.svc.Stat(context.Background(), namespace, name, container, c.Query("path"))
+		if err != nil {
+			fileError(c, err)
+			return
+		}
+		if file.Size > h.svc.MaxDownloadSize() {
+			fileError(c, fmt.Errorf("%w: %s is %d bytes, limit %d bytes", service.ErrFileTooLarge, file.Path, file.Size, h.svc.MaxDownloadSize()))
+			return
+		}
+
+		filename := file.Name
+		contentType := "application/octet-stream"
+		switch format {
+		case service.FileFormatTar:
+			filename += ".tar"
+			contentType = "application/x-tar"
+		case service.FileFormatZip:
+			filename += ".zip"
+			contentType = "application/zip"
+		case service.FileFormatRaw:
+			if file.Type != "file" {
+				fileError(c, fmt.Errorf("%w: raw download only supports regular files", service.ErrFilePath))
+				return
+			}
+		default:
+			fileError(c, fmt.Errorf("%w: unsupported format %s", service.ErrFilePath, format))
+			return
+		}
+		if file.Path == "/" {
+			filename = "root" + path.Ext(filename)
+		}
+
+		c.Header("Content-Type", contentType)
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		c.Status(http.StatusOK)
+
+		// The body has started, an error can only abort the transfer
+		if err := h.svc.Download(c.Request.Context(), namespace, name, container, file.Path, format, c.Writer); err != nil {
+			_ = c.Error(err)
+			c.Abort()
+		}
+	}
+}
+
+// Upload
+// @Summary 上传文件到容器
+// @Description 以 multipart 表单上传一个或多个文件，通过 exec 执行 tar 解包到容器目录，目录不存在时自动创建
+// @Tags Pod管理
+// @Accept multipart/form-data
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "Pod名称"
+// @Param container query string false "容器名称，Pod 只有一个容器时可省略"
+// @Param path query string true "容器内的目标目录(绝对路径)"
+// @Param files formData file true "要上传的文件，可多个"
+// @Success 200 {object} response.Response "操作成功，返回成功消息"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或路径、文件名非法(code=20002)"
+// @Failure 413 {object} response.Response "文件超过上传大小上限(code=20002)"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/pod/file/upload [post]
+func (h *PodFileHandler) Upload() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		namespace := c.Query("namespace")
+		name := c.Query("name")
+		dir := c.Query("path")
+
+		// leave some room for the multipart framing
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.svc.MaxUploadSize()+1<<20)
+		form, err := c.MultipartForm()
+		if err != nil {
+			var maxErr *http.MaxBytesError
+			if errors.As(err, &maxErr) {
+				fileError(c, fmt.Errorf("%w: limit %d bytes", service.ErrFileTooLarge, h.svc.MaxUploadSize()))
+				return
+			}
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20001, "bind error "+err.Error()))
+			return
+		}
+
+		err = h.svc.Upload(context.Background(), namespace, name, c.Query("container"), dir, form.File["files"])
+		if err != nil {
+			fileError(c, err)
+			return
+		}
+
+		response.SuccessWithMsg(c, fmt.Sprintf("Pod[namespace=%s,name=%s] upload %d files to %s success", namespace, name, len(form.File["files"]), dir))
+	}
+}
+
+func fileError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrFileTooLarge):
+		response.Error(c, http.StatusRequestEntityTooLarge, gerrors.NewBizError(20002, err.Error()))
+	case errors.Is(err, service.ErrFilePath):
+		response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, err.Error()))
+	default:
+		response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+	}
+}
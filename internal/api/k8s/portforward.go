@@ -0,0 +1,263 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/crazyfrankie/gem/gerrors"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+
+	"github.com/crazyfrankie/kube-ctl/conf"
+	"github.com/crazyfrankie/kube-ctl/internal/service"
+	"github.com/crazyfrankie/kube-ctl/pkg/response"
+)
+
+// closeFrameTimeout bounds how long the close frame may take to send
+const closeFrameTimeout = time.Second
+
+type PortForwardHandler struct {
+	svc      service.PortForwardService
+	upgrader websocket.Upgrader
+}
+
+func NewPortForwardHandler(svc service.PortForwardService) *PortForwardHandler {
+	return &PortForwardHandler{
+		svc: svc,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  32 * 1024,
+			WriteBufferSize: 32 * 1024,
+			CheckOrigin:     checkOrigin,
+		},
+	}
+}
+
+// checkOrigin only lets pages of kube-ctl itself or the configured origins open a tunnel,
+// otherwise any page the operator visits could reach pod ports through it. Clients
+// other than browsers send no Origin and are let through.
+func checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	if strings.EqualFold(u.Host, r.Host) {
+		return true
+	}
+
+	return slices.ContainsFunc(conf.GetConf().PortForward.AllowedOrigins, func(allowed string) bool {
+		return strings.EqualFold(strings.TrimSuffix(allowed, "/"), origin)
+	})
+}
+
+func (h *PortForwardHandler) RegisterRoute(r *gin.Engine) {
+	proxyGroup := r.Group("api/proxy")
+	{
+		proxyGroup.Any("pod/:namespace/:name/:port/*path", h.ProxyPod())
+		proxyGroup.Any("service/:namespace/:name/:port/*path", h.ProxyService())
+	}
+	tunnelGroup := r.Group("api/tunnel")
+	{
+		tunnelGroup.GET("pod/:namespace/:name/:port", h.TunnelPod())
+		tunnelGroup.GET("service/:namespace/:name/:port", h.TunnelService())
+	}
+}
+
+// ProxyPod
+// @Summary 通过 port-forward 代理 Pod 端口的 HTTP 请求
+// @Description 使用 pods/portforward 子资源建立隧道，将 path 之后的请求反向代理到 Pod 端口
+// @Tags PortForward 管理
+// @Param namespace path string true "命名空间"
+// @Param name path string true "Pod 名称"
+// @Param port path string true "端口号或容器端口名"
+// @Param path path string true "转发到 Pod 的请求路径"
+// @Success 200 "Pod 端口的原始响应"
+// @Failure 400 {object} response.Response "目标端口不存在或 Pod 未运行(code=20002)"
+// @Failure 429 {object} response.Response "port-forward 会话数达到上限(code=30001)"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Failure 502 {object} response.Response "转发失败(code=30000)"
+// @Router /api/proxy/pod/{namespace}/{name}/{port}/{path} [get]
+// @Router /api/proxy/pod/{namespace}/{name}/{port}/{path} [post]
+func (h *PortForwardHandler) ProxyPod() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		session, err := h.svc.ForwardPod(context.Background(), c.Param("namespace"), c.Param("name"), c.Param("port"))
+		if err != nil {
+			forwardError(c, err)
+			return
+		}
+
+		proxy(c, session)
+	}
+}
+
+// ProxyService
+// @Summary 通过 port-forward 代理 Service 端口的 HTTP 请求
+// @Description 将 Service 端口解析到一个就绪的后端 Pod，再通过 pods/portforward 反向代理请求
+// @Tags PortForward 管理
+// @Param namespace path string true "命名空间"
+// @Param name path string true "Service 名称"
+// @Param port path string true "Service 端口号或端口名"
+// @Param path path string true "转发到 Pod 的请求路径"
+// @Success 200 "后端 Pod 的原始响应"
+// @Failure 400 {object} response.Response "端口不存在或没有就绪的后端(code=20002)"
+// @Failure 429 {object} response.Response "port-forward 会话数达到上限(code=30001)"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Failure 502 {object} response.Response "转发失败(code=30000)"
+// @Router /api/proxy/service/{namespace}/{name}/{port}/{path} [get]
+// @Router /api/proxy/service/{namespace}/{name}/{port}/{path} [post]
+func (h *PortForwardHandler) ProxyService() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		session, err := h.svc.ForwardService(context.Background(), c.Param("namespace"), c.Param("name"), c.Param("port"))
+		if err != nil {
+			forwardError(c, err)
+			return
+		}
+
+		proxy(c, session)
+	}
+}
+
+// TunnelPod
+// @Summary 建立到 Pod 端口的 TCP WebSocket 隧道
+// @Description 升级为 WebSocket 后，二进制消息与 Pod 端口的 TCP 字节流双向转发，空闲超时后自动断开
+// @Tags PortForward 管理
+// @Param namespace path string true "命名空间"
+// @Param name path string true "Pod 名称"
+// @Param port path string true "端口号或容器端口名"
+// @Success 101 "切换为 WebSocket 协议"
+// @Failure 400 {object} response.Response "目标端口不存在或 Pod 未运行(code=20002)"
+// @Failure 429 {object} response.Response "port-forward 会话数达到上限(code=30001)"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/tunnel/pod/{namespace}/{name}/{port} [get]
+func (h *PortForwardHandler) TunnelPod() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		session, err := h.svc.ForwardPod(context.Background(), c.Param("namespace"), c.Param("name"), c.Param("port"))
+		if err != nil {
+			forwardError(c, err)
+			return
+		}
+
+		h.tunnel(c, session)
+	}
+}
+
+// TunnelService
+// @Summary 建立到 Service 端口的 TCP WebSocket 隧道
+// @Description 将 Service 端口解析到一个就绪的后端 Pod，升级为 WebSocket 后双向转发 TCP 字节流
+// @Tags PortForward 管理
+// @Param namespace path string true "命名空间"
+// @Param name path string true "Service 名称"
+// @Param port path string true "Service 端口号或端口名"
+// @Success 101 "切换为 WebSocket 协议"
+// @Failure 400 {object} response.Response "端口不存在或没有就绪的后端(code=20002)"
+// @Failure 429 {object} response.Response "port-forward 会话数达到上限(code=30001)"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/tunnel/service/{namespace}/{name}/{port} [get]
+func (h *PortForwardHandler) TunnelService() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		session, err := h.svc.ForwardService(context.Background(), c.Param("namespace"), c.Param("name"), c.Param("port"))
+		if err != nil {
+			forwardError(c, err)
+			return
+		}
+
+		h.tunnel(c, session)
+	}
+}
+
+func (h *PortForwardHandler) tunnel(c *gin.Context, session *service.PortForwardSession) {
+	ws, err := h.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		// the upgrader has already replied with an http error
+		return
+	}
+
+	_ = session.Pipe(&wsStream{conn: ws})
+}
+
+func proxy(c *gin.Context, session *service.PortForwardSession) {
+	session.Acquire()
+	defer session.Release()
+
+	target := &url.URL{Scheme: "http", Host: session.Addr()}
+	rp := &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			r.SetURL(target)
+			r.Out.URL.Path = c.Param("path")
+			r.Out.URL.RawPath = ""
+			r.SetXForwarded()
+		},
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			response.Error(c, http.StatusBadGateway, gerrors.NewBizError(30000, "port-forward proxy error "+err.Error()))
+		},
+	}
+
+	rp.ServeHTTP(c.Writer, c.Request)
+}
+
+func forwardError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrForwardTarget):
+		response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, err.Error()))
+	case errors.Is(err, service.ErrTooManySessions):
+		response.Error(c, http.StatusTooManyRequests, gerrors.NewBizError(30001, err.Error()))
+	default:
+		response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+	}
+}
+
+// wsStream adapts a websocket connection to a byte stream, each binary message is a chunk.
+type wsStream struct {
+	conn   *websocket.Conn
+	reader io.Reader
+}
+
+func (s *wsStream) Read(p []byte) (int, error) {
+	for {
+		if s.reader == nil {
+			_, r, err := s.conn.NextReader()
+			if err != nil {
+				if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+					return 0, io.EOF
+				}
+				return 0, err
+			}
+			s.reader = r
+		}
+		n, err := s.reader.Read(p)
+		if err == io.EOF {
+			s.reader = nil
+			if n == 0 {
+				continue
+			}
+			return n, nil
+		}
+		return n, err
+	}
+}
+
+func (s *wsStream) Write(p []byte) (int, error) {
+	if err := s.conn.WriteMessage(websocket.BinaryMessage, p); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// Close may run while Write is still busy, WriteControl is the one write that is safe next to another writer.
+func (s *wsStream) Close() error {
+	_ = s.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+		time.Now().Add(closeFrameTimeout))
+
+	return s.conn.Close()
+}
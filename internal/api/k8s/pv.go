@@ -10,6 +10,7 @@ import (
 	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
 	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+	"github.com/crazyfrankie/kube-ctl/internal/model/validate"
 	"github.com/crazyfrankie/kube-ctl/internal/service"
 	"github.com/crazyfrankie/kube-ctl/pkg/response"
 )
@@ -33,13 +34,14 @@ func (h *PVHandler) RegisterRoute(r *gin.Engine) {
 
 // CreatePV
 // @Summary 创建 PV
-// @Description 创建一个 PersistentVolume 存储空间
+// @Description 创建一个 PersistentVolume 存储空间，支持 nfs、hostPath、local(需指定节点)、csi、iscsi 卷，可通过 claimRef 预绑定到指定 PVC
 // @Tags PV 管理
 // @Accept json
 // @Produce json
 // @Param pod body req.PersistentVolume true "PV 信息"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
 // @Success 200 {object} response.Response "创建 PV 成功"
-// @Failure 400 {object} response.Response "参数错误(code=20001)"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或验证错误(code=20002)，如不支持的卷类型"
 // @Failure 500 {object} response.Response "系统错误(code=30000)"
 // @Router /api/pv [post]
 func (h *PVHandler) CreatePV() gin.HandlerFunc {
@@ -50,12 +52,22 @@ func (h *PVHandler) CreatePV() gin.HandlerFunc {
 			return
 		}
 
-		err := h.svc.CreatePV(context.Background(), &createReq)
+		if err := validate.PVValidate(&createReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "validate pv err: "+err.Error()))
+			return
+		}
+
+		ctx, rec := writeContext(c)
+		err := h.svc.CreatePV(ctx, &createReq)
 		if err != nil {
 			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
 			return
 		}
 
+		if dryRunResponse(c, rec) {
+			return
+		}
+
 		response.Success(c)
 	}
 }
@@ -2,6 +2,7 @@ package k8s
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strings"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
 	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+	"github.com/crazyfrankie/kube-ctl/internal/model/validate"
 	"github.com/crazyfrankie/kube-ctl/internal/service"
 	"github.com/crazyfrankie/kube-ctl/pkg/response"
 )
@@ -29,6 +31,9 @@ func (h *PVCHandler) RegisterRoute(r *gin.Engine) {
 		pvcGroup.POST("", h.CreatePVC())
 		pvcGroup.DELETE("", h.DeletePVC())
 		pvcGroup.GET("", h.GetPVCList())
+		pvcGroup.GET("detail", h.GetPVCDetail())
+		pvcGroup.POST("expand", h.ExpandPVC())
+		pvcGroup.GET("diagnose", h.DiagnosePVC())
 	}
 }
 
@@ -39,8 +44,9 @@ func (h *PVCHandler) RegisterRoute(r *gin.Engine) {
 // @Accept json
 // @Produce json
 // @Param pod body req.PersistentVolumeClaim true "PVC 信息"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
 // @Success 200 {object} response.Response "创建 PVC 成功"
-// @Failure 400 {object} response.Response "参数错误(code=20001)"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或验证错误(code=20002)"
 // @Failure 500 {object} response.Response "系统错误(code=30000)"
 // @Router /api/pvc [post]
 func (h *PVCHandler) CreatePVC() gin.HandlerFunc {
@@ -51,12 +57,22 @@ func (h *PVCHandler) CreatePVC() gin.HandlerFunc {
 			return
 		}
 
-		err := h.svc.CreatePVC(context.Background(), &createReq)
+		if err := validate.PVCValidate(&createReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "validate pvc err: "+err.Error()))
+			return
+		}
+
+		ctx, rec := writeContext(c)
+		err := h.svc.CreatePVC(ctx, &createReq)
 		if err != nil {
 			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
 			return
 		}
 
+		if dryRunResponse(c, rec) {
+			return
+		}
+
 		response.Success(c)
 	}
 }
@@ -118,3 +134,98 @@ func (h *PVCHandler) GetPVCList() gin.HandlerFunc {
 		response.SuccessWithData(c, pvcs)
 	}
 }
+
+// GetPVCDetail
+// @Summary 获取 PVC 详情
+// @Description 获取 PVC 的阶段、条件、StorageClass、申请容量与实际容量、绑定的 PV 以及挂载它的 Pod
+// @Tags PVC 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "PVC 名称"
+// @Success 200 {object} response.Response{data=resp.PersistentVolumeClaimDetail} "返回 PVC 详情"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/pvc/detail [get]
+func (h *PVCHandler) GetPVCDetail() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+		ns := c.Query("namespace")
+
+		pvc, err := h.svc.GetPVCDetail(context.Background(), name, ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+		pv, err := h.svc.GetPVCVolume(context.Background(), pvc)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+		pods, err := h.svc.GetPVCPods(context.Background(), pvc)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.SuccessWithData(c, convert.PVCDetailConvertResp(pvc, pv, pods))
+	}
+}
+
+// ExpandPVC
+// @Summary 在线扩容 PVC
+// @Description 调大已绑定 PVC 的申请容量，要求其 StorageClass 开启 allowVolumeExpansion；扩容进度见详情中的 resizing 与条件
+// @Tags PVC 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "PVC 名称"
+// @Param capacity query string true "新的容量，如 20Gi，需大于当前容量"
+// @Success 200 {object} response.Response "操作成功"
+// @Failure 400 {object} response.Response "无法扩容(code=20002)，如未绑定、容量未增大或 StorageClass 不允许扩容"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/pvc/expand [post]
+func (h *PVCHandler) ExpandPVC() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+		ns := c.Query("namespace")
+		capacity := c.Query("capacity")
+
+		err := h.svc.ExpandPVC(context.Background(), name, ns, capacity)
+		if err != nil {
+			if errors.Is(err, service.ErrPVCExpand) {
+				response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, err.Error()))
+				return
+			}
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.Success(c)
+	}
+}
+
+// DiagnosePVC
+// @Summary 诊断 PVC 绑定
+// @Description 解释 PVC 处于 Pending 的原因：没有匹配的 PV(并列出同类 PV 不匹配的原因)、WaitForFirstConsumer 等待 Pod 调度、StorageClass 不存在、供应器(provisioner)缺失或供应失败，附带 PVC 的最近事件
+// @Tags PVC 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "PVC 名称"
+// @Success 200 {object} response.Response{data=resp.PVCDiagnosis} "返回诊断结果"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/pvc/diagnose [get]
+func (h *PVCHandler) DiagnosePVC() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+		ns := c.Query("namespace")
+
+		res, err := h.svc.DiagnosePVC(context.Background(), name, ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.SuccessWithData(c, res)
+	}
+}
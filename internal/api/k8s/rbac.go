@@ -7,6 +7,7 @@ import (
 
 	"github.com/crazyfrankie/gem/gerrors"
 	"github.com/gin-gonic/gin"
+	rbacv1 "k8s.io/api/rbac/v1"
 
 	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
@@ -56,6 +57,7 @@ func (h *RbacHandler) RegisterRoute(r *gin.Engine) {
 // @Accept json
 // @Produce json
 // @Param serviceAccount body req.ServiceAccount true "ServiceAccount 信息"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
 // @Success 200 {object} response.Response "创建 SA 成功"
 // @Failure 400 {object} response.Response "参数错误(code=20001)"
 // @Failure 500 {object} response.Response "系统错误(code=30000)"
@@ -68,12 +70,17 @@ func (h *RbacHandler) CreateServiceAccount() gin.HandlerFunc {
 			return
 		}
 
-		err := h.svc.CreateServiceAccount(context.Background(), &createReq)
+		ctx, rec := writeContext(c)
+		err := h.svc.CreateServiceAccount(ctx, &createReq)
 		if err != nil {
 			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
 			return
 		}
 
+		if dryRunResponse(c, rec) {
+			return
+		}
+
 		response.Success(c)
 	}
 }
@@ -148,8 +155,11 @@ func (h *RbacHandler) GetServiceAccountList() gin.HandlerFunc {
 // @Accept json
 // @Produce json
 // @Param role body req.Role true "Role | ClusterRole 规则信息"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
+// @Param force query bool false "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段"
 // @Success 200 {object} response.Response "创建或更新 Role 成功"
 // @Failure 400 {object} response.Response "参数错误(code=20001)"
+// @Failure 409 {object} response.Response "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段"
 // @Failure 500 {object} response.Response "系统错误(code=30000)"
 // @Router /api/rbac/role [post]
 func (h *RbacHandler) CreateOrUpdateRole() gin.HandlerFunc {
@@ -160,12 +170,20 @@ func (h *RbacHandler) CreateOrUpdateRole() gin.HandlerFunc {
 			return
 		}
 
-		err := h.svc.CreateOrUpdateRole(context.Background(), &createReq)
+		ctx, rec := writeContext(c)
+		err := h.svc.CreateOrUpdateRole(ctx, &createReq)
 		if err != nil {
+			if conflictResponse(c, err, roleDetail) {
+				return
+			}
 			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
 			return
 		}
 
+		if dryRunResponse(c, rec) {
+			return
+		}
+
 		response.Success(c)
 	}
 }
@@ -283,8 +301,11 @@ func (h *RbacHandler) GetRoleList() gin.HandlerFunc {
 // @Accept json
 // @Produce json
 // @Param roleBinding body req.RoleBinding true "RoleBinding | ClusterRoleBinding 用户与规则绑定信息"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
+// @Param force query bool false "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段"
 // @Success 200 {object} response.Response "创建或更新 RB 成功"
 // @Failure 400 {object} response.Response "参数错误(code=20001)"
+// @Failure 409 {object} response.Response "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段"
 // @Failure 500 {object} response.Response "系统错误(code=30000)"
 // @Router /api/rbac/rb [post]
 func (h *RbacHandler) CreateOrUpdateRoleBinding() gin.HandlerFunc {
@@ -295,12 +316,20 @@ func (h *RbacHandler) CreateOrUpdateRoleBinding() gin.HandlerFunc {
 			return
 		}
 
-		err := h.svc.CreateOrUpdateRoleBinding(context.Background(), &createReq)
+		ctx, rec := writeContext(c)
+		err := h.svc.CreateOrUpdateRoleBinding(ctx, &createReq)
 		if err != nil {
+			if conflictResponse(c, err, roleBindingDetail) {
+				return
+			}
 			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
 			return
 		}
 
+		if dryRunResponse(c, rec) {
+			return
+		}
+
 		response.Success(c)
 	}
 }
@@ -410,3 +439,27 @@ func (h *RbacHandler) GetRoleBindingList() gin.HandlerFunc {
 		}
 	}
 }
+
+// roleDetail renders the Role or ClusterRole of a conflict like the detail endpoint.
+func roleDetail(obj any) any {
+	switch role := obj.(type) {
+	case *rbacv1.ClusterRole:
+		return convert.ClusterRoleConvertReq(role)
+	case *rbacv1.Role:
+		return convert.RoleConvertReq(role)
+	}
+
+	return nil
+}
+
+// roleBindingDetail renders the RoleBinding or ClusterRoleBinding of a conflict like the detail endpoint.
+func roleBindingDetail(obj any) any {
+	switch rb := obj.(type) {
+	case *rbacv1.ClusterRoleBinding:
+		return convert.ClusterRoleBindingConvertReq(rb)
+	case *rbacv1.RoleBinding:
+		return convert.RoleBindingConvertReq(rb)
+	}
+
+	return nil
+}
@@ -2,7 +2,11 @@ package k8s
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"strings"
 
 	"github.com/crazyfrankie/gem/gerrors"
 	"github.com/gin-gonic/gin"
@@ -10,7 +14,9 @@ import (
 	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
 	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+	"github.com/crazyfrankie/kube-ctl/internal/model/validate"
 	"github.com/crazyfrankie/kube-ctl/internal/service"
+	"github.com/crazyfrankie/kube-ctl/pkg/consts"
 	"github.com/crazyfrankie/kube-ctl/pkg/response"
 )
 
@@ -26,6 +32,11 @@ func (h *SecretHandler) RegisterRoute(r *gin.Engine) {
 	secretGroup := r.Group("api/secret")
 	{
 		secretGroup.POST("", h.CreateOrUpdateSecret())
+		secretGroup.POST("dockerconfigjson", h.CreateOrUpdateDockerConfigSecret())
+		secretGroup.POST("tls", h.CreateOrUpdateTLSSecret())
+		secretGroup.POST("basic-auth", h.CreateOrUpdateBasicAuthSecret())
+		secretGroup.POST("ssh-auth", h.CreateOrUpdateSSHAuthSecret())
+		secretGroup.POST("reveal", h.RevealSecret())
 		secretGroup.GET("", h.GetSecret())
 		secretGroup.GET("list", h.GetSecretList())
 		secretGroup.DELETE("", h.DeleteSecret())
@@ -34,13 +45,16 @@ func (h *SecretHandler) RegisterRoute(r *gin.Engine) {
 
 // CreateOrUpdateSecret
 // @Summary 创建或更新 Secret
-// @Description 创建新的 Secret 或更新已存在的 Secret
+// @Description 创建新的 Secret 或更新已存在的 Secret，data 中传明文值；更新时值为掩码 ****** 的 key 保留原值；restartConsumers 为 true 时保存后滚动重启使用它的工作负载
 // @Tags Secret管理
 // @Accept json
 // @Produce json
 // @Param pod body req.Secret true "Secret 配置信息"
-// @Success 200 {object} response.Response "操作成功，返回成功消息"
-// @Failure 400 {object} response.Response "参数错误(code=20001)"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
+// @Param force query bool false "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段"
+// @Success 200 {object} response.Response{data=[]resp.Consumer} "操作成功；开启重启时返回已重启的工作负载"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或验证错误(code=20002)"
+// @Failure 409 {object} response.Response "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段"
 // @Failure 500 {object} response.Response "系统错误(code=30000)"
 // @Router /api/secret [post]
 func (h *SecretHandler) CreateOrUpdateSecret() gin.HandlerFunc {
@@ -51,9 +65,175 @@ func (h *SecretHandler) CreateOrUpdateSecret() gin.HandlerFunc {
 			return
 		}
 
-		err := h.svc.CreateOrUpdateSecret(context.Background(), &cmReq)
+		if err := validate.SecretValidate(&cmReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "validate secret err: "+err.Error()))
+			return
+		}
+
+		ctx, rec := writeContext(c)
+		err := h.svc.CreateOrUpdateSecret(ctx, &cmReq)
 		if err != nil {
-			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			secretError(c, err)
+			return
+		}
+
+		if dryRunResponse(c, rec) {
+			return
+		}
+
+		if cmReq.RestartConsumers {
+			restarted, err := h.svc.RestartConsumers(context.Background(), cmReq.Name, cmReq.Namespace)
+			if err != nil {
+				response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+				return
+			}
+
+			response.SuccessWithData(c, restarted)
+			return
+		}
+
+		response.Success(c)
+	}
+}
+
+// CreateOrUpdateDockerConfigSecret
+// @Summary 创建或更新镜像仓库 Secret
+// @Description 根据仓库地址、用户名和密码生成 kubernetes.io/dockerconfigjson 类型的 Secret
+// @Tags Secret管理
+// @Accept json
+// @Produce json
+// @Param secret body req.DockerConfigSecret true "镜像仓库认证信息"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
+// @Param force query bool false "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段"
+// @Success 200 {object} response.Response "操作成功，返回成功消息"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或验证错误(code=20002)"
+// @Failure 409 {object} response.Response "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/secret/dockerconfigjson [post]
+func (h *SecretHandler) CreateOrUpdateDockerConfigSecret() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var secretReq req.DockerConfigSecret
+		if err := c.ShouldBind(&secretReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20001, "bind error "+err.Error()))
+			return
+		}
+
+		if err := validate.DockerConfigSecretValidate(&secretReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "validate secret err: "+err.Error()))
+			return
+		}
+
+		ctx, rec := writeContext(c)
+		err := h.svc.CreateOrUpdateDockerConfigSecret(ctx, &secretReq)
+		if err != nil {
+			secretError(c, err)
+			return
+		}
+
+		if dryRunResponse(c, rec) {
+			return
+		}
+
+		response.Success(c)
+	}
+}
+
+// CreateOrUpdateTLSSecret
+// @Summary 创建或更新 TLS Secret
+// @Description 上传 PEM 格式的证书和私钥，校验证书可解析、未过期且与私钥匹配后生成 kubernetes.io/tls 类型的 Secret
+// @Tags Secret管理
+// @Accept multipart/form-data
+// @Produce json
+// @Param name formData string true "Secret 名称"
+// @Param namespace formData string true "命名空间"
+// @Param resourceVersion formData string false "修改所基于的资源版本，为空时不做冲突检查"
+// @Param labels formData []string false "标签，格式为 key=value，可重复传多个" collectionFormat(multi)
+// @Param cert formData file true "PEM 格式证书(链)"
+// @Param key formData file true "PEM 格式私钥"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
+// @Param force query bool false "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段"
+// @Success 200 {object} response.Response "操作成功，返回成功消息"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或验证错误(code=20002)"
+// @Failure 409 {object} response.Response "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段"
+// @Failure 413 {object} response.Response "证书或私钥文件超过 1MiB 大小上限(code=20002)"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/secret/tls [post]
+func (h *SecretHandler) CreateOrUpdateTLSSecret() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var secretReq req.TLSSecret
+		if err := c.ShouldBind(&secretReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20001, "bind error "+err.Error()))
+			return
+		}
+		var err error
+		if secretReq.Labels, err = formItems(c.PostFormArray("labels")); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20001, "bind error labels: "+err.Error()))
+			return
+		}
+		if secretReq.Cert, err = readFormFile(c, "cert"); err != nil {
+			uploadError(c, err)
+			return
+		}
+		if secretReq.Key, err = readFormFile(c, "key"); err != nil {
+			uploadError(c, err)
+			return
+		}
+
+		if err := validate.TLSSecretValidate(&secretReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "validate secret err: "+err.Error()))
+			return
+		}
+
+		ctx, rec := writeContext(c)
+		err = h.svc.CreateOrUpdateTLSSecret(ctx, &secretReq)
+		if err != nil {
+			secretError(c, err)
+			return
+		}
+
+		if dryRunResponse(c, rec) {
+			return
+		}
+
+		response.Success(c)
+	}
+}
+
+// CreateOrUpdateBasicAuthSecret
+// @Summary 创建或更新 basic-auth Secret
+// @Description 生成 kubernetes.io/basic-auth 类型的 Secret，用户名和密码至少填写一个
+// @Tags Secret管理
+// @Accept json
+// @Produce json
+// @Param secret body req.BasicAuthSecret true "用户名和密码"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
+// @Param force query bool false "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段"
+// @Success 200 {object} response.Response "操作成功，返回成功消息"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或验证错误(code=20002)"
+// @Failure 409 {object} response.Response "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/secret/basic-auth [post]
+func (h *SecretHandler) CreateOrUpdateBasicAuthSecret() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var secretReq req.BasicAuthSecret
+		if err := c.ShouldBind(&secretReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20001, "bind error "+err.Error()))
+			return
+		}
+
+		if err := validate.BasicAuthSecretValidate(&secretReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "validate secret err: "+err.Error()))
+			return
+		}
+
+		ctx, rec := writeContext(c)
+		err := h.svc.CreateOrUpdateBasicAuthSecret(ctx, &secretReq)
+		if err != nil {
+			secretError(c, err)
+			return
+		}
+
+		if dryRunResponse(c, rec) {
 			return
 		}
 
@@ -61,6 +241,86 @@ func (h *SecretHandler) CreateOrUpdateSecret() gin.HandlerFunc {
 	}
 }
 
+// CreateOrUpdateSSHAuthSecret
+// @Summary 创建或更新 ssh-auth Secret
+// @Description 使用 PEM 格式私钥生成 kubernetes.io/ssh-auth 类型的 Secret，可选附带 known_hosts
+// @Tags Secret管理
+// @Accept json
+// @Produce json
+// @Param secret body req.SSHAuthSecret true "SSH 私钥"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
+// @Param force query bool false "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段"
+// @Success 200 {object} response.Response "操作成功，返回成功消息"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或验证错误(code=20002)"
+// @Failure 409 {object} response.Response "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/secret/ssh-auth [post]
+func (h *SecretHandler) CreateOrUpdateSSHAuthSecret() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var secretReq req.SSHAuthSecret
+		if err := c.ShouldBind(&secretReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20001, "bind error "+err.Error()))
+			return
+		}
+
+		if err := validate.SSHAuthSecretValidate(&secretReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "validate secret err: "+err.Error()))
+			return
+		}
+
+		ctx, rec := writeContext(c)
+		err := h.svc.CreateOrUpdateSSHAuthSecret(ctx, &secretReq)
+		if err != nil {
+			secretError(c, err)
+			return
+		}
+
+		if dryRunResponse(c, rec) {
+			return
+		}
+
+		response.Success(c)
+	}
+}
+
+// RevealSecret
+// @Summary 查看 Secret 明文
+// @Description 返回指定 key 的解码值(不指定则返回全部)，必须填写原因；每次查看都会记录审计日志并在 Secret 上生成 SecretRevealed 事件
+// @Tags Secret管理
+// @Accept json
+// @Produce json
+// @Param reveal body req.SecretReveal true "要查看的 Secret、key 以及原因"
+// @Success 200 {object} response.Response{data=[]resp.SecretItem} "返回明文值，二进制值以 base64 返回"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或验证错误(code=20002)"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/secret/reveal [post]
+func (h *SecretHandler) RevealSecret() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var revealReq req.SecretReveal
+		if err := c.ShouldBind(&revealReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20001, "bind error "+err.Error()))
+			return
+		}
+		if revealReq.Name == "" || revealReq.Namespace == "" || revealReq.Reason == "" {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "secret name, namespace and reason are necessary"))
+			return
+		}
+
+		var items []resp.SecretItem
+		items, err := h.svc.RevealSecret(context.Background(), &revealReq, service.SecretAudit{
+			ClientIP:  c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
+			Reason:    revealReq.Reason,
+		})
+		if err != nil {
+			secretError(c, err)
+			return
+		}
+
+		response.SuccessWithData(c, items)
+	}
+}
+
 // GetSecret
 // @Summary 获取Secret详情
 // @Description 获取指定命名空间下指定Secret的详细信息
@@ -69,7 +329,7 @@ func (h *SecretHandler) CreateOrUpdateSecret() gin.HandlerFunc {
 // @Produce json
 // @Param namespace query string true "命名空间"
 // @Param name query string true "Secret名称"
-// @Success 200 {object} response.Response{data=resp.SecretDetail} "返回Secret的详细信息"
+// @Success 200 {object} response.Response{data=resp.SecretDetail} "返回Secret的详细信息，值已掩码，明文请调用 reveal 接口；usedBy 为引用它的工作负载和 Pod"
 // @Failure 500 {object} response.Response "系统错误(code=30000)"
 // @Router /api/secret [get]
 func (h *SecretHandler) GetSecret() gin.HandlerFunc {
@@ -84,6 +344,11 @@ func (h *SecretHandler) GetSecret() gin.HandlerFunc {
 		}
 
 		se := convert.SecretConvertDetailResp(res)
+		se.UsedBy, err = h.svc.GetConsumers(context.Background(), name, ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
 
 		response.SuccessWithData(c, se)
 	}
@@ -143,3 +408,62 @@ func (h *SecretHandler) DeleteSecret() gin.HandlerFunc {
 		response.Success(c)
 	}
 }
+
+// readFormFile reads an uploaded file, rejecting one past the size limit of a secret
+// rather than cutting it, a chain cut at a PEM boundary would still parse.
+func readFormFile(c *gin.Context, field string) ([]byte, error) {
+	fh, err := c.FormFile(field)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", field, err)
+	}
+	f, err := fh.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(io.LimitReader(f, consts.SecretMaxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > consts.SecretMaxSize {
+		return nil, fmt.Errorf("%w: %s is over %d bytes", service.ErrSecretTooLarge, field, consts.SecretMaxSize)
+	}
+
+	return data, nil
+}
+
+func uploadError(c *gin.Context, err error) {
+	if errors.Is(err, service.ErrSecretTooLarge) {
+		response.Error(c, http.StatusRequestEntityTooLarge, gerrors.NewBizError(20002, err.Error()))
+		return
+	}
+
+	response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20001, "bind error "+err.Error()))
+}
+
+// formItems parses repeated key=value form fields, multipart forms have no room for the JSON items.
+func formItems(values []string) ([]req.Item, error) {
+	items := make([]req.Item, 0, len(values))
+	for _, v := range values {
+		key, value, ok := strings.Cut(v, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("%q is not in key=value form", v)
+		}
+		items = append(items, req.Item{Key: key, Value: value})
+	}
+
+	return items, nil
+}
+
+func secretError(c *gin.Context, err error) {
+	if conflictResponse(c, err, convert.SecretConvertDetailResp) {
+		return
+	}
+	switch {
+	case errors.Is(err, service.ErrSecretMasked), errors.Is(err, service.ErrSecretKey):
+		response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, err.Error()))
+	default:
+		response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
 	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+	"github.com/crazyfrankie/kube-ctl/internal/model/validate"
 	"github.com/crazyfrankie/kube-ctl/internal/service"
 	"github.com/crazyfrankie/kube-ctl/pkg/response"
 )
@@ -30,6 +31,7 @@ func (h *ServiceHandler) RegisterRoute(r *gin.Engine) {
 		serviceGroup.DELETE("", h.DeleteService())
 		serviceGroup.GET("", h.GetServiceDetail())
 		serviceGroup.GET("list", h.GetServiceList())
+		serviceGroup.GET("topology", h.GetServiceTopology())
 	}
 }
 
@@ -40,8 +42,11 @@ func (h *ServiceHandler) RegisterRoute(r *gin.Engine) {
 // @Accept json
 // @Produce json
 // @Param pod body req.Service true "Service 配置信息"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
+// @Param force query bool false "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段"
 // @Success 200 {object} response.Response "操作成功，返回成功消息"
-// @Failure 400 {object} response.Response "参数错误(code=20001)"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或验证错误(code=20002)"
+// @Failure 409 {object} response.Response "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段"
 // @Failure 500 {object} response.Response "系统错误(code=30000)"
 // @Router /api/service [post]
 func (h *ServiceHandler) CreateOrUpdateService() gin.HandlerFunc {
@@ -52,12 +57,26 @@ func (h *ServiceHandler) CreateOrUpdateService() gin.HandlerFunc {
 			return
 		}
 
-		err := h.svc.CreateOrUpdateService(context.Background(), &createReq)
+		err := validate.ServiceValidate(&createReq)
 		if err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "validate service err: "+err.Error()))
+			return
+		}
+
+		ctx, rec := writeContext(c)
+		err = h.svc.CreateOrUpdateService(ctx, &createReq)
+		if err != nil {
+			if conflictResponse(c, err, convert.ServiceConvertReq) {
+				return
+			}
 			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
 			return
 		}
 
+		if dryRunResponse(c, rec) {
+			return
+		}
+
 		response.Success(c)
 	}
 }
@@ -148,3 +167,29 @@ func (h *ServiceHandler) GetServiceList() gin.HandlerFunc {
 		response.SuccessWithData(c, svcs)
 	}
 }
+
+// GetServiceTopology
+// @Summary 获取Service拓扑
+// @Description 解析 Service 的 EndpointSlice、后端 Pod 以及路由到该 Service 的 Ingress/IngressRoute，并标记选择器无匹配 Pod、targetPort 不存在等问题
+// @Tags Service 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "Service 名称"
+// @Success 200 {object} response.Response{data=resp.ServiceTopology} "返回Service的拓扑信息"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/service/topology [get]
+func (h *ServiceHandler) GetServiceTopology() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+		ns := c.Query("namespace")
+
+		res, err := h.svc.GetServiceTopology(context.Background(), name, ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.SuccessWithData(c, res)
+	}
+}
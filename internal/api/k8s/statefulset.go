@@ -3,6 +3,7 @@ package k8s
 import (
 	"context"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/crazyfrankie/gem/gerrors"
@@ -11,6 +12,7 @@ import (
 	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
 	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+	"github.com/crazyfrankie/kube-ctl/internal/model/validate"
 	"github.com/crazyfrankie/kube-ctl/internal/service"
 	"github.com/crazyfrankie/kube-ctl/pkg/response"
 )
@@ -30,6 +32,10 @@ func (h *StatefulSetHandler) RegisterRoute(r *gin.Engine) {
 		statefulGroup.DELETE("", h.DeleteStatefulSet())
 		statefulGroup.GET("", h.GetStatefulSetDetail())
 		statefulGroup.GET("list", h.GetStatefulSetList())
+		statefulGroup.POST("scale", h.ScaleStatefulSet())
+		statefulGroup.GET("pods", h.GetStatefulSetPods())
+		statefulGroup.GET("pvc", h.GetStatefulSetPVCs())
+		statefulGroup.DELETE("pvc", h.DeleteOrphanedPVCs())
 	}
 }
 
@@ -40,8 +46,11 @@ func (h *StatefulSetHandler) RegisterRoute(r *gin.Engine) {
 // @Accept json
 // @Produce json
 // @Param pod body req.StatefulSet true "StatefulSet 配置信息"
-// @Success 200 {object} response.Response "操作成功，返回成功消息"
-// @Failure 400 {object} response.Response "参数错误(code=20001)"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
+// @Param force query bool false "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段"
+// @Success 200 {object} response.Response "操作成功；副本数由生效中的 HPA 管理时 msg 为覆盖提示，预演时该提示位于 data.warnings"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或验证错误(code=20002)"
+// @Failure 409 {object} response.Response "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段"
 // @Failure 500 {object} response.Response "系统错误(code=30000)"
 // @Router /api/statefulset [post]
 func (h *StatefulSetHandler) CreateOrUpdateStatefulSet() gin.HandlerFunc {
@@ -52,12 +61,31 @@ func (h *StatefulSetHandler) CreateOrUpdateStatefulSet() gin.HandlerFunc {
 			return
 		}
 
-		err := h.svc.CreateOrUpdateStatefulSet(context.Background(), &createReq)
+		if err := validate.StatefulSetValidate(&createReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "validate statefulset err: "+err.Error()))
+			return
+		}
+
+		// looked up before the write so a dry run shows it as well
+		warning := autoscalerWarning("StatefulSet", createReq.Name, createReq.Namespace, createReq.Replicas, h.svc.GetAutoscaler)
+		ctx, rec := writeContext(c)
+		err := h.svc.CreateOrUpdateStatefulSet(ctx, &createReq)
 		if err != nil {
+			if conflictResponse(c, err, convert.StatefulSetConvertReq) {
+				return
+			}
 			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
 			return
 		}
 
+		if dryRunResponse(c, rec, warning) {
+			return
+		}
+		if warning != "" {
+			response.SuccessWithMsg(c, warning)
+			return
+		}
+
 		response.Success(c)
 	}
 }
@@ -96,7 +124,7 @@ func (h *StatefulSetHandler) DeleteStatefulSet() gin.HandlerFunc {
 // @Produce json
 // @Param namespace query string true "命名空间"
 // @Param name query string true "StatefulSet 名称"
-// @Success 200 {object} response.Response{data=req.StatefulSet} "返回StatefulSet的详细信息"
+// @Success 200 {object} response.Response{data=req.StatefulSet} "返回StatefulSet的详细信息，autoscaler 为管理其副本数的 HPA"
 // @Failure 500 {object} response.Response "系统错误(code=30000)"
 // @Router /api/statefulset [get]
 func (h *StatefulSetHandler) GetStatefulSetDetail() gin.HandlerFunc {
@@ -111,6 +139,14 @@ func (h *StatefulSetHandler) GetStatefulSetDetail() gin.HandlerFunc {
 		}
 
 		state := convert.StatefulSetConvertReq(res)
+		hpa, err := h.svc.GetAutoscaler(context.Background(), name, ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+		if hpa != nil {
+			state.Autoscaler = convert.AutoscalerRefConvert(hpa)
+		}
 
 		response.SuccessWithData(c, state)
 	}
@@ -148,3 +184,135 @@ func (h *StatefulSetHandler) GetStatefulSetList() gin.HandlerFunc {
 		response.SuccessWithData(c, daemons)
 	}
 }
+
+// ScaleStatefulSet
+// @Summary 扩缩容 StatefulSet
+// @Description 通过 scale 子资源设置副本数，OrderedReady 策略下按序号依次创建或从最大序号开始删除 Pod
+// @Tags StatefulSet 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "StatefulSet 名称"
+// @Param replicas query int true "副本数"
+// @Success 200 {object} response.Response "操作成功；副本数由生效中的 HPA 管理时 msg 为覆盖提示"
+// @Failure 400 {object} response.Response "参数错误(code=20001)"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/statefulset/scale [post]
+func (h *StatefulSetHandler) ScaleStatefulSet() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+		ns := c.Query("namespace")
+		replicas, err := strconv.ParseInt(c.Query("replicas"), 10, 32)
+		if err != nil || replicas < 0 {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20001, "bind error replicas must be a non-negative number"))
+			return
+		}
+
+		warning := autoscalerWarning("StatefulSet", name, ns, int32(replicas), h.svc.GetAutoscaler)
+		err = h.svc.ScaleStatefulSet(context.Background(), name, ns, int32(replicas))
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		if warning != "" {
+			response.SuccessWithMsg(c, warning)
+			return
+		}
+
+		response.Success(c)
+	}
+}
+
+// GetStatefulSetPods
+// @Summary 获取 StatefulSet 各序号 Pod 状态
+// @Description 按序号列出 Pod 的阶段、就绪状态与所属版本，用于观察分区(partition)滚动更新的进度；尚未创建的序号标记为 Missing
+// @Tags StatefulSet 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "StatefulSet 名称"
+// @Success 200 {object} response.Response{data=resp.StatefulSetRollout} "返回当前/更新版本与各序号 Pod 状态"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/statefulset/pods [get]
+func (h *StatefulSetHandler) GetStatefulSetPods() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+		ns := c.Query("namespace")
+
+		state, err := h.svc.GetStatefulSetDetail(context.Background(), name, ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+		pods, err := h.svc.GetStatefulSetPods(context.Background(), state)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.SuccessWithData(c, convert.StatefulSetRolloutConvertResp(state, pods))
+	}
+}
+
+// GetStatefulSetPVCs
+// @Summary 获取 StatefulSet 的 PVC
+// @Description 列出由 volumeClaimTemplates 创建的 PVC(名称为 <模板名>-<StatefulSet名>-<序号>)，缩容后序号超出副本数且未被 Pod 使用的标记为孤立
+// @Tags StatefulSet 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "StatefulSet 名称"
+// @Success 200 {object} response.Response{data=[]resp.StatefulSetPVC} "返回 PVC 列表"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/statefulset/pvc [get]
+func (h *StatefulSetHandler) GetStatefulSetPVCs() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+		ns := c.Query("namespace")
+
+		state, err := h.svc.GetStatefulSetDetail(context.Background(), name, ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+		pvcs, err := h.svc.GetStatefulSetPVCs(context.Background(), state)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.SuccessWithData(c, pvcs)
+	}
+}
+
+// DeleteOrphanedPVCs
+// @Summary 清理 StatefulSet 孤立的 PVC
+// @Description 删除缩容后遗留、序号超出副本数且未被任何 Pod 使用的 PVC，数据将随 PV 回收策略一同释放
+// @Tags StatefulSet 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "StatefulSet 名称"
+// @Success 200 {object} response.Response{data=[]string} "返回已删除的 PVC 名称"
+// @Failure 500 {object} response.Response "系统错误(code=30000)，data 为出错前已删除的 PVC"
+// @Router /api/statefulset/pvc [delete]
+func (h *StatefulSetHandler) DeleteOrphanedPVCs() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+		ns := c.Query("namespace")
+
+		state, err := h.svc.GetStatefulSetDetail(context.Background(), name, ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+		deleted, err := h.svc.DeleteOrphanedPVCs(context.Background(), state)
+		if err != nil {
+			response.ErrorWithData(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()), deleted)
+			return
+		}
+
+		response.SuccessWithData(c, deleted)
+	}
+}
@@ -2,10 +2,12 @@ package k8s
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/crazyfrankie/gem/gerrors"
 	"github.com/gin-gonic/gin"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
@@ -26,24 +28,30 @@ func NewStorageClassHandler(svc service.StorageClassService) *StorageClassHandle
 func (h *StorageClassHandler) RegisterRoute(r *gin.Engine) {
 	scGroup := r.Group("api/storage")
 	{
-		scGroup.POST("", h.CreateStorageClass())
+		scGroup.POST("", h.CreateOrUpdateStorageClass())
 		scGroup.DELETE("", h.DeleteStorageClass())
 		scGroup.GET("", h.GetStorageClassList())
+		scGroup.GET("detail", h.GetStorageClassDetail())
+		scGroup.POST("default", h.SetDefaultStorageClass(true))
+		scGroup.DELETE("default", h.SetDefaultStorageClass(false))
 	}
 }
 
-// CreateStorageClass
-// @Summary 创建 StorageClass
-// @Description 创建一个 StorageClas 存储类
+// CreateOrUpdateStorageClass
+// @Summary 创建或更新 StorageClass
+// @Description 创建 StorageClass，供应器(provisioner)需在配置中注册，参数键、必填参数、回收策略与绑定模式按注册项校验；已存在时只能更新标签、mountOptions 与 allowVolumeExpansion，不再按注册项校验，未填写的不可变字段沿用现有值
 // @Tags StorageClass 管理
 // @Accept json
 // @Produce json
 // @Param pod body req.StorageClass true "StorageClass 信息"
-// @Success 200 {object} response.Response "创建 StorageClass 成功"
-// @Failure 400 {object} response.Response "参数错误(code=20001或20002)"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
+// @Param force query bool false "为 true 时强制接管其他管理者(如 GitOps 工具、控制器)持有的冲突字段"
+// @Success 200 {object} response.Response "操作成功"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或验证错误(code=20002)，如供应器未注册、参数不允许或修改了不可变字段"
+// @Failure 409 {object} response.Response "资源在读取后已被修改或删除(code=30002)，data 为当前对象；或字段归其他管理者所有且值不同(code=30003)，data 为冲突字段"
 // @Failure 500 {object} response.Response "系统错误(code=30000)"
 // @Router /api/storage [post]
-func (h *StorageClassHandler) CreateStorageClass() gin.HandlerFunc {
+func (h *StorageClassHandler) CreateOrUpdateStorageClass() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var createReq req.StorageClass
 		if err := c.ShouldBind(&createReq); err != nil {
@@ -51,18 +59,40 @@ func (h *StorageClassHandler) CreateStorageClass() gin.HandlerFunc {
 			return
 		}
 
-		err := validate.StorageClassValidate(&createReq)
+		// the registry only governs new classes, an existing one is checked for its mutable fields
+		live, err := h.svc.GetStorageClassDetail(context.Background(), createReq.Name)
+		switch {
+		case err == nil:
+			err = validate.StorageClassUpdateValidate(&createReq, live)
+		case apierrors.IsNotFound(err):
+			err = validate.StorageClassValidate(&createReq)
+		default:
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
 		if err != nil {
-			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, err.Error()))
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "validate storage class err: "+err.Error()))
 			return
 		}
 
-		err = h.svc.CreateStorageClass(context.Background(), &createReq)
+		ctx, rec := writeContext(c)
+		err = h.svc.CreateOrUpdateStorageClass(ctx, &createReq)
 		if err != nil {
+			if errors.Is(err, service.ErrStorageClassImmutable) {
+				response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, err.Error()))
+				return
+			}
+			if conflictResponse(c, err, convert.StorageClassConvertReq) {
+				return
+			}
 			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
 			return
 		}
 
+		if dryRunResponse(c, rec) {
+			return
+		}
+
 		response.Success(c)
 	}
 }
@@ -94,7 +124,7 @@ func (h *StorageClassHandler) DeleteStorageClass() gin.HandlerFunc {
 // GetStorageClassList
 // @Summary 获取 StorageClass 列表
 // @Description 获取所有 StorageClass 存储类信息
-// @Tags PVC 管理
+// @Tags StorageClass 管理
 // @Accept json
 // @Produce json
 // @Success 200 {object} response.Response{data=[]resp.StorageClass} "获取成功"
@@ -116,3 +146,52 @@ func (h *StorageClassHandler) GetStorageClassList() gin.HandlerFunc {
 		response.SuccessWithData(c, scs)
 	}
 }
+
+// GetStorageClassDetail
+// @Summary 获取 StorageClass 详情
+// @Description 以创建请求的结构返回 StorageClass，用于编辑
+// @Tags StorageClass 管理
+// @Accept json
+// @Produce json
+// @Param name query string true "StorageClass 名称"
+// @Success 200 {object} response.Response{data=req.StorageClass} "返回 StorageClass 详情"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/storage/detail [get]
+func (h *StorageClassHandler) GetStorageClassDetail() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+
+		res, err := h.svc.GetStorageClassDetail(context.Background(), name)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.SuccessWithData(c, convert.StorageClassConvertReq(res))
+	}
+}
+
+// SetDefaultStorageClass
+// @Summary 设置或取消默认 StorageClass
+// @Description POST 将其设为集群默认 StorageClass 并取消其他默认类，未指定 StorageClass 的 PVC 使用它；DELETE 取消默认
+// @Tags StorageClass 管理
+// @Accept json
+// @Produce json
+// @Param name query string true "StorageClass 名称"
+// @Success 200 {object} response.Response "操作成功"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/storage/default [post]
+// @Router /api/storage/default [delete]
+func (h *StorageClassHandler) SetDefaultStorageClass(isDefault bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+
+		err := h.svc.SetDefaultStorageClass(context.Background(), name, isDefault)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.Success(c)
+	}
+}
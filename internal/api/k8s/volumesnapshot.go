@@ -0,0 +1,358 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/crazyfrankie/gem/gerrors"
+	"github.com/gin-gonic/gin"
+
+	"github.com/crazyfrankie/kube-ctl/internal/model/req"
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+	"github.com/crazyfrankie/kube-ctl/internal/model/validate"
+	"github.com/crazyfrankie/kube-ctl/internal/service"
+	"github.com/crazyfrankie/kube-ctl/pkg/response"
+	"github.com/crazyfrankie/kube-ctl/pkg/utils"
+)
+
+type VolumeSnapshotHandler struct {
+	svc service.VolumeSnapshotService
+}
+
+func NewVolumeSnapshotHandler(svc service.VolumeSnapshotService) *VolumeSnapshotHandler {
+	return &VolumeSnapshotHandler{svc: svc}
+}
+
+func (h *VolumeSnapshotHandler) RegisterRoute(r *gin.Engine) {
+	snapshotGroup := r.Group("api/snapshot")
+	{
+		snapshotGroup.GET("supported", h.Supported())
+		snapshotGroup.POST("", h.CreateVolumeSnapshot())
+		snapshotGroup.DELETE("", h.DeleteVolumeSnapshot())
+		snapshotGroup.GET("list", h.GetVolumeSnapshotList())
+		snapshotGroup.POST("restore", h.RestoreVolumeSnapshot())
+		classGroup := snapshotGroup.Group("class")
+		{
+			classGroup.POST("", h.CreateVolumeSnapshotClass())
+			classGroup.DELETE("", h.DeleteVolumeSnapshotClass())
+			classGroup.GET("list", h.GetVolumeSnapshotClassList())
+		}
+	}
+}
+
+// Supported
+// @Summary 是否支持卷快照
+// @Description 通过服务发现检查集群是否安装了 snapshot.storage.k8s.io/v1 的 CRD，未安装时前端隐藏快照功能
+// @Tags VolumeSnapshot 管理
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Response{data=bool} "返回是否支持"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/snapshot/supported [get]
+func (h *VolumeSnapshotHandler) Supported() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		res, err := h.svc.Supported(context.Background())
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.SuccessWithData(c, res)
+	}
+}
+
+// CreateVolumeSnapshot
+// @Summary 创建卷快照
+// @Description 为已绑定的 CSI PVC 创建 VolumeSnapshot，未指定快照类时使用该驱动的默认 VolumeSnapshotClass
+// @Tags VolumeSnapshot 管理
+// @Accept json
+// @Produce json
+// @Param snapshot body req.VolumeSnapshot true "快照信息"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
+// @Success 200 {object} response.Response "创建成功"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或验证错误(code=20002)，如未安装快照 CRD、PVC 未绑定或没有可用的快照类"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/snapshot [post]
+func (h *VolumeSnapshotHandler) CreateVolumeSnapshot() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var createReq req.VolumeSnapshot
+		if err := c.ShouldBind(&createReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20001, "bind error "+err.Error()))
+			return
+		}
+
+		if err := validate.VolumeSnapshotValidate(&createReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "validate volume snapshot err: "+err.Error()))
+			return
+		}
+
+		ctx, rec := writeContext(c)
+		err := h.svc.CreateVolumeSnapshot(ctx, &createReq)
+		if err != nil {
+			if errors.Is(err, service.ErrSnapshotUnsupported) || errors.Is(err, service.ErrVolumeSnapshot) {
+				response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, err.Error()))
+				return
+			}
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		if dryRunResponse(c, rec) {
+			return
+		}
+
+		response.Success(c)
+	}
+}
+
+// DeleteVolumeSnapshot
+// @Summary 删除卷快照
+// @Description 删除 VolumeSnapshot，存储系统中的快照是否一并删除取决于快照类的 deletionPolicy
+// @Tags VolumeSnapshot 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string true "命名空间"
+// @Param name query string true "快照名称"
+// @Success 200 {object} response.Response "删除成功"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/snapshot [delete]
+func (h *VolumeSnapshotHandler) DeleteVolumeSnapshot() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+		ns := c.Query("namespace")
+
+		err := h.svc.DeleteVolumeSnapshot(context.Background(), name, ns)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.Success(c)
+	}
+}
+
+// GetVolumeSnapshotList
+// @Summary 获取卷快照列表
+// @Description 获取命名空间下的 VolumeSnapshot，包含源 PVC、是否可用(readyToUse)与恢复所需的最小容量(restoreSize)；未安装快照 CRD 时返回空
+// @Tags VolumeSnapshot 管理
+// @Accept json
+// @Produce json
+// @Param namespace query string false "命名空间，为空时查询所有命名空间"
+// @Param keyword query string false "关键词"
+// @Success 200 {object} response.Response{data=[]resp.VolumeSnapshot} "返回快照列表"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/snapshot/list [get]
+func (h *VolumeSnapshotHandler) GetVolumeSnapshotList() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ns := c.Query("namespace")
+		keyword := c.Query("keyword")
+
+		res, err := h.svc.GetVolumeSnapshotList(context.Background(), ns)
+		if err != nil {
+			if errors.Is(err, service.ErrSnapshotUnsupported) {
+				response.Success(c)
+				return
+			}
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		snapshots := make([]resp.VolumeSnapshot, 0, len(res))
+		for i := range res {
+			if strings.Contains(res[i].Metadata.Name, keyword) {
+				snapshots = append(snapshots, volumeSnapshotResp(&res[i]))
+			}
+		}
+
+		response.SuccessWithData(c, snapshots)
+	}
+}
+
+// RestoreVolumeSnapshot
+// @Summary 从快照恢复 PVC
+// @Description 以 VolumeSnapshot 为数据源(dataSource)新建 PVC，快照需已可用；未指定的 StorageClass 与访问模式沿用源 PVC，容量默认取快照的 restoreSize
+// @Tags VolumeSnapshot 管理
+// @Accept json
+// @Produce json
+// @Param restore body req.VolumeSnapshotRestore true "恢复信息"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
+// @Success 200 {object} response.Response "恢复成功"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或验证错误(code=20002)，如快照未就绪、容量小于 restoreSize 或 StorageClass 的供应器与快照驱动不一致"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/snapshot/restore [post]
+func (h *VolumeSnapshotHandler) RestoreVolumeSnapshot() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var restoreReq req.VolumeSnapshotRestore
+		if err := c.ShouldBind(&restoreReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20001, "bind error "+err.Error()))
+			return
+		}
+
+		if err := validate.VolumeSnapshotRestoreValidate(&restoreReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "validate restore err: "+err.Error()))
+			return
+		}
+
+		ctx, rec := writeContext(c)
+		err := h.svc.RestoreVolumeSnapshot(ctx, &restoreReq)
+		if err != nil {
+			if errors.Is(err, service.ErrSnapshotUnsupported) || errors.Is(err, service.ErrSnapshotRestore) {
+				response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, err.Error()))
+				return
+			}
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		if dryRunResponse(c, rec) {
+			return
+		}
+
+		response.Success(c)
+	}
+}
+
+// CreateVolumeSnapshotClass
+// @Summary 创建快照类
+// @Description 创建 VolumeSnapshotClass，可设为对应 CSI 驱动的默认快照类
+// @Tags VolumeSnapshot 管理
+// @Accept json
+// @Produce json
+// @Param class body req.VolumeSnapshotClass true "快照类信息"
+// @Param dryRun query bool false "为 true 时仅在服务端预演不落库，返回与现有对象的差异"
+// @Success 200 {object} response.Response "创建成功"
+// @Failure 400 {object} response.Response "参数错误(code=20001)或验证错误(code=20002)"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/snapshot/class [post]
+func (h *VolumeSnapshotHandler) CreateVolumeSnapshotClass() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var createReq req.VolumeSnapshotClass
+		if err := c.ShouldBind(&createReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20001, "bind error "+err.Error()))
+			return
+		}
+
+		if err := validate.VolumeSnapshotClassValidate(&createReq); err != nil {
+			response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, "validate volume snapshot class err: "+err.Error()))
+			return
+		}
+
+		ctx, rec := writeContext(c)
+		err := h.svc.CreateVolumeSnapshotClass(ctx, &createReq)
+		if err != nil {
+			if errors.Is(err, service.ErrSnapshotUnsupported) {
+				response.Error(c, http.StatusBadRequest, gerrors.NewBizError(20002, err.Error()))
+				return
+			}
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		if dryRunResponse(c, rec) {
+			return
+		}
+
+		response.Success(c)
+	}
+}
+
+// DeleteVolumeSnapshotClass
+// @Summary 删除快照类
+// @Description 删除 VolumeSnapshotClass
+// @Tags VolumeSnapshot 管理
+// @Accept json
+// @Produce json
+// @Param name query string true "快照类名称"
+// @Success 200 {object} response.Response "删除成功"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/snapshot/class [delete]
+func (h *VolumeSnapshotHandler) DeleteVolumeSnapshotClass() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := c.Query("name")
+
+		err := h.svc.DeleteVolumeSnapshotClass(context.Background(), name)
+		if err != nil {
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		response.Success(c)
+	}
+}
+
+// GetVolumeSnapshotClassList
+// @Summary 获取快照类列表
+// @Description 获取所有 VolumeSnapshotClass；未安装快照 CRD 时返回空
+// @Tags VolumeSnapshot 管理
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Response{data=[]resp.VolumeSnapshotClass} "返回快照类列表"
+// @Failure 500 {object} response.Response "系统错误(code=30000)"
+// @Router /api/snapshot/class/list [get]
+func (h *VolumeSnapshotHandler) GetVolumeSnapshotClassList() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		res, err := h.svc.GetVolumeSnapshotClassList(context.Background())
+		if err != nil {
+			if errors.Is(err, service.ErrSnapshotUnsupported) {
+				response.Success(c)
+				return
+			}
+			response.Error(c, http.StatusInternalServerError, gerrors.NewBizError(30000, err.Error()))
+			return
+		}
+
+		classes := make([]resp.VolumeSnapshotClass, 0, len(res))
+		for _, i := range res {
+			classes = append(classes, resp.VolumeSnapshotClass{
+				Name:           i.Metadata.Name,
+				Labels:         utils.ResMapToItem(i.Metadata.Labels),
+				Driver:         i.Driver,
+				DeletionPolicy: i.DeletionPolicy,
+				Parameters:     utils.ResMapToItem(i.Parameters),
+				IsDefault:      i.Metadata.Annotations[service.DefaultSnapshotClassAnnotation] == "true",
+				Age:            i.Metadata.CreationTimestamp.Unix(),
+			})
+		}
+
+		response.SuccessWithData(c, classes)
+	}
+}
+
+func volumeSnapshotResp(vs *service.VolumeSnapshot) resp.VolumeSnapshot {
+	res := resp.VolumeSnapshot{
+		Name:      vs.Metadata.Name,
+		Namespace: vs.Metadata.Namespace,
+		Labels:    utils.ResMapToItem(vs.Metadata.Labels),
+		Age:       vs.Metadata.CreationTimestamp.Unix(),
+	}
+	if vs.Spec.Source.PersistentVolumeClaimName != nil {
+		res.PVC = *vs.Spec.Source.PersistentVolumeClaimName
+	}
+	if vs.Spec.VolumeSnapshotClassName != nil {
+		res.SnapshotClass = *vs.Spec.VolumeSnapshotClassName
+	}
+
+	status := vs.Status
+	if status == nil {
+		return res
+	}
+	if status.BoundVolumeSnapshotContentName != nil {
+		res.SnapshotContent = *status.BoundVolumeSnapshotContentName
+	}
+	if status.ReadyToUse != nil {
+		res.ReadyToUse = *status.ReadyToUse
+	}
+	if status.RestoreSize != nil {
+		res.RestoreSize = status.RestoreSize.String()
+	}
+	if status.CreationTime != nil {
+		res.CreationTime = status.CreationTime.Unix()
+	}
+	if status.Error != nil && status.Error.Message != nil {
+		res.Error = *status.Error.Message
+	}
+
+	return res
+}
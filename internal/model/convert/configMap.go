@@ -1,6 +1,8 @@
 package convert
 
 import (
+	"encoding/base64"
+	"sort"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -11,7 +13,19 @@ import (
 	"github.com/crazyfrankie/kube-ctl/pkg/utils"
 )
 
+// CMReqConvert expects binaryData already checked by validate.ConfigMapValidate.
 func CMReqConvert(req *req.ConfigMap) *corev1.ConfigMap {
+	var binaryData map[string][]byte
+	if len(req.BinaryData) > 0 {
+		binaryData = make(map[string][]byte, len(req.BinaryData))
+		for _, i := range req.BinaryData {
+			binaryData[i.Key], _ = base64.StdEncoding.DecodeString(i.Value)
+		}
+	}
+	var immutable *bool
+	if req.Immutable {
+		immutable = &req.Immutable
+	}
 
 	return &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
@@ -20,7 +34,9 @@ func CMReqConvert(req *req.ConfigMap) *corev1.ConfigMap {
 			CreationTimestamp: metav1.Time{Time: time.Now()},
 			Labels:            utils.ReqItemToMap(req.Labels),
 		},
-		Data: utils.ReqItemToMap(req.Data),
+		Data:       utils.ReqItemToMap(req.Data),
+		BinaryData: binaryData,
+		Immutable:  immutable,
 	}
 }
 
@@ -28,18 +44,43 @@ func CMConvertListResp(cm *corev1.ConfigMap) resp.ConfigMap {
 	return resp.ConfigMap{
 		Name:      cm.Name,
 		Namespace: cm.Namespace,
-		DataNum:   len(cm.Data),
+		DataNum:   len(cm.Data) + len(cm.BinaryData),
 		Age:       cm.CreationTimestamp.Time.Unix(),
+		Immutable: cm.Immutable != nil && *cm.Immutable,
 	}
 }
 
 func CMConvertDetailResp(cm *corev1.ConfigMap) resp.ConfigMapDetail {
+	binaryData := make([]resp.BinaryItem, 0, len(cm.BinaryData))
+	for k, v := range cm.BinaryData {
+		binaryData = append(binaryData, resp.BinaryItem{Key: k, Size: len(v)})
+	}
+	sort.Slice(binaryData, func(i, j int) bool {
+		return binaryData[i].Key < binaryData[j].Key
+	})
+
 	return resp.ConfigMapDetail{
-		Name:      cm.Name,
-		Namespace: cm.Namespace,
-		DataNum:   len(cm.Data),
-		Age:       cm.CreationTimestamp.Time.Unix(),
-		Data:      utils.ResMapToItem(cm.Data),
-		Labels:    utils.ResMapToItem(cm.Labels),
+		Name:            cm.Name,
+		Namespace:       cm.Namespace,
+		ResourceVersion: cm.ResourceVersion,
+		DataNum:         len(cm.Data) + len(cm.BinaryData),
+		Age:             cm.CreationTimestamp.Time.Unix(),
+		Data:            utils.ResMapToItem(cm.Data),
+		BinaryData:      binaryData,
+		Immutable:       cm.Immutable != nil && *cm.Immutable,
+		Labels:          utils.ResMapToItem(cm.Labels),
+	}
+}
+
+// ConfigMapSize is the size counted against the 1MiB limit.
+func ConfigMapSize(cm *corev1.ConfigMap) int {
+	size := 0
+	for k, v := range cm.Data {
+		size += len(k) + len(v)
+	}
+	for k, v := range cm.BinaryData {
+		size += len(k) + len(v)
 	}
+
+	return size
 }
@@ -1,6 +1,8 @@
 package convert
 
 import (
+	"time"
+
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -10,10 +12,14 @@ import (
 	"github.com/crazyfrankie/kube-ctl/pkg/utils"
 )
 
+// CronJobManualAnnotation marks the Jobs started by hand instead of by the schedule,
+// the same annotation kubectl create job --from sets.
+const CronJobManualAnnotation = "cronjob.kubernetes.io/instantiate"
+
 func CronJobReqConvert(req *req.CronJob) *batchv1.CronJob {
 	pod := PodReqConvert(&req.Template)
 
-	return &batchv1.CronJob{
+	cron := &batchv1.CronJob{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      req.Name,
 			Namespace: req.Namespace,
@@ -37,12 +43,18 @@ func CronJobReqConvert(req *req.CronJob) *batchv1.CronJob {
 			},
 		},
 	}
+	if req.TimeZone != "" {
+		cron.Spec.TimeZone = &req.TimeZone
+	}
+
+	return cron
 }
 
 func CronJobConvertReq(cron *batchv1.CronJob) req.CronJob {
-	return req.CronJob{
+	res := req.CronJob{
 		Name:                       cron.Name,
 		Namespace:                  cron.Namespace,
+		ResourceVersion:            cron.ResourceVersion,
 		Labels:                     utils.ReqMapToItem(cron.Labels),
 		Schedule:                   cron.Spec.Schedule,
 		Suspend:                    *cron.Spec.Suspend,
@@ -58,6 +70,11 @@ func CronJobConvertReq(cron *batchv1.CronJob) req.CronJob {
 			Spec:       cron.Spec.JobTemplate.Spec.Template.Spec,
 		}),
 	}
+	if cron.Spec.TimeZone != nil {
+		res.TimeZone = *cron.Spec.TimeZone
+	}
+
+	return res
 }
 
 func CronJobConvertResp(cron *batchv1.CronJob) resp.CronJob {
@@ -69,9 +86,68 @@ func CronJobConvertResp(cron *batchv1.CronJob) resp.CronJob {
 		Active:    len(cron.Status.Active),
 		Age:       cron.CreationTimestamp.Unix(),
 	}
+	if cron.Spec.TimeZone != nil {
+		res.TimeZone = *cron.Spec.TimeZone
+	}
+	if cron.Status.LastScheduleTime != nil {
+		res.LastScheduleTime = cron.Status.LastScheduleTime.Unix()
+	}
+	if !res.Suspend {
+		if next := CronJobNextRuns(cron, time.Now(), 1); len(next) > 0 {
+			res.NextScheduleTime = next[0].Unix()
+		}
+	}
+
+	return res
+}
+
+// CronJobNextRuns previews the next count schedule times of the CronJob, none when the schedule can not be parsed.
+func CronJobNextRuns(cron *batchv1.CronJob, from time.Time, count int) []time.Time {
+	var timeZone string
+	if cron.Spec.TimeZone != nil {
+		timeZone = *cron.Spec.TimeZone
+	}
+	sched, loc, err := utils.ParseSchedule(cron.Spec.Schedule, timeZone)
+	if err != nil {
+		return nil
+	}
+
+	return utils.NextRuns(sched, loc, from, count)
+}
+
+func CronJobHistoryConvertResp(cron *batchv1.CronJob, jobs []batchv1.Job) resp.CronJobHistory {
+	res := resp.CronJobHistory{
+		Active: len(cron.Status.Active),
+		Jobs:   make([]resp.CronJobRun, 0, len(jobs)),
+	}
 	if cron.Status.LastScheduleTime != nil {
 		res.LastScheduleTime = cron.Status.LastScheduleTime.Unix()
 	}
+	if cron.Status.LastSuccessfulTime != nil {
+		res.LastSuccessfulTime = cron.Status.LastSuccessfulTime.Unix()
+	}
+	for i := range jobs {
+		run := resp.CronJobRun{
+			Name:      jobs[i].Name,
+			Status:    JobStatus(&jobs[i]),
+			Manual:    jobs[i].Annotations[CronJobManualAnnotation] == "manual",
+			Succeeded: jobs[i].Status.Succeeded,
+			Failed:    jobs[i].Status.Failed,
+		}
+		if jobs[i].Status.StartTime != nil {
+			run.StartTime = jobs[i].Status.StartTime.Unix()
+		}
+		if jobs[i].Status.CompletionTime != nil {
+			run.CompletionTime = jobs[i].Status.CompletionTime.Unix()
+		}
+		switch run.Status {
+		case JobStatusComplete:
+			res.Succeeded++
+		case JobStatusFailed:
+			res.Failed++
+		}
+		res.Jobs = append(res.Jobs, run)
+	}
 
 	return res
 }
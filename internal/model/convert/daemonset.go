@@ -4,6 +4,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
 	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
@@ -13,7 +14,7 @@ import (
 func DaemonSetReqConvert(req *req.DaemonSet) *appsv1.DaemonSet {
 	pod := PodReqConvert(&req.Template)
 
-	return &appsv1.DaemonSet{
+	daemon := &appsv1.DaemonSet{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      req.Name,
 			Namespace: req.Namespace,
@@ -27,23 +28,62 @@ func DaemonSetReqConvert(req *req.DaemonSet) *appsv1.DaemonSet {
 				ObjectMeta: pod.ObjectMeta,
 				Spec:       pod.Spec,
 			},
+			MinReadySeconds: req.MinReadySeconds,
+			UpdateStrategy: appsv1.DaemonSetUpdateStrategy{
+				Type: req.UpdateStrategy.Type,
+			},
 		},
 	}
+	if req.UpdateStrategy.Type != appsv1.OnDeleteDaemonSetStrategyType &&
+		(req.UpdateStrategy.MaxUnavailable != "" || req.UpdateStrategy.MaxSurge != "") {
+		rolling := &appsv1.RollingUpdateDaemonSet{}
+		if req.UpdateStrategy.MaxUnavailable != "" {
+			maxUnavailable := intstr.Parse(req.UpdateStrategy.MaxUnavailable)
+			rolling.MaxUnavailable = &maxUnavailable
+		}
+		if req.UpdateStrategy.MaxSurge != "" {
+			maxSurge := intstr.Parse(req.UpdateStrategy.MaxSurge)
+			rolling.MaxSurge = &maxSurge
+			// the api server defaults maxUnavailable to 1, surging needs it to be 0
+			if rolling.MaxUnavailable == nil {
+				zero := intstr.FromInt32(0)
+				rolling.MaxUnavailable = &zero
+			}
+		}
+		daemon.Spec.UpdateStrategy.RollingUpdate = rolling
+	}
+
+	return daemon
 }
 
 func DaemonSetConvertReq(daemon *appsv1.DaemonSet) req.DaemonSet {
-	return req.DaemonSet{
-		Name:      daemon.Name,
-		Namespace: daemon.Namespace,
-		Labels:    utils.ReqMapToItem(daemon.Labels),
-		Selector:  utils.ReqMapToItem(daemon.Spec.Selector.MatchLabels),
+	res := req.DaemonSet{
+		Name:            daemon.Name,
+		Namespace:       daemon.Namespace,
+		ResourceVersion: daemon.ResourceVersion,
+		Labels:          utils.ReqMapToItem(daemon.Labels),
+		Selector:        utils.ReqMapToItem(daemon.Spec.Selector.MatchLabels),
 		Template: *PodConvertReq(&corev1.Pod{
 			ObjectMeta: metav1.ObjectMeta{
 				Labels: daemon.Spec.Template.Labels,
 			},
 			Spec: daemon.Spec.Template.Spec,
 		}),
+		MinReadySeconds: daemon.Spec.MinReadySeconds,
+		UpdateStrategy: req.DaemonSetUpdateStrategy{
+			Type: daemon.Spec.UpdateStrategy.Type,
+		},
 	}
+	if rolling := daemon.Spec.UpdateStrategy.RollingUpdate; rolling != nil {
+		if rolling.MaxUnavailable != nil {
+			res.UpdateStrategy.MaxUnavailable = rolling.MaxUnavailable.String()
+		}
+		if rolling.MaxSurge != nil {
+			res.UpdateStrategy.MaxSurge = rolling.MaxSurge.String()
+		}
+	}
+
+	return res
 }
 
 func DaemonSetConvertResp(daemon *appsv1.DaemonSet) resp.DaemonSet {
@@ -38,11 +38,12 @@ func DeploymentConvertReq(deploy *appsv1.Deployment) req.Deployment {
 		replicas = *deploy.Spec.Replicas
 	}
 	return req.Deployment{
-		Name:      deploy.Name,
-		Namespace: deploy.Namespace,
-		Labels:    utils.ReqMapToItem(deploy.Labels),
-		Replicas:  replicas,
-		Selector:  utils.ReqMapToItem(deploy.Spec.Selector.MatchLabels),
+		Name:            deploy.Name,
+		Namespace:       deploy.Namespace,
+		ResourceVersion: deploy.ResourceVersion,
+		Labels:          utils.ReqMapToItem(deploy.Labels),
+		Replicas:        replicas,
+		Selector:        utils.ReqMapToItem(deploy.Spec.Selector.MatchLabels),
 		Template: *PodConvertReq(&corev1.Pod{
 			ObjectMeta: metav1.ObjectMeta{
 				Labels: deploy.Spec.Template.Labels,
@@ -0,0 +1,291 @@
+package convert
+
+import (
+	"fmt"
+	"strings"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crazyfrankie/kube-ctl/internal/model/req"
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+	"github.com/crazyfrankie/kube-ctl/pkg/utils"
+)
+
+const unknownMetric = "<unknown>"
+
+// HPAReqConvert expects the metric quantities already checked by validate.HPAValidate.
+func HPAReqConvert(req *req.HPA) *autoscalingv2.HorizontalPodAutoscaler {
+	minReplicas := req.MinReplicas
+	metrics := make([]autoscalingv2.MetricSpec, 0, len(req.Metrics))
+	for _, m := range req.Metrics {
+		metrics = append(metrics, hpaMetricReqConvert(&m))
+	}
+
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      req.Name,
+			Namespace: req.Namespace,
+			Labels:    utils.ReqItemToMap(req.Labels),
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				Kind:       req.TargetKind,
+				Name:       req.TargetName,
+				APIVersion: "apps/v1",
+			},
+			MinReplicas: &minReplicas,
+			MaxReplicas: req.MaxReplicas,
+			Metrics:     metrics,
+			Behavior:    req.Behavior,
+		},
+	}
+}
+
+func hpaMetricReqConvert(m *req.HPAMetric) autoscalingv2.MetricSpec {
+	target := autoscalingv2.MetricTarget{Type: m.TargetType}
+	switch m.TargetType {
+	case autoscalingv2.UtilizationMetricType:
+		utilization := m.Utilization
+		target.AverageUtilization = &utilization
+	case autoscalingv2.AverageValueMetricType:
+		value := resource.MustParse(m.Value)
+		target.AverageValue = &value
+	case autoscalingv2.ValueMetricType:
+		value := resource.MustParse(m.Value)
+		target.Value = &value
+	}
+
+	var selector *metav1.LabelSelector
+	if len(m.Selector) > 0 {
+		selector = &metav1.LabelSelector{MatchLabels: utils.ReqItemToMap(m.Selector)}
+	}
+	identifier := autoscalingv2.MetricIdentifier{Name: m.MetricName, Selector: selector}
+
+	spec := autoscalingv2.MetricSpec{Type: m.Type}
+	switch m.Type {
+	case autoscalingv2.ResourceMetricSourceType:
+		spec.Resource = &autoscalingv2.ResourceMetricSource{Name: corev1.ResourceName(m.ResourceName), Target: target}
+	case autoscalingv2.ContainerResourceMetricSourceType:
+		spec.ContainerResource = &autoscalingv2.ContainerResourceMetricSource{
+			Name:      corev1.ResourceName(m.ResourceName),
+			Container: m.Container,
+			Target:    target,
+		}
+	case autoscalingv2.PodsMetricSourceType:
+		spec.Pods = &autoscalingv2.PodsMetricSource{Metric: identifier, Target: target}
+	case autoscalingv2.ObjectMetricSourceType:
+		spec.Object = &autoscalingv2.ObjectMetricSource{DescribedObject: m.DescribedObject, Metric: identifier, Target: target}
+	case autoscalingv2.ExternalMetricSourceType:
+		spec.External = &autoscalingv2.ExternalMetricSource{Metric: identifier, Target: target}
+	}
+
+	return spec
+}
+
+func HPAConvertReq(hpa *autoscalingv2.HorizontalPodAutoscaler) req.HPA {
+	var minReplicas int32 = 1
+	if hpa.Spec.MinReplicas != nil {
+		minReplicas = *hpa.Spec.MinReplicas
+	}
+	metrics := make([]req.HPAMetric, 0, len(hpa.Spec.Metrics))
+	for _, m := range hpa.Spec.Metrics {
+		metrics = append(metrics, hpaMetricConvertReq(&m))
+	}
+
+	return req.HPA{
+		Name:            hpa.Name,
+		Namespace:       hpa.Namespace,
+		ResourceVersion: hpa.ResourceVersion,
+		Labels:          utils.ReqMapToItem(hpa.Labels),
+		TargetKind:      hpa.Spec.ScaleTargetRef.Kind,
+		TargetName:      hpa.Spec.ScaleTargetRef.Name,
+		MinReplicas:     minReplicas,
+		MaxReplicas:     hpa.Spec.MaxReplicas,
+		Metrics:         metrics,
+		Behavior:        hpa.Spec.Behavior,
+	}
+}
+
+func hpaMetricConvertReq(spec *autoscalingv2.MetricSpec) req.HPAMetric {
+	m := req.HPAMetric{Type: spec.Type}
+	var identifier autoscalingv2.MetricIdentifier
+	var target autoscalingv2.MetricTarget
+	switch {
+	case spec.Resource != nil:
+		m.ResourceName = string(spec.Resource.Name)
+		target = spec.Resource.Target
+	case spec.ContainerResource != nil:
+		m.ResourceName = string(spec.ContainerResource.Name)
+		m.Container = spec.ContainerResource.Container
+		target = spec.ContainerResource.Target
+	case spec.Pods != nil:
+		identifier, target = spec.Pods.Metric, spec.Pods.Target
+	case spec.Object != nil:
+		identifier, target = spec.Object.Metric, spec.Object.Target
+		m.DescribedObject = spec.Object.DescribedObject
+	case spec.External != nil:
+		identifier, target = spec.External.Metric, spec.External.Target
+	}
+
+	m.MetricName = identifier.Name
+	if identifier.Selector != nil {
+		m.Selector = utils.ReqMapToItem(identifier.Selector.MatchLabels)
+	}
+	m.TargetType = target.Type
+	switch {
+	case target.AverageUtilization != nil:
+		m.Utilization = *target.AverageUtilization
+	case target.AverageValue != nil:
+		m.Value = target.AverageValue.String()
+	case target.Value != nil:
+		m.Value = target.Value.String()
+	}
+
+	return m
+}
+
+func HPAConvertResp(hpa *autoscalingv2.HorizontalPodAutoscaler) resp.HPA {
+	var minReplicas int32 = 1
+	if hpa.Spec.MinReplicas != nil {
+		minReplicas = *hpa.Spec.MinReplicas
+	}
+	metrics := hpaMetricStatus(hpa)
+	targets := make([]string, 0, len(metrics))
+	for _, m := range metrics {
+		targets = append(targets, fmt.Sprintf("%s: %s/%s", m.Name, m.Current, m.Target))
+	}
+
+	return resp.HPA{
+		Name:            hpa.Name,
+		Namespace:       hpa.Namespace,
+		Target:          hpa.Spec.ScaleTargetRef.Kind + "/" + hpa.Spec.ScaleTargetRef.Name,
+		MinReplicas:     minReplicas,
+		MaxReplicas:     hpa.Spec.MaxReplicas,
+		CurrentReplicas: hpa.Status.CurrentReplicas,
+		DesiredReplicas: hpa.Status.DesiredReplicas,
+		Targets:         strings.Join(targets, ", "),
+		Age:             hpa.CreationTimestamp.Unix(),
+	}
+}
+
+func HPAStatusConvertResp(hpa *autoscalingv2.HorizontalPodAutoscaler) resp.HPAStatus {
+	list := HPAConvertResp(hpa)
+	var lastScaleTime int64
+	if hpa.Status.LastScaleTime != nil {
+		lastScaleTime = hpa.Status.LastScaleTime.Unix()
+	}
+	conditions := make([]resp.HPAConditionStatus, 0, len(hpa.Status.Conditions))
+	for _, c := range hpa.Status.Conditions {
+		conditions = append(conditions, resp.HPAConditionStatus{
+			Type:               string(c.Type),
+			Status:             string(c.Status),
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime.Unix(),
+		})
+	}
+
+	return resp.HPAStatus{
+		Name:            list.Name,
+		Namespace:       list.Namespace,
+		Target:          list.Target,
+		MinReplicas:     list.MinReplicas,
+		MaxReplicas:     list.MaxReplicas,
+		CurrentReplicas: list.CurrentReplicas,
+		DesiredReplicas: list.DesiredReplicas,
+		LastScaleTime:   lastScaleTime,
+		Metrics:         hpaMetricStatus(hpa),
+		Conditions:      conditions,
+	}
+}
+
+// hpaMetricStatus pairs every metric of the spec with its current value,
+// the status lists the metrics in the same order once they are collected.
+func hpaMetricStatus(hpa *autoscalingv2.HorizontalPodAutoscaler) []resp.HPAMetricStatus {
+	res := make([]resp.HPAMetricStatus, 0, len(hpa.Spec.Metrics))
+	for i, spec := range hpa.Spec.Metrics {
+		m := hpaMetricConvertReq(&spec)
+		name := m.MetricName
+		if m.ResourceName != "" {
+			name = m.ResourceName
+		}
+		if m.Container != "" {
+			name = m.Container + "/" + m.ResourceName
+		}
+		status := resp.HPAMetricStatus{
+			Type:    string(spec.Type),
+			Name:    name,
+			Current: unknownMetric,
+			Target:  metricValue(m.TargetType, &m.Utilization, m.Value),
+		}
+		if i < len(hpa.Status.CurrentMetrics) && hpa.Status.CurrentMetrics[i].Type == spec.Type {
+			if current := currentMetric(&hpa.Status.CurrentMetrics[i], m.TargetType); current != "" {
+				status.Current = current
+			}
+		}
+		res = append(res, status)
+	}
+
+	return res
+}
+
+func currentMetric(status *autoscalingv2.MetricStatus, targetType autoscalingv2.MetricTargetType) string {
+	var current autoscalingv2.MetricValueStatus
+	switch {
+	case status.Resource != nil:
+		current = status.Resource.Current
+	case status.ContainerResource != nil:
+		current = status.ContainerResource.Current
+	case status.Pods != nil:
+		current = status.Pods.Current
+	case status.Object != nil:
+		current = status.Object.Current
+	case status.External != nil:
+		current = status.External.Current
+	}
+
+	switch {
+	case targetType == autoscalingv2.UtilizationMetricType && current.AverageUtilization != nil:
+		return metricValue(targetType, current.AverageUtilization, "")
+	case current.AverageValue != nil:
+		return current.AverageValue.String()
+	case current.Value != nil:
+		return current.Value.String()
+	}
+
+	return ""
+}
+
+func metricValue(targetType autoscalingv2.MetricTargetType, utilization *int32, value string) string {
+	if targetType == autoscalingv2.UtilizationMetricType {
+		return fmt.Sprintf("%d%%", *utilization)
+	}
+
+	return value
+}
+
+// AutoscalerRefConvert summarizes the HPA on the detail of the workload it scales.
+func AutoscalerRefConvert(hpa *autoscalingv2.HorizontalPodAutoscaler) *req.AutoscalerRef {
+	var minReplicas int32 = 1
+	if hpa.Spec.MinReplicas != nil {
+		minReplicas = *hpa.Spec.MinReplicas
+	}
+	active := true
+	for _, c := range hpa.Status.Conditions {
+		if c.Type == autoscalingv2.ScalingActive && c.Status == corev1.ConditionFalse {
+			active = false
+		}
+	}
+
+	return &req.AutoscalerRef{
+		Name:            hpa.Name,
+		MinReplicas:     minReplicas,
+		MaxReplicas:     hpa.Spec.MaxReplicas,
+		CurrentReplicas: hpa.Status.CurrentReplicas,
+		DesiredReplicas: hpa.Status.DesiredReplicas,
+		Active:          active,
+	}
+}
@@ -2,7 +2,7 @@ package convert
 
 import (
 	"strings"
-	
+
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -19,14 +19,29 @@ func IngressReqConvert(req *req.Ingress) *networkingv1.Ingress {
 			IngressRuleValue: r.Value,
 		})
 	}
+	tls := make([]networkingv1.IngressTLS, 0, len(req.TLS))
+	for _, t := range req.TLS {
+		tls = append(tls, networkingv1.IngressTLS{
+			Hosts:      t.Hosts,
+			SecretName: t.SecretName,
+		})
+	}
+	var class *string
+	if req.IngressClassName != "" {
+		class = &req.IngressClassName
+	}
 	return &networkingv1.Ingress{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      req.Name,
-			Namespace: req.Namespace,
-			Labels:    utils.ReqItemToMap(req.Labels),
+			Name:        req.Name,
+			Namespace:   req.Namespace,
+			Labels:      utils.ReqItemToMap(req.Labels),
+			Annotations: utils.ReqItemToMap(req.Annotations),
 		},
 		Spec: networkingv1.IngressSpec{
-			Rules: rules,
+			IngressClassName: class,
+			DefaultBackend:   req.DefaultBackend,
+			TLS:              tls,
+			Rules:            rules,
 		},
 	}
 }
@@ -39,14 +54,49 @@ func IngressConvertReq(ingress *networkingv1.Ingress) req.Ingress {
 			Value: r.IngressRuleValue,
 		})
 	}
+	tls := make([]req.IngressTLS, 0, len(ingress.Spec.TLS))
+	for _, t := range ingress.Spec.TLS {
+		tls = append(tls, req.IngressTLS{
+			Hosts:      t.Hosts,
+			SecretName: t.SecretName,
+		})
+	}
+	var class string
+	if ingress.Spec.IngressClassName != nil {
+		class = *ingress.Spec.IngressClassName
+	}
 	return req.Ingress{
-		Name:      ingress.Name,
-		Namespace: ingress.Namespace,
-		Labels:    utils.ReqMapToItem(ingress.Labels),
-		Rules:     rules,
+		Name:             ingress.Name,
+		Namespace:        ingress.Namespace,
+		ResourceVersion:  ingress.ResourceVersion,
+		Labels:           utils.ReqMapToItem(ingress.Labels),
+		Annotations:      utils.ReqMapToItem(ingress.Annotations),
+		IngressClassName: class,
+		DefaultBackend:   ingress.Spec.DefaultBackend,
+		TLS:              tls,
+		Rules:            rules,
 	}
 }
 
+// IngressBackends collects every backend referenced by the ingress,
+// the default backend first and then each rule path in order.
+func IngressBackends(ingress *networkingv1.Ingress) []networkingv1.IngressBackend {
+	res := make([]networkingv1.IngressBackend, 0)
+	if ingress.Spec.DefaultBackend != nil {
+		res = append(res, *ingress.Spec.DefaultBackend)
+	}
+	for _, r := range ingress.Spec.Rules {
+		if r.HTTP == nil {
+			continue
+		}
+		for _, p := range r.HTTP.Paths {
+			res = append(res, p.Backend)
+		}
+	}
+
+	return res
+}
+
 func IngressConvertResp(ingress *networkingv1.Ingress) resp.Ingress {
 	var class string
 	if ingress.Spec.IngressClassName != nil {
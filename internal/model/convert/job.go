@@ -10,44 +10,116 @@ import (
 	"github.com/crazyfrankie/kube-ctl/pkg/utils"
 )
 
+const (
+	JobStatusRunning   = "Running"
+	JobStatusComplete  = "Complete"
+	JobStatusFailed    = "Failed"
+	JobStatusSuspended = "Suspended"
+)
+
+// jobControllerLabels are set by the job controller on the selector and the pod template,
+// they carry the uid of one Job and must not be copied to another.
+var jobControllerLabels = []string{
+	"controller-uid",
+	"job-name",
+	batchv1.ControllerUidLabel,
+	batchv1.JobNameLabel,
+}
+
 func JobReqConvert(req *req.Job) *batchv1.Job {
 	pod := PodReqConvert(&req.Template)
-	return &batchv1.Job{
+	job := &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      req.Name,
 			Namespace: req.Namespace,
 			Labels:    utils.ReqItemToMap(req.Labels),
 		},
 		Spec: batchv1.JobSpec{
-			Completions: &req.Completions,
+			Completions:             &req.Completions,
+			BackoffLimit:            req.BackoffLimit,
+			TTLSecondsAfterFinished: req.TTLSecondsAfterFinished,
+			PodFailurePolicy:        req.PodFailurePolicy,
+			Suspend:                 &req.Suspend,
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: pod.ObjectMeta,
 				Spec:       pod.Spec,
 			},
 		},
 	}
+	if req.Parallelism != 0 {
+		job.Spec.Parallelism = &req.Parallelism
+	}
+	if req.ActiveDeadlineSeconds != 0 {
+		job.Spec.ActiveDeadlineSeconds = &req.ActiveDeadlineSeconds
+	}
+	if req.CompletionMode != "" {
+		job.Spec.CompletionMode = &req.CompletionMode
+	}
+
+	return job
 }
 
 func JobConvertReq(job *batchv1.Job) req.Job {
-	return req.Job{
-		Name:        job.Name,
-		Namespace:   job.Namespace,
-		Labels:      utils.ReqMapToItem(job.Labels),
-		Completions: *job.Spec.Completions,
+	res := req.Job{
+		Name:                    job.Name,
+		Namespace:               job.Namespace,
+		ResourceVersion:         job.ResourceVersion,
+		Labels:                  utils.ReqMapToItem(job.Labels),
+		BackoffLimit:            job.Spec.BackoffLimit,
+		TTLSecondsAfterFinished: job.Spec.TTLSecondsAfterFinished,
+		PodFailurePolicy:        job.Spec.PodFailurePolicy,
 		Template: *PodConvertReq(&corev1.Pod{
-			ObjectMeta: job.Spec.Template.ObjectMeta,
-			Spec:       job.Spec.Template.Spec,
+			ObjectMeta: metav1.ObjectMeta{
+				Labels:      JobTemplateLabels(job.Spec.Template.Labels),
+				Annotations: job.Spec.Template.Annotations,
+			},
+			Spec: job.Spec.Template.Spec,
 		}),
 	}
+	if job.Spec.Completions != nil {
+		res.Completions = *job.Spec.Completions
+	}
+	if job.Spec.Parallelism != nil {
+		res.Parallelism = *job.Spec.Parallelism
+	}
+	if job.Spec.ActiveDeadlineSeconds != nil {
+		res.ActiveDeadlineSeconds = *job.Spec.ActiveDeadlineSeconds
+	}
+	if job.Spec.CompletionMode != nil {
+		res.CompletionMode = *job.Spec.CompletionMode
+	}
+	if job.Spec.Suspend != nil {
+		res.Suspend = *job.Spec.Suspend
+	}
+
+	return res
+}
+
+// JobTemplateLabels drops the labels the job controller adds to the pod template.
+func JobTemplateLabels(labels map[string]string) map[string]string {
+	res := make(map[string]string, len(labels))
+	for k, v := range labels {
+		res[k] = v
+	}
+	for _, k := range jobControllerLabels {
+		delete(res, k)
+	}
+
+	return res
 }
 
 func JobConvertResp(job *batchv1.Job) resp.Job {
 	res := resp.Job{
-		Name:        job.Name,
-		Namespace:   job.Namespace,
-		Completions: *job.Spec.Completions,
-		Succeeded:   job.Status.Succeeded,
-		Age:         job.CreationTimestamp.Unix(),
+		Name:      job.Name,
+		Namespace: job.Namespace,
+		Succeeded: job.Status.Succeeded,
+		Active:    job.Status.Active,
+		Failed:    job.Status.Failed,
+		Status:    JobStatus(job),
+		Age:       job.CreationTimestamp.Unix(),
+	}
+	if job.Spec.Completions != nil {
+		res.Completions = *job.Spec.Completions
 	}
 	if job.Status.CompletionTime != nil && job.Status.StartTime != nil {
 		res.Duration = job.Status.CompletionTime.Unix() - job.Status.StartTime.Unix()
@@ -55,3 +127,59 @@ func JobConvertResp(job *batchv1.Job) resp.Job {
 
 	return res
 }
+
+// JobStatus reads the state of the Job from its conditions.
+func JobStatus(job *batchv1.Job) string {
+	for _, c := range job.Status.Conditions {
+		if c.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case batchv1.JobComplete:
+			return JobStatusComplete
+		case batchv1.JobFailed:
+			return JobStatusFailed
+		}
+	}
+	if job.Spec.Suspend != nil && *job.Spec.Suspend {
+		return JobStatusSuspended
+	}
+
+	return JobStatusRunning
+}
+
+func JobPodConvertResp(pod *corev1.Pod) resp.JobPod {
+	res := resp.JobPod{
+		Name:            pod.Name,
+		Phase:           string(pod.Status.Phase),
+		Node:            pod.Spec.NodeName,
+		CompletionIndex: pod.Annotations[batchv1.JobCompletionIndexAnnotation],
+		Containers:      make([]resp.JobContainerStatus, 0, len(pod.Status.ContainerStatuses)),
+	}
+	if pod.Status.StartTime != nil {
+		res.StartTime = pod.Status.StartTime.Unix()
+	}
+	for _, c := range pod.Status.ContainerStatuses {
+		status := resp.JobContainerStatus{Name: c.Name, Restarts: c.RestartCount}
+		switch {
+		case c.State.Terminated != nil:
+			status.State = "Terminated"
+			status.Reason = c.State.Terminated.Reason
+			status.ExitCode = c.State.Terminated.ExitCode
+		case c.State.Running != nil:
+			status.State = "Running"
+			if c.LastTerminationState.Terminated != nil {
+				status.ExitCode = c.LastTerminationState.Terminated.ExitCode
+			}
+		case c.State.Waiting != nil:
+			status.State = "Waiting"
+			status.Reason = c.State.Waiting.Reason
+			if c.LastTerminationState.Terminated != nil {
+				status.ExitCode = c.LastTerminationState.Terminated.ExitCode
+			}
+		}
+		res.Containers = append(res.Containers, status)
+	}
+
+	return res
+}
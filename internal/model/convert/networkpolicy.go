@@ -0,0 +1,146 @@
+package convert
+
+import (
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crazyfrankie/kube-ctl/internal/model/req"
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+	"github.com/crazyfrankie/kube-ctl/pkg/utils"
+)
+
+func NetworkPolicyReqConvert(req *req.NetworkPolicy) *networkingv1.NetworkPolicy {
+	ingress := make([]networkingv1.NetworkPolicyIngressRule, 0, len(req.Ingress))
+	for _, r := range req.Ingress {
+		ingress = append(ingress, networkingv1.NetworkPolicyIngressRule{
+			From:  networkPolicyPeersReqConvert(r.Peers),
+			Ports: networkPolicyPortsReqConvert(r.Ports),
+		})
+	}
+	egress := make([]networkingv1.NetworkPolicyEgressRule, 0, len(req.Egress))
+	for _, r := range req.Egress {
+		egress = append(egress, networkingv1.NetworkPolicyEgressRule{
+			To:    networkPolicyPeersReqConvert(r.Peers),
+			Ports: networkPolicyPortsReqConvert(r.Ports),
+		})
+	}
+
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      req.Name,
+			Namespace: req.Namespace,
+			Labels:    utils.ReqItemToMap(req.Labels),
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: req.PodSelector,
+			PolicyTypes: req.PolicyTypes,
+			Ingress:     ingress,
+			Egress:      egress,
+		},
+	}
+}
+
+func networkPolicyPeersReqConvert(peers []req.NetworkPolicyPeer) []networkingv1.NetworkPolicyPeer {
+	res := make([]networkingv1.NetworkPolicyPeer, 0, len(peers))
+	for _, p := range peers {
+		res = append(res, networkingv1.NetworkPolicyPeer{
+			PodSelector:       p.PodSelector,
+			NamespaceSelector: p.NamespaceSelector,
+			IPBlock:           p.IPBlock,
+		})
+	}
+
+	return res
+}
+
+func networkPolicyPortsReqConvert(ports []req.NetworkPolicyPort) []networkingv1.NetworkPolicyPort {
+	res := make([]networkingv1.NetworkPolicyPort, 0, len(ports))
+	for _, p := range ports {
+		port := networkingv1.NetworkPolicyPort{Port: p.Port}
+		if p.Protocol != "" {
+			protocol := p.Protocol
+			port.Protocol = &protocol
+		}
+		if p.EndPort != 0 {
+			endPort := p.EndPort
+			port.EndPort = &endPort
+		}
+		res = append(res, port)
+	}
+
+	return res
+}
+
+func NetworkPolicyConvertReq(np *networkingv1.NetworkPolicy) req.NetworkPolicy {
+	ingress := make([]req.NetworkPolicyRule, 0, len(np.Spec.Ingress))
+	for _, r := range np.Spec.Ingress {
+		ingress = append(ingress, req.NetworkPolicyRule{
+			Peers: networkPolicyPeersConvertReq(r.From),
+			Ports: networkPolicyPortsConvertReq(r.Ports),
+		})
+	}
+	egress := make([]req.NetworkPolicyRule, 0, len(np.Spec.Egress))
+	for _, r := range np.Spec.Egress {
+		egress = append(egress, req.NetworkPolicyRule{
+			Peers: networkPolicyPeersConvertReq(r.To),
+			Ports: networkPolicyPortsConvertReq(r.Ports),
+		})
+	}
+
+	return req.NetworkPolicy{
+		Name:            np.Name,
+		Namespace:       np.Namespace,
+		ResourceVersion: np.ResourceVersion,
+		Labels:          utils.ReqMapToItem(np.Labels),
+		PodSelector:     np.Spec.PodSelector,
+		PolicyTypes:     np.Spec.PolicyTypes,
+		Ingress:         ingress,
+		Egress:          egress,
+	}
+}
+
+func networkPolicyPeersConvertReq(peers []networkingv1.NetworkPolicyPeer) []req.NetworkPolicyPeer {
+	res := make([]req.NetworkPolicyPeer, 0, len(peers))
+	for _, p := range peers {
+		res = append(res, req.NetworkPolicyPeer{
+			PodSelector:       p.PodSelector,
+			NamespaceSelector: p.NamespaceSelector,
+			IPBlock:           p.IPBlock,
+		})
+	}
+
+	return res
+}
+
+func networkPolicyPortsConvertReq(ports []networkingv1.NetworkPolicyPort) []req.NetworkPolicyPort {
+	res := make([]req.NetworkPolicyPort, 0, len(ports))
+	for _, p := range ports {
+		port := req.NetworkPolicyPort{Port: p.Port}
+		if p.Protocol != nil {
+			port.Protocol = *p.Protocol
+		}
+		if p.EndPort != nil {
+			port.EndPort = *p.EndPort
+		}
+		res = append(res, port)
+	}
+
+	return res
+}
+
+func NetworkPolicyConvertResp(np *networkingv1.NetworkPolicy) resp.NetworkPolicy {
+	policyTypes := make([]string, 0, len(np.Spec.PolicyTypes))
+	for _, t := range np.Spec.PolicyTypes {
+		policyTypes = append(policyTypes, string(t))
+	}
+
+	return resp.NetworkPolicy{
+		Name:         np.Name,
+		Namespace:    np.Namespace,
+		PodSelector:  metav1.FormatLabelSelector(&np.Spec.PodSelector),
+		PolicyTypes:  policyTypes,
+		IngressRules: len(np.Spec.Ingress),
+		EgressRules:  len(np.Spec.Egress),
+		Age:          np.CreationTimestamp.Unix(),
+	}
+}
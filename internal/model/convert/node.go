@@ -44,6 +44,7 @@ func getNodeIP(adds []corev1.NodeAddress, addrTyp corev1.NodeAddressType) string
 func NodeDetailConvertResp(node *corev1.Node) resp.NodeDetail {
 	return resp.NodeDetail{
 		Name:             node.Name,
+		ResourceVersion:  node.ResourceVersion,
 		Age:              node.CreationTimestamp.Unix(),
 		Version:          node.Status.NodeInfo.KubeletVersion,
 		OSImage:          node.Status.NodeInfo.OSImage,
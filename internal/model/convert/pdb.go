@@ -0,0 +1,137 @@
+package convert
+
+import (
+	"fmt"
+	"strings"
+
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/crazyfrankie/kube-ctl/internal/model/req"
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+	"github.com/crazyfrankie/kube-ctl/pkg/utils"
+)
+
+const (
+	DisruptionBudgetNone     = "None"
+	DisruptionBudgetBlocking = "Blocking"
+	DisruptionBudgetAllowed  = "Allowed"
+)
+
+func PDBReqConvert(req *req.PDB) *policyv1.PodDisruptionBudget {
+	pdb := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      req.Name,
+			Namespace: req.Namespace,
+			Labels:    utils.ReqItemToMap(req.Labels),
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: req.Selector.DeepCopy(),
+		},
+	}
+	if req.MinAvailable != "" {
+		v := intstr.Parse(req.MinAvailable)
+		pdb.Spec.MinAvailable = &v
+	}
+	if req.MaxUnavailable != "" {
+		v := intstr.Parse(req.MaxUnavailable)
+		pdb.Spec.MaxUnavailable = &v
+	}
+
+	return pdb
+}
+
+func PDBConvertReq(pdb *policyv1.PodDisruptionBudget) req.PDB {
+	res := req.PDB{
+		Name:            pdb.Name,
+		Namespace:       pdb.Namespace,
+		ResourceVersion: pdb.ResourceVersion,
+		Labels:          utils.ReqMapToItem(pdb.Labels),
+		MinAvailable:    intOrStringValue(pdb.Spec.MinAvailable),
+		MaxUnavailable:  intOrStringValue(pdb.Spec.MaxUnavailable),
+	}
+	if pdb.Spec.Selector != nil {
+		res.Selector = *pdb.Spec.Selector.DeepCopy()
+	}
+
+	return res
+}
+
+func PDBConvertResp(pdb *policyv1.PodDisruptionBudget) resp.PDB {
+	res := resp.PDB{
+		Name:               pdb.Name,
+		Namespace:          pdb.Namespace,
+		MinAvailable:       intOrStringValue(pdb.Spec.MinAvailable),
+		MaxUnavailable:     intOrStringValue(pdb.Spec.MaxUnavailable),
+		CurrentHealthy:     pdb.Status.CurrentHealthy,
+		DesiredHealthy:     pdb.Status.DesiredHealthy,
+		ExpectedPods:       pdb.Status.ExpectedPods,
+		DisruptionsAllowed: pdb.Status.DisruptionsAllowed,
+		Age:                pdb.CreationTimestamp.Unix(),
+	}
+	if pdb.Spec.Selector != nil {
+		res.Selector = metav1.FormatLabelSelector(pdb.Spec.Selector)
+	}
+
+	return res
+}
+
+func intOrStringValue(v *intstr.IntOrString) string {
+	if v == nil {
+		return ""
+	}
+
+	return v.String()
+}
+
+// DisruptionBudgetRefConvert summarizes the PDBs selecting the pods of a workload with the given replicas.
+// A budget that can never allow an eviction, such as maxUnavailable 0 or minAvailable 100%,
+// blocks drains and rolling node upgrades for good, not only until the pods are healthy again.
+func DisruptionBudgetRefConvert(pdbs []policyv1.PodDisruptionBudget, replicas int32) *req.DisruptionBudgetRef {
+	if len(pdbs) == 0 {
+		return &req.DisruptionBudgetRef{
+			Status:  DisruptionBudgetNone,
+			Message: "no PDB, the pods can all be evicted at once by a drain",
+			Budgets: []string{},
+		}
+	}
+
+	ref := &req.DisruptionBudgetRef{Status: DisruptionBudgetAllowed, Budgets: make([]string, 0, len(pdbs))}
+	blocking := make([]string, 0)
+	for i, p := range pdbs {
+		ref.Budgets = append(ref.Budgets, p.Name)
+		if i == 0 || p.Status.DisruptionsAllowed < ref.DisruptionsAllowed {
+			ref.DisruptionsAllowed = p.Status.DisruptionsAllowed
+		}
+		if pdbBlocksAll(&p, replicas) {
+			blocking = append(blocking, p.Name)
+		}
+	}
+
+	switch {
+	case len(blocking) > 0:
+		ref.Status = DisruptionBudgetBlocking
+		ref.Message = fmt.Sprintf("PDB %s blocks all disruptions, node drains will hang", strings.Join(blocking, ","))
+	case ref.DisruptionsAllowed == 0:
+		ref.Status = DisruptionBudgetBlocking
+		ref.Message = "PDB blocks all disruptions until more pods are healthy"
+	default:
+		ref.Message = fmt.Sprintf("%d pods can be disrupted now", ref.DisruptionsAllowed)
+	}
+
+	return ref
+}
+
+func pdbBlocksAll(pdb *policyv1.PodDisruptionBudget, replicas int32) bool {
+	if v := pdb.Spec.MaxUnavailable; v != nil {
+		n, err := intstr.GetScaledValueFromIntOrPercent(v, int(replicas), true)
+		return err == nil && n == 0
+	}
+	if v := pdb.Spec.MinAvailable; v != nil {
+		n, err := intstr.GetScaledValueFromIntOrPercent(v, int(replicas), true)
+		return err == nil && n >= int(replicas)
+	}
+
+	return false
+}
@@ -1,7 +1,9 @@
 package convert
 
 import (
+	"cmp"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -26,6 +28,15 @@ const (
 	HostPathVolume    = "hostPath"
 	DownwardAPIVolume = "downwardAPI"
 	PVCVolume         = "pvc"
+	ProjectedVolume   = "projected"
+	EphemeralVolume   = "ephemeral"
+
+	ServiceAccountTokenProjection = "serviceAccountToken"
+
+	SleepHandler = "sleep"
+
+	AffinityRequired  = "required"
+	AffinityPreferred = "preferred"
 
 	ScheduleNodeName     = "nodeName"
 	ScheduleNodeSelector = "nodeSelector"
@@ -34,12 +45,15 @@ const (
 
 	RefConfigMap = "configMap"
 	RefSecret    = "secret"
+	RefField     = "field"
+	RefResource  = "resource"
 )
 
 // PodReqConvert convert req.Pod to corev1.Pod
 func PodReqConvert(req *req.Pod) *corev1.Pod {
 	// get node scheduling
 	affinity, selector, nodeName := getPodNodeScheduling(req.NodeScheduling)
+	affinity = getPodAffinity(affinity, req.PodAffinity)
 
 	return &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -53,16 +67,23 @@ func PodReqConvert(req *req.Pod) *corev1.Pod {
 			Volumes:        getPodVolumes(req.Volume),
 			InitContainers: getPodContainers(req.InitContainers),
 			Containers:     getPodContainers(req.Containers),
+			HostNetwork:    req.Network.HostNetwork,
 			HostAliases:    getPodHostAliases(req.Network.HostAliases),
 			Hostname:       req.Network.HostName,
 			DNSConfig: &corev1.PodDNSConfig{
 				Nameservers: req.Network.DnsConfig.Nameservers,
 			},
-			DNSPolicy:     corev1.DNSPolicy(req.Network.DnsPolicy),
-			RestartPolicy: corev1.RestartPolicy(req.Base.RestartPolicy),
-			NodeName:      nodeName,
-			NodeSelector:  selector,
-			Affinity:      affinity,
+			DNSPolicy:                     corev1.DNSPolicy(req.Network.DnsPolicy),
+			RestartPolicy:                 corev1.RestartPolicy(req.Base.RestartPolicy),
+			ServiceAccountName:            req.Base.ServiceAccountName,
+			ImagePullSecrets:              getPodImagePullSecrets(req.Base.ImagePullSecrets),
+			PriorityClassName:             req.Base.PriorityClassName,
+			TerminationGracePeriodSeconds: req.Base.TerminationGracePeriodSeconds,
+			SecurityContext:               getPodSecurityContext(req.SecurityContext),
+			NodeName:                      nodeName,
+			NodeSelector:                  selector,
+			Affinity:                      affinity,
+			TopologySpreadConstraints:     getPodTopologySpread(req.TopologySpreadConstraints),
 		},
 	}
 }
@@ -91,23 +112,19 @@ func getPodVolumes(vms []req.Volume) []corev1.Volume {
 				Type: &i.HostPathVolume.Type,
 			}
 		case DownwardAPIVolume:
-			items := make([]corev1.DownwardAPIVolumeFile, 0, len(i.DownwardAPIVolume.Items))
-			for _, i := range i.DownwardAPIVolume.Items {
-				items = append(items, corev1.DownwardAPIVolumeFile{
-					// pod internal path
-					Path: i.Path,
-					FieldRef: &corev1.ObjectFieldSelector{
-						FieldPath: i.FieldPath,
-					},
-				})
-			}
 			source.DownwardAPI = &corev1.DownwardAPIVolumeSource{
-				Items: items,
+				Items: getDownwardAPIFiles(i.DownwardAPIVolume.Items),
 			}
 		case PVCVolume:
 			source.PersistentVolumeClaim = &corev1.PersistentVolumeClaimVolumeSource{
 				ClaimName: i.PVCVolume.ClaimName,
 			}
+		case ProjectedVolume:
+			source.Projected = &corev1.ProjectedVolumeSource{
+				Sources: getProjectedSources(i.ProjectedVolume.Sources),
+			}
+		case EphemeralVolume:
+			source.Ephemeral = getEphemeralVolume(i.EphemeralVolume)
 		default:
 			continue
 		}
@@ -121,6 +138,203 @@ func getPodVolumes(vms []req.Volume) []corev1.Volume {
 	return res
 }
 
+func getDownwardAPIFiles(items []req.DownwardAPIVolumeItem) []corev1.DownwardAPIVolumeFile {
+	res := make([]corev1.DownwardAPIVolumeFile, 0, len(items))
+	for _, i := range items {
+		res = append(res, corev1.DownwardAPIVolumeFile{
+			// pod internal path
+			Path: i.Path,
+			FieldRef: &corev1.ObjectFieldSelector{
+				FieldPath: i.FieldPath,
+			},
+		})
+	}
+
+	return res
+}
+
+func getProjectedSources(sources []req.ProjectedSource) []corev1.VolumeProjection {
+	res := make([]corev1.VolumeProjection, 0, len(sources))
+	for _, i := range sources {
+		var projection corev1.VolumeProjection
+		switch i.Type {
+		case ConfigMapVolume:
+			projection.ConfigMap = &corev1.ConfigMapProjection{
+				LocalObjectReference: corev1.LocalObjectReference{Name: i.Name},
+				Items:                getKeyToPaths(i.Items),
+				Optional:             &i.Optional,
+			}
+		case SecretVolume:
+			projection.Secret = &corev1.SecretProjection{
+				LocalObjectReference: corev1.LocalObjectReference{Name: i.Name},
+				Items:                getKeyToPaths(i.Items),
+				Optional:             &i.Optional,
+			}
+		case DownwardAPIVolume:
+			projection.DownwardAPI = &corev1.DownwardAPIProjection{
+				Items: getDownwardAPIFiles(i.DownwardAPI),
+			}
+		case ServiceAccountTokenProjection:
+			projection.ServiceAccountToken = &corev1.ServiceAccountTokenProjection{
+				Audience: i.ServiceAccountToken.Audience,
+				Path:     i.ServiceAccountToken.Path,
+			}
+			if i.ServiceAccountToken.ExpirationSeconds > 0 {
+				projection.ServiceAccountToken.ExpirationSeconds = &i.ServiceAccountToken.ExpirationSeconds
+			}
+		default:
+			continue
+		}
+		res = append(res, projection)
+	}
+
+	return res
+}
+
+func getKeyToPaths(items []req.Item) []corev1.KeyToPath {
+	res := make([]corev1.KeyToPath, 0, len(items))
+	for _, i := range items {
+		res = append(res, corev1.KeyToPath{
+			Key:  i.Key,
+			Path: i.Value,
+		})
+	}
+
+	return res
+}
+
+func getEphemeralVolume(v req.EphemeralVolume) *corev1.EphemeralVolumeSource {
+	spec := corev1.PersistentVolumeClaimSpec{
+		AccessModes: v.AccessModes,
+		Resources: corev1.VolumeResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceStorage: resource.MustParse(v.Capacity),
+			},
+		},
+	}
+	// left out the claim gets the default StorageClass
+	if v.StorageClassName != "" {
+		spec.StorageClassName = &v.StorageClassName
+	}
+
+	return &corev1.EphemeralVolumeSource{
+		VolumeClaimTemplate: &corev1.PersistentVolumeClaimTemplate{Spec: spec},
+	}
+}
+
+func getPodImagePullSecrets(names []string) []corev1.LocalObjectReference {
+	res := make([]corev1.LocalObjectReference, 0, len(names))
+	for _, n := range names {
+		res = append(res, corev1.LocalObjectReference{Name: n})
+	}
+
+	return res
+}
+
+func getPodSecurityContext(sc req.PodSecurityContext) *corev1.PodSecurityContext {
+	res := &corev1.PodSecurityContext{
+		RunAsUser:          sc.RunAsUser,
+		RunAsGroup:         sc.RunAsGroup,
+		FSGroup:            sc.FSGroup,
+		SupplementalGroups: sc.SupplementalGroups,
+		SeccompProfile:     getSeccompProfile(sc.SeccompProfile),
+	}
+	if sc.RunAsNonRoot {
+		res.RunAsNonRoot = &sc.RunAsNonRoot
+	}
+	if sc.FSGroupChangePolicy != "" {
+		policy := corev1.PodFSGroupChangePolicy(sc.FSGroupChangePolicy)
+		res.FSGroupChangePolicy = &policy
+	}
+	for _, i := range sc.Sysctls {
+		res.Sysctls = append(res.Sysctls, corev1.Sysctl{
+			Name:  i.Key,
+			Value: i.Value,
+		})
+	}
+
+	return res
+}
+
+func getSeccompProfile(profile req.SeccompProfile) *corev1.SeccompProfile {
+	if profile.Type == "" {
+		return nil
+	}
+	res := &corev1.SeccompProfile{Type: corev1.SeccompProfileType(profile.Type)}
+	if res.Type == corev1.SeccompProfileTypeLocalhost {
+		res.LocalhostProfile = &profile.LocalhostProfile
+	}
+
+	return res
+}
+
+// getPodAffinity adds the pod (anti-)affinity terms to the node affinity of the scheduling.
+func getPodAffinity(affinity *corev1.Affinity, pa req.PodAffinity) *corev1.Affinity {
+	if len(pa.Affinity) == 0 && len(pa.AntiAffinity) == 0 {
+		return affinity
+	}
+	if affinity == nil {
+		affinity = &corev1.Affinity{}
+	}
+	if len(pa.Affinity) > 0 {
+		required, preferred := getPodAffinityTerms(pa.Affinity)
+		affinity.PodAffinity = &corev1.PodAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution:  required,
+			PreferredDuringSchedulingIgnoredDuringExecution: preferred,
+		}
+	}
+	if len(pa.AntiAffinity) > 0 {
+		required, preferred := getPodAffinityTerms(pa.AntiAffinity)
+		affinity.PodAntiAffinity = &corev1.PodAntiAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution:  required,
+			PreferredDuringSchedulingIgnoredDuringExecution: preferred,
+		}
+	}
+
+	return affinity
+}
+
+func getPodAffinityTerms(terms []req.PodAffinityTerm) ([]corev1.PodAffinityTerm, []corev1.WeightedPodAffinityTerm) {
+	var required []corev1.PodAffinityTerm
+	var preferred []corev1.WeightedPodAffinityTerm
+	for _, t := range terms {
+		term := corev1.PodAffinityTerm{
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: utils.ReqItemToMap(t.LabelSelector),
+			},
+			Namespaces:  t.Namespaces,
+			TopologyKey: t.TopologyKey,
+		}
+		if t.Type == AffinityPreferred {
+			preferred = append(preferred, corev1.WeightedPodAffinityTerm{
+				Weight:          t.Weight,
+				PodAffinityTerm: term,
+			})
+			continue
+		}
+		required = append(required, term)
+	}
+
+	return required, preferred
+}
+
+func getPodTopologySpread(rules []req.TopologySpreadRule) []corev1.TopologySpreadConstraint {
+	res := make([]corev1.TopologySpreadConstraint, 0, len(rules))
+	for _, r := range rules {
+		res = append(res, corev1.TopologySpreadConstraint{
+			MaxSkew:           r.MaxSkew,
+			TopologyKey:       r.TopologyKey,
+			WhenUnsatisfiable: corev1.UnsatisfiableConstraintAction(r.WhenUnsatisfiable),
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: utils.ReqItemToMap(r.LabelSelector),
+			},
+			MinDomains: r.MinDomains,
+		})
+	}
+
+	return res
+}
+
 func getPodContainers(cs []req.Container) []corev1.Container {
 	res := make([]corev1.Container, 0, len(cs))
 
@@ -136,20 +350,88 @@ func getPodContainers(cs []req.Container) []corev1.Container {
 			Env:             getPodEnvVar(c.Env),
 			EnvFrom:         getPodEnvVarFrom(c.EnvsFrom),
 			ImagePullPolicy: corev1.PullPolicy(c.ImagePullPolicy),
-			SecurityContext: &corev1.SecurityContext{
-				Privileged: &c.Privileged,
-			},
-			Resources:      getPodContainerResource(c.Resources),
-			VolumeMounts:   getPodContainerVolumeMounts(c.VolumeMounts),
-			StartupProbe:   getPodContainerProbe(c.StartUpProbe),
-			LivenessProbe:  getPodContainerProbe(c.LivenessProbe),
-			ReadinessProbe: getPodContainerProbe(c.ReadinessProbe),
+			SecurityContext: getContainerSecurityContext(c),
+			Resources:       getPodContainerResource(c.Resources),
+			VolumeMounts:    getPodContainerVolumeMounts(c.VolumeMounts),
+			StartupProbe:    getPodContainerProbe(c.StartUpProbe),
+			LivenessProbe:   getPodContainerProbe(c.LivenessProbe),
+			ReadinessProbe:  getPodContainerProbe(c.ReadinessProbe),
+			Lifecycle:       getContainerLifecycle(c.Lifecycle),
 		})
 	}
 
 	return res
 }
 
+func getContainerSecurityContext(c req.Container) *corev1.SecurityContext {
+	sc := c.SecurityContext
+	res := &corev1.SecurityContext{
+		Privileged:               &c.Privileged,
+		RunAsUser:                sc.RunAsUser,
+		RunAsGroup:               sc.RunAsGroup,
+		AllowPrivilegeEscalation: sc.AllowPrivilegeEscalation,
+		SeccompProfile:           getSeccompProfile(sc.SeccompProfile),
+	}
+	if sc.RunAsNonRoot {
+		res.RunAsNonRoot = &sc.RunAsNonRoot
+	}
+	if sc.ReadOnlyRootFilesystem {
+		res.ReadOnlyRootFilesystem = &sc.ReadOnlyRootFilesystem
+	}
+	if len(sc.Capabilities.Add) > 0 || len(sc.Capabilities.Drop) > 0 {
+		res.Capabilities = &corev1.Capabilities{
+			Add:  getCapabilities(sc.Capabilities.Add),
+			Drop: getCapabilities(sc.Capabilities.Drop),
+		}
+	}
+
+	return res
+}
+
+func getCapabilities(caps []string) []corev1.Capability {
+	res := make([]corev1.Capability, 0, len(caps))
+	for _, c := range caps {
+		res = append(res, corev1.Capability(c))
+	}
+
+	return res
+}
+
+func getContainerLifecycle(lc req.Lifecycle) *corev1.Lifecycle {
+	postStart := getLifecycleHandler(lc.PostStart)
+	preStop := getLifecycleHandler(lc.PreStop)
+	if postStart == nil && preStop == nil {
+		return nil
+	}
+
+	return &corev1.Lifecycle{
+		PostStart: postStart,
+		PreStop:   preStop,
+	}
+}
+
+func getLifecycleHandler(h req.LifecycleHandler) *corev1.LifecycleHandler {
+	if !h.Enable {
+		return nil
+	}
+
+	var res corev1.LifecycleHandler
+	switch h.Type {
+	case HTTPProbe:
+		res.HTTPGet = getHTTPGetAction(h.HttpGet)
+	case EXECProbe:
+		res.Exec = &corev1.ExecAction{
+			Command: h.Command.Command,
+		}
+	case SleepHandler:
+		res.Sleep = &corev1.SleepAction{
+			Seconds: h.Sleep,
+		}
+	}
+
+	return &res
+}
+
 func getPodPorts(ports []req.ContainerPort) []corev1.ContainerPort {
 	res := make([]corev1.ContainerPort, 0, len(ports))
 	for _, i := range ports {
@@ -175,6 +457,9 @@ func getPodEnvVar(items []req.EnvVar) []corev1.EnvVar {
 					Key:                  i.Value,
 				},
 			}
+			if i.Optional {
+				env.ValueFrom.ConfigMapKeyRef.Optional = &i.Optional
+			}
 		case RefSecret:
 			env.ValueFrom = &corev1.EnvVarSource{
 				SecretKeyRef: &corev1.SecretKeySelector{
@@ -182,10 +467,30 @@ func getPodEnvVar(items []req.EnvVar) []corev1.EnvVar {
 					Key:                  i.Value,
 				},
 			}
+			if i.Optional {
+				env.ValueFrom.SecretKeyRef.Optional = &i.Optional
+			}
+		case RefField:
+			env.ValueFrom = &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					FieldPath: i.Value,
+				},
+			}
+		case RefResource:
+			env.ValueFrom = &corev1.EnvVarSource{
+				ResourceFieldRef: &corev1.ResourceFieldSelector{
+					ContainerName: i.ContainerName,
+					Resource:      i.Value,
+				},
+			}
+			// the divisor is validated beforehand, a bad one keeps the default of 1 rather than panicking
+			if divisor, err := resource.ParseQuantity(i.Divisor); err == nil {
+				env.ValueFrom.ResourceFieldRef.Divisor = divisor
+			}
 		default:
 			env.Value = i.Value
 		}
-		envs = append(envs)
+		envs = append(envs, env)
 	}
 
 	return envs
@@ -219,22 +524,7 @@ func getPodContainerProbe(probe req.ContainerProbe) *corev1.Probe {
 	var hdl corev1.ProbeHandler
 	switch probe.Type {
 	case HTTPProbe:
-		header := make([]corev1.HTTPHeader, 0, len(probe.HttpGet.Headers))
-		for _, h := range probe.HttpGet.Headers {
-			header = append(header, corev1.HTTPHeader{
-				Name:  h.Key,
-				Value: h.Value,
-			})
-		}
-		hdl.HTTPGet = &corev1.HTTPGetAction{
-			Path: probe.HttpGet.Path,
-			Port: intstr.IntOrString{
-				IntVal: probe.HttpGet.Port,
-			},
-			Host:        probe.HttpGet.Host,
-			Scheme:      corev1.URIScheme(probe.HttpGet.Scheme),
-			HTTPHeaders: header,
-		}
+		hdl.HTTPGet = getHTTPGetAction(probe.HttpGet)
 	case TCPProbe:
 		hdl.TCPSocket = &corev1.TCPSocketAction{
 			Port: intstr.IntOrString{
@@ -258,6 +548,26 @@ func getPodContainerProbe(probe req.ContainerProbe) *corev1.Probe {
 	}
 }
 
+func getHTTPGetAction(get req.ProbeHTTPGet) *corev1.HTTPGetAction {
+	header := make([]corev1.HTTPHeader, 0, len(get.Headers))
+	for _, h := range get.Headers {
+		header = append(header, corev1.HTTPHeader{
+			Name:  h.Key,
+			Value: h.Value,
+		})
+	}
+
+	return &corev1.HTTPGetAction{
+		Path: get.Path,
+		Port: intstr.IntOrString{
+			IntVal: get.Port,
+		},
+		Host:        get.Host,
+		Scheme:      corev1.URIScheme(get.Scheme),
+		HTTPHeaders: header,
+	}
+}
+
 func getPodContainerVolumeMounts(vms []req.VolumeMount) []corev1.VolumeMount {
 	res := make([]corev1.VolumeMount, 0, len(vms))
 	for _, vm := range vms {
@@ -271,21 +581,61 @@ func getPodContainerVolumeMounts(vms []req.VolumeMount) []corev1.VolumeMount {
 	return res
 }
 
-func getPodContainerResource(rs req.Resource) corev1.ResourceRequirements {
+// getPodContainerResource sets every request and limit on its own, leaving empty ones unset
+// so the api server and the LimitRanges of the namespace can default them.
+func getPodContainerResource(rs []req.ResourceRequirement) corev1.ResourceRequirements {
 	var res corev1.ResourceRequirements
-	if !rs.Enable {
-		return res
+	for _, r := range rs {
+		name := corev1.ResourceName(r.Name)
+		if r.Request != "" {
+			if res.Requests == nil {
+				res.Requests = corev1.ResourceList{}
+			}
+			res.Requests[name] = resource.MustParse(r.Request)
+		}
+		if r.Limit != "" {
+			if res.Limits == nil {
+				res.Limits = corev1.ResourceList{}
+			}
+			res.Limits[name] = resource.MustParse(r.Limit)
+		}
 	}
-	res.Limits = corev1.ResourceList{
-		corev1.ResourceCPU:    resource.MustParse(fmt.Sprintf("%dm", rs.CPULimit)),
-		corev1.ResourceMemory: resource.MustParse(fmt.Sprintf("%dMi", rs.MemoryLimit)),
+
+	return res
+}
+
+// ResourceNames returns the resources named in any of lists, cpu, memory and ephemeral-storage first,
+// then hugepages, then the extended resources, each sorted by name.
+func ResourceNames(lists ...corev1.ResourceList) []corev1.ResourceName {
+	var names []corev1.ResourceName
+	for _, l := range lists {
+		for name := range l {
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
 	}
-	res.Requests = corev1.ResourceList{
-		corev1.ResourceCPU:    resource.MustParse(fmt.Sprintf("%dm", rs.CPUReq)),
-		corev1.ResourceMemory: resource.MustParse(fmt.Sprintf("%dMi", rs.MemoryReq)),
+	rank := func(name corev1.ResourceName) int {
+		switch {
+		case name == corev1.ResourceCPU:
+			return 0
+		case name == corev1.ResourceMemory:
+			return 1
+		case name == corev1.ResourceEphemeralStorage:
+			return 2
+		case strings.HasPrefix(string(name), corev1.ResourceHugePagesPrefix):
+			return 3
+		}
+		return 4
 	}
+	slices.SortFunc(names, func(a, b corev1.ResourceName) int {
+		if c := cmp.Compare(rank(a), rank(b)); c != 0 {
+			return c
+		}
+		return cmp.Compare(a, b)
+	})
 
-	return res
+	return names
 }
 
 func getPodHostAliases(as []req.Item) []corev1.HostAlias {
@@ -344,29 +694,145 @@ func getPodNodeScheduling(sch req.NodeScheduling) (*corev1.Affinity, map[string]
 func PodConvertReq(pod *corev1.Pod) *req.Pod {
 	volume, volumeMap := getReqVolume(pod.Spec.Volumes)
 	return &req.Pod{
-		Base:           getReqBase(pod),
-		Network:        getReqNetwork(pod),
-		Volume:         volume,
-		InitContainers: getReqContainers(pod.Spec.InitContainers, volumeMap),
-		Containers:     getReqContainers(pod.Spec.Containers, volumeMap),
-		Tolerations:    pod.Spec.Tolerations,
-		NodeScheduling: getReqPodNodeScheduling(pod),
+		Base:                      getReqBase(pod),
+		ResourceVersion:           pod.ResourceVersion,
+		Network:                   getReqNetwork(pod),
+		SecurityContext:           getReqPodSecurityContext(pod.Spec.SecurityContext),
+		Volume:                    volume,
+		InitContainers:            getReqContainers(pod.Spec.InitContainers, volumeMap),
+		Containers:                getReqContainers(pod.Spec.Containers, volumeMap),
+		Tolerations:               pod.Spec.Tolerations,
+		NodeScheduling:            getReqPodNodeScheduling(pod),
+		PodAffinity:               getReqPodAffinity(pod.Spec.Affinity),
+		TopologySpreadConstraints: getReqTopologySpread(pod.Spec.TopologySpreadConstraints),
 	}
 }
 
 func getReqBase(pod *corev1.Pod) req.Base {
+	pullSecrets := make([]string, 0, len(pod.Spec.ImagePullSecrets))
+	for _, s := range pod.Spec.ImagePullSecrets {
+		pullSecrets = append(pullSecrets, s.Name)
+	}
+
 	return req.Base{
-		Name:          pod.Name,
-		Labels:        utils.ReqMapToItem(pod.Labels),
-		Namespace:     pod.Namespace,
-		RestartPolicy: string(pod.Spec.RestartPolicy),
+		Name:                          pod.Name,
+		Labels:                        utils.ReqMapToItem(pod.Labels),
+		Namespace:                     pod.Namespace,
+		RestartPolicy:                 string(pod.Spec.RestartPolicy),
+		ServiceAccountName:            pod.Spec.ServiceAccountName,
+		ImagePullSecrets:              pullSecrets,
+		PriorityClassName:             pod.Spec.PriorityClassName,
+		TerminationGracePeriodSeconds: pod.Spec.TerminationGracePeriodSeconds,
+	}
+}
+
+func getReqPodSecurityContext(sc *corev1.PodSecurityContext) req.PodSecurityContext {
+	if sc == nil {
+		return req.PodSecurityContext{}
+	}
+
+	res := req.PodSecurityContext{
+		RunAsUser:          sc.RunAsUser,
+		RunAsGroup:         sc.RunAsGroup,
+		RunAsNonRoot:       sc.RunAsNonRoot != nil && *sc.RunAsNonRoot,
+		FSGroup:            sc.FSGroup,
+		SupplementalGroups: sc.SupplementalGroups,
+		SeccompProfile:     getReqSeccompProfile(sc.SeccompProfile),
+		Sysctls:            make([]req.Item, 0, len(sc.Sysctls)),
+	}
+	if sc.FSGroupChangePolicy != nil {
+		res.FSGroupChangePolicy = string(*sc.FSGroupChangePolicy)
+	}
+	for _, i := range sc.Sysctls {
+		res.Sysctls = append(res.Sysctls, req.Item{
+			Key:   i.Name,
+			Value: i.Value,
+		})
+	}
+
+	return res
+}
+
+func getReqSeccompProfile(profile *corev1.SeccompProfile) req.SeccompProfile {
+	if profile == nil {
+		return req.SeccompProfile{}
+	}
+	res := req.SeccompProfile{Type: string(profile.Type)}
+	if profile.LocalhostProfile != nil {
+		res.LocalhostProfile = *profile.LocalhostProfile
+	}
+
+	return res
+}
+
+func getReqPodAffinity(affinity *corev1.Affinity) req.PodAffinity {
+	res := req.PodAffinity{
+		Affinity:     make([]req.PodAffinityTerm, 0),
+		AntiAffinity: make([]req.PodAffinityTerm, 0),
+	}
+	if affinity == nil {
+		return res
+	}
+	if pa := affinity.PodAffinity; pa != nil {
+		res.Affinity = getReqPodAffinityTerms(pa.RequiredDuringSchedulingIgnoredDuringExecution,
+			pa.PreferredDuringSchedulingIgnoredDuringExecution)
+	}
+	if pa := affinity.PodAntiAffinity; pa != nil {
+		res.AntiAffinity = getReqPodAffinityTerms(pa.RequiredDuringSchedulingIgnoredDuringExecution,
+			pa.PreferredDuringSchedulingIgnoredDuringExecution)
+	}
+
+	return res
+}
+
+func getReqPodAffinityTerms(required []corev1.PodAffinityTerm, preferred []corev1.WeightedPodAffinityTerm) []req.PodAffinityTerm {
+	res := make([]req.PodAffinityTerm, 0, len(required)+len(preferred))
+	for _, t := range required {
+		res = append(res, getReqPodAffinityTerm(AffinityRequired, 0, &t))
+	}
+	for _, t := range preferred {
+		res = append(res, getReqPodAffinityTerm(AffinityPreferred, t.Weight, &t.PodAffinityTerm))
+	}
+
+	return res
+}
+
+func getReqPodAffinityTerm(typ string, weight int32, term *corev1.PodAffinityTerm) req.PodAffinityTerm {
+	res := req.PodAffinityTerm{
+		Type:        typ,
+		Weight:      weight,
+		Namespaces:  term.Namespaces,
+		TopologyKey: term.TopologyKey,
+	}
+	if term.LabelSelector != nil {
+		res.LabelSelector = utils.ReqMapToItem(term.LabelSelector.MatchLabels)
+	}
+
+	return res
+}
+
+func getReqTopologySpread(constraints []corev1.TopologySpreadConstraint) []req.TopologySpreadRule {
+	res := make([]req.TopologySpreadRule, 0, len(constraints))
+	for _, c := range constraints {
+		rule := req.TopologySpreadRule{
+			MaxSkew:           c.MaxSkew,
+			TopologyKey:       c.TopologyKey,
+			WhenUnsatisfiable: string(c.WhenUnsatisfiable),
+			MinDomains:        c.MinDomains,
+		}
+		if c.LabelSelector != nil {
+			rule.LabelSelector = utils.ReqMapToItem(c.LabelSelector.MatchLabels)
+		}
+		res = append(res, rule)
 	}
+
+	return res
 }
 
 func getReqNetwork(pod *corev1.Pod) req.Network {
 	return req.Network{
 		HostNetwork: pod.Spec.HostNetwork,
-		HostName:    pod.Spec.NodeName,
+		HostName:    pod.Spec.Hostname,
 		DnsPolicy:   string(pod.Spec.DNSPolicy),
 		DnsConfig:   getReqDNSConfig(pod.Spec.DNSConfig),
 		HostAliases: getReqHostAliases(pod.Spec.HostAliases),
@@ -407,7 +873,7 @@ func getReqVolume(volumes []corev1.Volume) ([]req.Volume, map[string]string) {
 			volume.Type = ConfigMapVolume
 			var optional bool
 			if v.ConfigMap.Optional != nil {
-				optional = *v.Secret.Optional
+				optional = *v.ConfigMap.Optional
 			}
 			volume.ConfigMapRefVolume = req.ConfigMapRefVolume{
 				Name:     v.ConfigMap.Name,
@@ -434,14 +900,7 @@ func getReqVolume(volumes []corev1.Volume) ([]req.Volume, map[string]string) {
 		}
 		if v.DownwardAPI != nil {
 			volume.Type = DownwardAPIVolume
-			items := make([]req.DownwardAPIVolumeItem, 0, len(v.DownwardAPI.Items))
-			for _, i := range v.DownwardAPI.Items {
-				items = append(items, req.DownwardAPIVolumeItem{
-					Path:      i.Path,
-					FieldPath: i.FieldRef.FieldPath,
-				})
-			}
-			volume.DownwardAPIVolume = req.DownwardAPIVolume{Items: items}
+			volume.DownwardAPIVolume = req.DownwardAPIVolume{Items: getReqDownwardAPIItems(v.DownwardAPI.Items)}
 		}
 		if v.PersistentVolumeClaim != nil {
 			volume.Type = PVCVolume
@@ -449,6 +908,23 @@ func getReqVolume(volumes []corev1.Volume) ([]req.Volume, map[string]string) {
 				ClaimName: v.PersistentVolumeClaim.ClaimName,
 			}
 		}
+		if v.Projected != nil {
+			volume.Type = ProjectedVolume
+			volume.ProjectedVolume = req.ProjectedVolume{Sources: getReqProjectedSources(v.Projected.Sources)}
+		}
+		if v.Ephemeral != nil && v.Ephemeral.VolumeClaimTemplate != nil {
+			spec := v.Ephemeral.VolumeClaimTemplate.Spec
+			volume.Type = EphemeralVolume
+			volume.EphemeralVolume = req.EphemeralVolume{
+				AccessModes: spec.AccessModes,
+			}
+			if spec.StorageClassName != nil {
+				volume.EphemeralVolume.StorageClassName = *spec.StorageClassName
+			}
+			if capacity, ok := spec.Resources.Requests[corev1.ResourceStorage]; ok {
+				volume.EphemeralVolume.Capacity = capacity.String()
+			}
+		}
 
 		volumeMap[v.Name] = ""
 		res = append(res, volume)
@@ -457,6 +933,78 @@ func getReqVolume(volumes []corev1.Volume) ([]req.Volume, map[string]string) {
 	return res, volumeMap
 }
 
+// getReqDownwardAPIItems keeps the field references, resource references can not be expressed.
+func getReqDownwardAPIItems(files []corev1.DownwardAPIVolumeFile) []req.DownwardAPIVolumeItem {
+	res := make([]req.DownwardAPIVolumeItem, 0, len(files))
+	for _, i := range files {
+		if i.FieldRef == nil {
+			continue
+		}
+		res = append(res, req.DownwardAPIVolumeItem{
+			Path:      i.Path,
+			FieldPath: i.FieldRef.FieldPath,
+		})
+	}
+
+	return res
+}
+
+func getReqProjectedSources(sources []corev1.VolumeProjection) []req.ProjectedSource {
+	res := make([]req.ProjectedSource, 0, len(sources))
+	for _, p := range sources {
+		var source req.ProjectedSource
+		switch {
+		case p.ConfigMap != nil:
+			source = req.ProjectedSource{
+				Type:     ConfigMapVolume,
+				Name:     p.ConfigMap.Name,
+				Optional: p.ConfigMap.Optional != nil && *p.ConfigMap.Optional,
+				Items:    getReqKeyToPaths(p.ConfigMap.Items),
+			}
+		case p.Secret != nil:
+			source = req.ProjectedSource{
+				Type:     SecretVolume,
+				Name:     p.Secret.Name,
+				Optional: p.Secret.Optional != nil && *p.Secret.Optional,
+				Items:    getReqKeyToPaths(p.Secret.Items),
+			}
+		case p.DownwardAPI != nil:
+			source = req.ProjectedSource{
+				Type:        DownwardAPIVolume,
+				DownwardAPI: getReqDownwardAPIItems(p.DownwardAPI.Items),
+			}
+		case p.ServiceAccountToken != nil:
+			source = req.ProjectedSource{
+				Type: ServiceAccountTokenProjection,
+				ServiceAccountToken: req.ServiceAccountToken{
+					Path:     p.ServiceAccountToken.Path,
+					Audience: p.ServiceAccountToken.Audience,
+				},
+			}
+			if p.ServiceAccountToken.ExpirationSeconds != nil {
+				source.ServiceAccountToken.ExpirationSeconds = *p.ServiceAccountToken.ExpirationSeconds
+			}
+		default:
+			continue
+		}
+		res = append(res, source)
+	}
+
+	return res
+}
+
+func getReqKeyToPaths(items []corev1.KeyToPath) []req.Item {
+	res := make([]req.Item, 0, len(items))
+	for _, i := range items {
+		res = append(res, req.Item{
+			Key:   i.Key,
+			Value: i.Path,
+		})
+	}
+
+	return res
+}
+
 func getReqContainers(containers []corev1.Container, volumeMap map[string]string) []req.Container {
 	res := make([]req.Container, 0, len(containers))
 	for _, c := range containers {
@@ -476,17 +1024,88 @@ func getReqContainers(containers []corev1.Container, volumeMap map[string]string
 			Env:             getReqContainerEnv(c.Env),
 			EnvsFrom:        getReqContainerEnvVarFrom(c.EnvFrom),
 			Privileged:      privileged,
+			SecurityContext: getReqContainerSecurityContext(c.SecurityContext),
 			Resources:       getReqContainerResource(&c.Resources),
 			VolumeMounts:    getReqContainerVolumeMount(c.VolumeMounts, volumeMap),
 			StartUpProbe:    getReqContainerProbe(c.StartupProbe),
 			LivenessProbe:   getReqContainerProbe(c.LivenessProbe),
 			ReadinessProbe:  getReqContainerProbe(c.ReadinessProbe),
+			Lifecycle:       getReqContainerLifecycle(c.Lifecycle),
 		})
 	}
 
 	return res
 }
 
+func getReqContainerSecurityContext(sc *corev1.SecurityContext) req.SecurityContext {
+	if sc == nil {
+		return req.SecurityContext{}
+	}
+
+	res := req.SecurityContext{
+		RunAsUser:                sc.RunAsUser,
+		RunAsGroup:               sc.RunAsGroup,
+		RunAsNonRoot:             sc.RunAsNonRoot != nil && *sc.RunAsNonRoot,
+		ReadOnlyRootFilesystem:   sc.ReadOnlyRootFilesystem != nil && *sc.ReadOnlyRootFilesystem,
+		AllowPrivilegeEscalation: sc.AllowPrivilegeEscalation,
+		SeccompProfile:           getReqSeccompProfile(sc.SeccompProfile),
+	}
+	if sc.Capabilities != nil {
+		for _, c := range sc.Capabilities.Add {
+			res.Capabilities.Add = append(res.Capabilities.Add, string(c))
+		}
+		for _, c := range sc.Capabilities.Drop {
+			res.Capabilities.Drop = append(res.Capabilities.Drop, string(c))
+		}
+	}
+
+	return res
+}
+
+func getReqContainerLifecycle(lc *corev1.Lifecycle) req.Lifecycle {
+	if lc == nil {
+		return req.Lifecycle{}
+	}
+
+	return req.Lifecycle{
+		PostStart: getReqLifecycleHandler(lc.PostStart),
+		PreStop:   getReqLifecycleHandler(lc.PreStop),
+	}
+}
+
+func getReqLifecycleHandler(h *corev1.LifecycleHandler) req.LifecycleHandler {
+	switch {
+	case h == nil:
+		return req.LifecycleHandler{}
+	case h.HTTPGet != nil:
+		return req.LifecycleHandler{
+			Enable: true,
+			Type:   HTTPProbe,
+			HttpGet: req.ProbeHTTPGet{
+				Scheme:  string(h.HTTPGet.Scheme),
+				Host:    h.HTTPGet.Host,
+				Path:    h.HTTPGet.Path,
+				Port:    h.HTTPGet.Port.IntVal,
+				Headers: getReqProbeHTTPHeaders(h.HTTPGet.HTTPHeaders),
+			},
+		}
+	case h.Exec != nil:
+		return req.LifecycleHandler{
+			Enable:  true,
+			Type:    EXECProbe,
+			Command: req.ProbeCommand{Command: h.Exec.Command},
+		}
+	case h.Sleep != nil:
+		return req.LifecycleHandler{
+			Enable: true,
+			Type:   SleepHandler,
+			Sleep:  h.Sleep.Seconds,
+		}
+	default:
+		return req.LifecycleHandler{}
+	}
+}
+
 func getReqContainerPort(ports []corev1.ContainerPort) []req.ContainerPort {
 	res := make([]req.ContainerPort, 0, len(ports))
 	for _, i := range ports {
@@ -505,15 +1124,29 @@ func getReqContainerEnv(envs []corev1.EnvVar) []req.EnvVar {
 	for _, i := range envs {
 		env := req.EnvVar{Name: i.Name}
 		if i.ValueFrom != nil {
-			if i.ValueFrom.ConfigMapKeyRef != nil {
-				env.RefName = i.ValueFrom.ConfigMapKeyRef.LocalObjectReference.Name
-				env.Value = i.ValueFrom.ConfigMapKeyRef.Key
+			if ref := i.ValueFrom.ConfigMapKeyRef; ref != nil {
+				env.RefName = ref.LocalObjectReference.Name
+				env.Value = ref.Key
 				env.Type = RefConfigMap
+				env.Optional = ref.Optional != nil && *ref.Optional
 			}
-			if i.ValueFrom.SecretKeyRef != nil {
-				env.RefName = i.ValueFrom.SecretKeyRef.LocalObjectReference.Name
-				env.Value = i.ValueFrom.SecretKeyRef.Key
+			if ref := i.ValueFrom.SecretKeyRef; ref != nil {
+				env.RefName = ref.LocalObjectReference.Name
+				env.Value = ref.Key
 				env.Type = RefSecret
+				env.Optional = ref.Optional != nil && *ref.Optional
+			}
+			if ref := i.ValueFrom.FieldRef; ref != nil {
+				env.Value = ref.FieldPath
+				env.Type = RefField
+			}
+			if ref := i.ValueFrom.ResourceFieldRef; ref != nil {
+				env.Value = ref.Resource
+				env.Type = RefResource
+				env.ContainerName = ref.ContainerName
+				if !ref.Divisor.IsZero() {
+					env.Divisor = ref.Divisor.String()
+				}
 			}
 		} else {
 			env.Value = i.Value
@@ -543,18 +1176,21 @@ func getReqContainerEnvVarFrom(envs []corev1.EnvFromSource) []req.EnvVarFromReso
 	return res
 }
 
-func getReqContainerResource(resource *corev1.ResourceRequirements) req.Resource {
-	if resource == nil {
-		return req.Resource{}
+func getReqContainerResource(rs *corev1.ResourceRequirements) []req.ResourceRequirement {
+	names := ResourceNames(rs.Requests, rs.Limits)
+	res := make([]req.ResourceRequirement, 0, len(names))
+	for _, name := range names {
+		r := req.ResourceRequirement{Name: string(name)}
+		if q, ok := rs.Requests[name]; ok {
+			r.Request = q.String()
+		}
+		if q, ok := rs.Limits[name]; ok {
+			r.Limit = q.String()
+		}
+		res = append(res, r)
 	}
 
-	return req.Resource{
-		Enable:      true,
-		MemoryReq:   int32(resource.Requests.Memory().Value()),
-		MemoryLimit: int32(resource.Limits.Memory().Value()),
-		CPUReq:      int32(resource.Requests.Cpu().Value()),
-		CPULimit:    int32(resource.Limits.Cpu().Value()),
-	}
+	return res
 }
 
 func getReqContainerVolumeMount(vm []corev1.VolumeMount, volumeMap map[string]string) []req.VolumeMount {
@@ -651,7 +1287,9 @@ func getReqPodNodeScheduling(pod *corev1.Pod) req.NodeScheduling {
 		scheduling.NodeSelector = res
 	}
 
-	if pod.Spec.Affinity != nil && pod.Spec.Affinity.NodeAffinity != nil {
+	if pod.Spec.Affinity != nil && pod.Spec.Affinity.NodeAffinity != nil &&
+		pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil &&
+		len(pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms) > 0 {
 		// Hard affinity scheduling by default
 		scheduling.Type = ScheduleNodeAffinity
 		term := pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0]
@@ -11,24 +11,16 @@ import (
 )
 
 const (
-	VolumeTypeNFS = "nfs"
+	VolumeTypeNFS      = "nfs"
+	VolumeTypeHostPath = "hostPath"
+	VolumeTypeLocal    = "local"
+	VolumeTypeCSI      = "csi"
+	VolumeTypeISCSI    = "iscsi"
 )
 
+// PVReqConvert expects a request checked by validate.PVValidate, the source of an unknown type is left empty.
 func PVReqConvert(req *req.PersistentVolume) *corev1.PersistentVolume {
-	var volumeSource corev1.PersistentVolumeSource
-	switch req.VolumeSource.Type {
-	case VolumeTypeNFS:
-		volumeSource.NFS = &corev1.NFSVolumeSource{
-			Server:   req.VolumeSource.NFS.NfsServer,
-			Path:     req.VolumeSource.NFS.NfsPath,
-			ReadOnly: req.VolumeSource.NFS.ReadOnly,
-		}
-		// TODO other type
-	default:
-		panic("unsupported volume type")
-	}
-
-	return &corev1.PersistentVolume{
+	pv := &corev1.PersistentVolume{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:   req.Name,
 			Labels: utils.ReqItemToMap(req.Labels),
@@ -39,9 +31,115 @@ func PVReqConvert(req *req.PersistentVolume) *corev1.PersistentVolume {
 				corev1.ResourceStorage: resource.MustParse(req.Capacity),
 			},
 			PersistentVolumeReclaimPolicy: req.ReclaimPolicy,
-			PersistentVolumeSource:        volumeSource,
+			StorageClassName:              req.StorageClassName,
+			MountOptions:                  req.MountOptions,
 		},
 	}
+	if req.VolumeMode != "" {
+		pv.Spec.VolumeMode = &req.VolumeMode
+	}
+	if req.ClaimRef != nil {
+		pv.Spec.ClaimRef = &corev1.ObjectReference{
+			Kind:       "PersistentVolumeClaim",
+			APIVersion: "v1",
+			Namespace:  req.ClaimRef.Namespace,
+			Name:       req.ClaimRef.Name,
+		}
+	}
+
+	source := req.VolumeSource
+	switch source.Type {
+	case VolumeTypeNFS:
+		pv.Spec.NFS = &corev1.NFSVolumeSource{
+			Server:   source.NFS.NfsServer,
+			Path:     source.NFS.NfsPath,
+			ReadOnly: source.NFS.ReadOnly,
+		}
+	case VolumeTypeHostPath:
+		pv.Spec.HostPath = &corev1.HostPathVolumeSource{
+			Path: source.HostPath.Path,
+		}
+		if source.HostPath.Type != "" {
+			pv.Spec.HostPath.Type = &source.HostPath.Type
+		}
+	case VolumeTypeLocal:
+		pv.Spec.Local = &corev1.LocalVolumeSource{
+			Path: source.Local.Path,
+		}
+		if source.Local.FSType != "" {
+			pv.Spec.Local.FSType = &source.Local.FSType
+		}
+		pv.Spec.NodeAffinity = &corev1.VolumeNodeAffinity{
+			Required: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+					MatchExpressions: []corev1.NodeSelectorRequirement{{
+						Key:      corev1.LabelHostname,
+						Operator: corev1.NodeSelectorOpIn,
+						Values:   source.Local.Nodes,
+					}},
+				}},
+			},
+		}
+	case VolumeTypeCSI:
+		pv.Spec.CSI = &corev1.CSIPersistentVolumeSource{
+			Driver:                     source.CSI.Driver,
+			VolumeHandle:               source.CSI.VolumeHandle,
+			FSType:                     source.CSI.FSType,
+			ReadOnly:                   source.CSI.ReadOnly,
+			ControllerPublishSecretRef: secretReference(source.CSI.ControllerPublishSecretRef),
+			NodeStageSecretRef:         secretReference(source.CSI.NodeStageSecretRef),
+			NodePublishSecretRef:       secretReference(source.CSI.NodePublishSecretRef),
+			ControllerExpandSecretRef:  secretReference(source.CSI.ControllerExpandSecretRef),
+			NodeExpandSecretRef:        secretReference(source.CSI.NodeExpandSecretRef),
+		}
+		if len(source.CSI.VolumeAttributes) > 0 {
+			pv.Spec.CSI.VolumeAttributes = utils.ReqItemToMap(source.CSI.VolumeAttributes)
+		}
+	case VolumeTypeISCSI:
+		pv.Spec.ISCSI = &corev1.ISCSIPersistentVolumeSource{
+			TargetPortal:      source.ISCSI.TargetPortal,
+			Portals:           source.ISCSI.Portals,
+			IQN:               source.ISCSI.IQN,
+			Lun:               source.ISCSI.Lun,
+			ISCSIInterface:    source.ISCSI.ISCSIInterface,
+			FSType:            source.ISCSI.FSType,
+			ReadOnly:          source.ISCSI.ReadOnly,
+			DiscoveryCHAPAuth: source.ISCSI.ChapAuthDiscovery,
+			SessionCHAPAuth:   source.ISCSI.ChapAuthSession,
+			SecretRef:         secretReference(source.ISCSI.SecretRef),
+		}
+		if source.ISCSI.InitiatorName != "" {
+			pv.Spec.ISCSI.InitiatorName = &source.ISCSI.InitiatorName
+		}
+	}
+
+	return pv
+}
+
+func secretReference(ref *req.SecretRef) *corev1.SecretReference {
+	if ref == nil {
+		return nil
+	}
+
+	return &corev1.SecretReference{Name: ref.Name, Namespace: ref.Namespace}
+}
+
+// PVSourceType names the source of the volume, empty for the types kube-ctl does not create.
+func PVSourceType(pv *corev1.PersistentVolume) string {
+	switch {
+	case pv.Spec.NFS != nil:
+		return VolumeTypeNFS
+	case pv.Spec.HostPath != nil:
+		return VolumeTypeHostPath
+	case pv.Spec.Local != nil:
+		return VolumeTypeLocal
+	case pv.Spec.CSI != nil:
+		return VolumeTypeCSI
+	case pv.Spec.ISCSI != nil:
+		return VolumeTypeISCSI
+	}
+
+	return ""
 }
 
 func PVConvertResp(pv *corev1.PersistentVolume) resp.PersistentVolumeItem {
@@ -54,6 +152,10 @@ func PVConvertResp(pv *corev1.PersistentVolume) resp.PersistentVolumeItem {
 	if pv.Spec.ClaimRef != nil {
 		claim = pv.Spec.ClaimRef.Name
 	}
+	volumeMode := corev1.PersistentVolumeFilesystem
+	if pv.Spec.VolumeMode != nil {
+		volumeMode = *pv.Spec.VolumeMode
+	}
 	return resp.PersistentVolumeItem{
 		Name:                 pv.Name,
 		Labels:               utils.ResMapToItem(pv.Labels),
@@ -63,6 +165,8 @@ func PVConvertResp(pv *corev1.PersistentVolume) resp.PersistentVolumeItem {
 		Status:               pv.Status.Phase,
 		Claim:                claim,
 		StorageClass:         pv.Spec.StorageClassName,
+		Source:               PVSourceType(pv),
+		VolumeMode:           volumeMode,
 		VolumeAttributeClass: attributeName,
 		Reason:               pv.Status.Reason,
 		Age:                  pv.CreationTimestamp.Time.Unix(),
@@ -11,7 +11,7 @@ import (
 )
 
 func PVCReqConvert(req *req.PersistentVolumeClaim) *corev1.PersistentVolumeClaim {
-	return &corev1.PersistentVolumeClaim{
+	pvc := &corev1.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      req.Name,
 			Namespace: req.Namespace,
@@ -24,12 +24,20 @@ func PVCReqConvert(req *req.PersistentVolumeClaim) *corev1.PersistentVolumeClaim
 				},
 			},
 			AccessModes: req.AccessModes,
-			Selector: &metav1.LabelSelector{
-				MatchLabels: utils.ReqItemToMap(req.Selector),
-			},
-			StorageClassName: &req.StorageClassName,
 		},
 	}
+	// provisioners refuse claims with a selector, even an empty one
+	if len(req.Selector) > 0 {
+		pvc.Spec.Selector = &metav1.LabelSelector{
+			MatchLabels: utils.ReqItemToMap(req.Selector),
+		}
+	}
+	// left out the claim gets the default StorageClass, an empty name would bind it to volumes without a class
+	if req.StorageClassName != "" {
+		pvc.Spec.StorageClassName = &req.StorageClassName
+	}
+
+	return pvc
 }
 
 func PVCRespConvert(pvc *corev1.PersistentVolumeClaim) resp.PersistentVolumeClaim {
@@ -51,3 +59,61 @@ func PVCRespConvert(pvc *corev1.PersistentVolumeClaim) resp.PersistentVolumeClai
 		Age:                  pvc.CreationTimestamp.Unix(),
 	}
 }
+
+func PVCDetailConvertResp(pvc *corev1.PersistentVolumeClaim, pv *corev1.PersistentVolume, pods []corev1.Pod) resp.PersistentVolumeClaimDetail {
+	res := resp.PersistentVolumeClaimDetail{
+		Name:        pvc.Name,
+		Namespace:   pvc.Namespace,
+		Labels:      utils.ResMapToItem(pvc.Labels),
+		Status:      pvc.Status.Phase,
+		VolumeMode:  corev1.PersistentVolumeFilesystem,
+		AccessModes: pvc.Spec.AccessModes,
+		Requested:   pvc.Spec.Resources.Requests.Storage().String(),
+		Conditions:  make([]resp.PVCCondition, 0, len(pvc.Status.Conditions)),
+		MountedBy:   make([]resp.PVCMount, 0, len(pods)),
+		Age:         pvc.CreationTimestamp.Unix(),
+	}
+	if pvc.Spec.StorageClassName != nil {
+		res.StorageClass = *pvc.Spec.StorageClassName
+	}
+	if pvc.Spec.VolumeMode != nil {
+		res.VolumeMode = *pvc.Spec.VolumeMode
+	}
+	if capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]; ok {
+		res.Capacity = capacity.String()
+		request := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+		res.Resizing = pvc.Status.Phase == corev1.ClaimBound && request.Cmp(capacity) > 0
+	}
+	if pv != nil {
+		res.Volume = &resp.PVCVolume{
+			Name:          pv.Name,
+			Capacity:      pv.Spec.Capacity.Storage().String(),
+			ReclaimPolicy: pv.Spec.PersistentVolumeReclaimPolicy,
+			Status:        pv.Status.Phase,
+		}
+	}
+	for _, c := range pvc.Status.Conditions {
+		res.Conditions = append(res.Conditions, resp.PVCCondition{
+			Type:               string(c.Type),
+			Status:             string(c.Status),
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime.Unix(),
+		})
+	}
+	for _, pod := range pods {
+		for _, v := range pod.Spec.Volumes {
+			if v.PersistentVolumeClaim == nil || v.PersistentVolumeClaim.ClaimName != pvc.Name {
+				continue
+			}
+			res.MountedBy = append(res.MountedBy, resp.PVCMount{
+				Pod:      pod.Name,
+				Node:     pod.Spec.NodeName,
+				Phase:    string(pod.Status.Phase),
+				ReadOnly: v.PersistentVolumeClaim.ReadOnly,
+			})
+		}
+	}
+
+	return res
+}
@@ -87,39 +87,43 @@ func getReqRoleBindingSubjects(subjects []req.Subject) []rbacv1.Subject {
 
 func RoleConvertReq(role *rbacv1.Role) req.Role {
 	return req.Role{
-		Name:      role.Name,
-		Namespace: role.Namespace,
-		Labels:    utils.ReqMapToItem(role.Labels),
-		Rules:     role.Rules,
+		Name:            role.Name,
+		Namespace:       role.Namespace,
+		ResourceVersion: role.ResourceVersion,
+		Labels:          utils.ReqMapToItem(role.Labels),
+		Rules:           role.Rules,
 	}
 }
 
 func ClusterRoleConvertReq(role *rbacv1.ClusterRole) req.Role {
 	return req.Role{
-		Name:      role.Name,
-		Namespace: role.Namespace,
-		Labels:    utils.ReqMapToItem(role.Labels),
-		Rules:     role.Rules,
+		Name:            role.Name,
+		Namespace:       role.Namespace,
+		ResourceVersion: role.ResourceVersion,
+		Labels:          utils.ReqMapToItem(role.Labels),
+		Rules:           role.Rules,
 	}
 }
 
 func RoleBindingConvertReq(role *rbacv1.RoleBinding) req.RoleBinding {
 	return req.RoleBinding{
-		Name:      role.Name,
-		Namespace: role.Namespace,
-		Labels:    utils.ReqMapToItem(role.Labels),
-		RoleRef:   role.RoleRef.Name,
-		Subjects:  getRoleBindingSubjectsReq(role.Subjects),
+		Name:            role.Name,
+		Namespace:       role.Namespace,
+		ResourceVersion: role.ResourceVersion,
+		Labels:          utils.ReqMapToItem(role.Labels),
+		RoleRef:         role.RoleRef.Name,
+		Subjects:        getRoleBindingSubjectsReq(role.Subjects),
 	}
 }
 
 func ClusterRoleBindingConvertReq(role *rbacv1.ClusterRoleBinding) req.RoleBinding {
 	return req.RoleBinding{
-		Name:      role.Name,
-		Namespace: role.Namespace,
-		Labels:    utils.ReqMapToItem(role.Labels),
-		RoleRef:   role.RoleRef.Name,
-		Subjects:  getRoleBindingSubjectsReq(role.Subjects),
+		Name:            role.Name,
+		Namespace:       role.Namespace,
+		ResourceVersion: role.ResourceVersion,
+		Labels:          utils.ReqMapToItem(role.Labels),
+		RoleRef:         role.RoleRef.Name,
+		Subjects:        getRoleBindingSubjectsReq(role.Subjects),
 	}
 }
 
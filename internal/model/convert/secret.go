@@ -2,41 +2,97 @@ package convert
 
 import (
 	"encoding/base64"
+	"encoding/json"
+	"sort"
 	"time"
-	"unsafe"
+	"unicode/utf8"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
 	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+	"github.com/crazyfrankie/kube-ctl/pkg/consts"
 	"github.com/crazyfrankie/kube-ctl/pkg/utils"
 )
 
+// SecretReqConvert puts the plain values in Data, the API server stores them base64 encoded.
 func SecretReqConvert(req *req.Secret) *corev1.Secret {
-	data := make(map[string]string)
+	data := make(map[string][]byte, len(req.Data))
 	for _, i := range req.Data {
-		val := base64.StdEncoding.EncodeToString(unsafeToBytes(i.Value))
-		data[i.Key] = val
+		data[i.Key] = []byte(i.Value)
 	}
-	return &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:              req.Name,
-			Namespace:         req.Namespace,
-			Labels:            utils.ReqItemToMap(req.Labels),
-			CreationTimestamp: metav1.Time{Time: time.Now()},
+	secretType := req.Type
+	if secretType == "" {
+		secretType = corev1.SecretTypeOpaque
+	}
+
+	return newSecret(req.Name, req.Namespace, req.Labels, secretType, data)
+}
+
+func DockerConfigSecretReqConvert(req *req.DockerConfigSecret) (*corev1.Secret, error) {
+	auth := base64.StdEncoding.EncodeToString([]byte(req.Username + ":" + req.Password))
+	cfg := map[string]any{
+		"auths": map[string]any{
+			req.Registry: map[string]string{
+				"username": req.Username,
+				"password": req.Password,
+				"email":    req.Email,
+				"auth":     auth,
+			},
 		},
-		StringData: data,
-		Type:       req.Type,
 	}
+	raw, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSecret(req.Name, req.Namespace, req.Labels, corev1.SecretTypeDockerConfigJson, map[string][]byte{
+		corev1.DockerConfigJsonKey: raw,
+	}), nil
+}
+
+func TLSSecretReqConvert(req *req.TLSSecret) *corev1.Secret {
+	return newSecret(req.Name, req.Namespace, req.Labels, corev1.SecretTypeTLS, map[string][]byte{
+		corev1.TLSCertKey:       req.Cert,
+		corev1.TLSPrivateKeyKey: req.Key,
+	})
+}
+
+func BasicAuthSecretReqConvert(req *req.BasicAuthSecret) *corev1.Secret {
+	data := make(map[string][]byte, 2)
+	if req.Username != "" {
+		data[corev1.BasicAuthUsernameKey] = []byte(req.Username)
+	}
+	if req.Password != "" {
+		data[corev1.BasicAuthPasswordKey] = []byte(req.Password)
+	}
+
+	return newSecret(req.Name, req.Namespace, req.Labels, corev1.SecretTypeBasicAuth, data)
 }
 
-func unsafeToBytes(s string) []byte {
-	sh := (*[2]uintptr)(unsafe.Pointer(&s))
+func SSHAuthSecretReqConvert(req *req.SSHAuthSecret) *corev1.Secret {
+	data := map[string][]byte{
+		corev1.SSHAuthPrivateKey: []byte(req.PrivateKey),
+	}
+	if req.KnownHosts != "" {
+		data["known_hosts"] = []byte(req.KnownHosts)
+	}
 
-	res := [3]uintptr{sh[0], sh[1], sh[1]}
+	return newSecret(req.Name, req.Namespace, req.Labels, corev1.SecretTypeSSHAuth, data)
+}
 
-	return *(*[]byte)(unsafe.Pointer(&res))
+func newSecret(name string, namespace string, labels []req.Item, secretType corev1.SecretType, data map[string][]byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         namespace,
+			Labels:            utils.ReqItemToMap(labels),
+			CreationTimestamp: metav1.Time{Time: time.Now()},
+		},
+		Data: data,
+		Type: secretType,
+	}
 }
 
 func SecretConvertListResp(s *corev1.Secret) resp.Secret {
@@ -49,14 +105,56 @@ func SecretConvertListResp(s *corev1.Secret) resp.Secret {
 	}
 }
 
+// SecretConvertDetailResp only exposes the keys and sizes, values are masked.
 func SecretConvertDetailResp(s *corev1.Secret) resp.SecretDetail {
+	data := make([]resp.SecretItem, 0, len(s.Data))
+	for k, v := range s.Data {
+		data = append(data, resp.SecretItem{
+			Key:   k,
+			Value: consts.SecretMaskValue,
+			Size:  len(v),
+		})
+	}
+	sort.Slice(data, func(i, j int) bool {
+		return data[i].Key < data[j].Key
+	})
+
 	return resp.SecretDetail{
-		Name:      s.Name,
-		Namespace: s.Namespace,
-		DataNum:   len(s.Data),
-		Age:       s.CreationTimestamp.Time.Unix(),
-		Type:      s.Type,
-		Labels:    utils.ResMapToItem(s.Labels),
-		Data:      utils.ResMapToItem(s.StringData),
+		Name:            s.Name,
+		Namespace:       s.Namespace,
+		ResourceVersion: s.ResourceVersion,
+		DataNum:         len(s.Data),
+		Age:             s.CreationTimestamp.Time.Unix(),
+		Type:            s.Type,
+		Labels:          utils.ResMapToItem(s.Labels),
+		Data:            data,
 	}
 }
+
+// SecretRevealResp returns the decoded values of keys, or of every key when keys is empty.
+func SecretRevealResp(s *corev1.Secret, keys []string) []resp.SecretItem {
+	if len(keys) == 0 {
+		for k := range s.Data {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+	}
+
+	items := make([]resp.SecretItem, 0, len(keys))
+	for _, k := range keys {
+		v, ok := s.Data[k]
+		if !ok {
+			continue
+		}
+		item := resp.SecretItem{Key: k, Size: len(v)}
+		if utf8.Valid(v) {
+			item.Value = string(v)
+		} else {
+			item.Value = base64.StdEncoding.EncodeToString(v)
+			item.Base64 = true
+		}
+		items = append(items, item)
+	}
+
+	return items
+}
@@ -11,53 +11,102 @@ import (
 )
 
 func ServiceReqConvert(req *req.Service) *corev1.Service {
-	return &corev1.Service{
+	svc := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      req.Name,
 			Namespace: req.Namespace,
 			Labels:    utils.ReqItemToMap(req.Labels),
 		},
 		Spec: corev1.ServiceSpec{
-			Ports:    getServicePorts(req.Ports),
-			Selector: utils.ReqItemToMap(req.Selector),
-			Type:     req.Type,
+			Ports:                    getServicePorts(req.Ports),
+			Selector:                 utils.ReqItemToMap(req.Selector),
+			Type:                     req.Type,
+			ExternalIPs:              req.ExternalIPs,
+			SessionAffinity:          req.SessionAffinity,
+			ExternalTrafficPolicy:    req.ExternalTrafficPolicy,
+			LoadBalancerSourceRanges: req.LoadBalancerSourceRanges,
 		},
 	}
+
+	switch {
+	case req.Type == corev1.ServiceTypeExternalName:
+		// ExternalName services are a DNS CNAME, they have neither cluster ip nor selector
+		svc.Spec.ExternalName = req.ExternalName
+		svc.Spec.Selector = nil
+	case req.Headless:
+		svc.Spec.ClusterIP = corev1.ClusterIPNone
+	}
+
+	if req.SessionAffinity == corev1.ServiceAffinityClientIP && req.SessionAffinityTimeout > 0 {
+		svc.Spec.SessionAffinityConfig = &corev1.SessionAffinityConfig{
+			ClientIP: &corev1.ClientIPConfig{TimeoutSeconds: &req.SessionAffinityTimeout},
+		}
+	}
+
+	return svc
 }
 
 func getServicePorts(ports []req.ServicePort) []corev1.ServicePort {
 	res := make([]corev1.ServicePort, 0, len(ports))
 	for _, p := range ports {
-		res = append(res, corev1.ServicePort{
+		port := corev1.ServicePort{
 			Name:       p.Name,
+			Protocol:   p.Protocol,
 			Port:       p.Port,
-			TargetPort: intstr.FromInt32(p.TargetPort),
+			TargetPort: p.TargetPort,
 			NodePort:   p.NodePort,
-		})
+		}
+		if p.AppProtocol != "" {
+			port.AppProtocol = &p.AppProtocol
+		}
+		// Zero value means the same as port
+		if p.TargetPort.Type == intstr.Int && p.TargetPort.IntVal == 0 {
+			port.TargetPort = intstr.FromInt32(p.Port)
+		}
+		res = append(res, port)
 	}
 
 	return res
 }
 
 func ServiceConvertReq(svc *corev1.Service) req.Service {
+	var timeout int32
+	if cfg := svc.Spec.SessionAffinityConfig; cfg != nil && cfg.ClientIP != nil && cfg.ClientIP.TimeoutSeconds != nil {
+		timeout = *cfg.ClientIP.TimeoutSeconds
+	}
+
 	return req.Service{
-		Name:      svc.Name,
-		Namespace: svc.Namespace,
-		Labels:    utils.ReqMapToItem(svc.Labels),
-		Type:      svc.Spec.Type,
-		Selector:  utils.ReqMapToItem(svc.Spec.Selector),
-		Ports:     getReqServicePorts(svc.Spec.Ports),
+		Name:                     svc.Name,
+		Namespace:                svc.Namespace,
+		ResourceVersion:          svc.ResourceVersion,
+		Labels:                   utils.ReqMapToItem(svc.Labels),
+		Type:                     svc.Spec.Type,
+		Headless:                 svc.Spec.ClusterIP == corev1.ClusterIPNone,
+		ExternalName:             svc.Spec.ExternalName,
+		Selector:                 utils.ReqMapToItem(svc.Spec.Selector),
+		Ports:                    getReqServicePorts(svc.Spec.Ports),
+		ExternalIPs:              svc.Spec.ExternalIPs,
+		SessionAffinity:          svc.Spec.SessionAffinity,
+		SessionAffinityTimeout:   timeout,
+		ExternalTrafficPolicy:    svc.Spec.ExternalTrafficPolicy,
+		LoadBalancerSourceRanges: svc.Spec.LoadBalancerSourceRanges,
 	}
 }
 
 func getReqServicePorts(ports []corev1.ServicePort) []req.ServicePort {
 	res := make([]req.ServicePort, 0, len(ports))
 	for _, p := range ports {
+		var appProtocol string
+		if p.AppProtocol != nil {
+			appProtocol = *p.AppProtocol
+		}
 		res = append(res, req.ServicePort{
-			Name:       p.Name,
-			Port:       p.Port,
-			TargetPort: p.TargetPort.IntVal,
-			NodePort:   p.NodePort,
+			Name:        p.Name,
+			Protocol:    p.Protocol,
+			AppProtocol: appProtocol,
+			Port:        p.Port,
+			TargetPort:  p.TargetPort,
+			NodePort:    p.NodePort,
 		})
 	}
 
@@ -66,11 +115,12 @@ func getReqServicePorts(ports []corev1.ServicePort) []req.ServicePort {
 
 func ServiceConvertResp(svc *corev1.Service) resp.Service {
 	return resp.Service{
-		Name:       svc.Name,
-		Namespace:  svc.Namespace,
-		Type:       svc.Spec.Type,
-		ClusterIP:  svc.Spec.ClusterIP,
-		ExternalIP: svc.Spec.ExternalIPs,
-		Age:        svc.CreationTimestamp.Unix(),
+		Name:         svc.Name,
+		Namespace:    svc.Namespace,
+		Type:         svc.Spec.Type,
+		ClusterIP:    svc.Spec.ClusterIP,
+		ExternalIP:   svc.Spec.ExternalIPs,
+		ExternalName: svc.Spec.ExternalName,
+		Age:          svc.CreationTimestamp.Unix(),
 	}
 }
@@ -1,9 +1,15 @@
 package convert
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
 	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
@@ -17,7 +23,7 @@ func StatefulSetReqConvert(req *req.StatefulSet) *appsv1.StatefulSet {
 	for _, i := range req.VolumeClaimTemplates {
 		vct = append(vct, *PVCReqConvert(&i))
 	}
-	return &appsv1.StatefulSet{
+	stateful := &appsv1.StatefulSet{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      req.Name,
 			Namespace: req.Namespace,
@@ -34,8 +40,33 @@ func StatefulSetReqConvert(req *req.StatefulSet) *appsv1.StatefulSet {
 			},
 			ServiceName:          req.ServiceName,
 			VolumeClaimTemplates: vct,
+			PodManagementPolicy:  req.PodManagementPolicy,
+			UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+				Type: req.UpdateStrategy.Type,
+			},
 		},
 	}
+	if req.UpdateStrategy.Type != appsv1.OnDeleteStatefulSetStrategyType {
+		rolling := &appsv1.RollingUpdateStatefulSetStrategy{}
+		if req.UpdateStrategy.Partition != 0 {
+			rolling.Partition = &req.UpdateStrategy.Partition
+		}
+		if req.UpdateStrategy.MaxUnavailable != "" {
+			maxUnavailable := intstr.Parse(req.UpdateStrategy.MaxUnavailable)
+			rolling.MaxUnavailable = &maxUnavailable
+		}
+		if rolling.Partition != nil || rolling.MaxUnavailable != nil {
+			stateful.Spec.UpdateStrategy.RollingUpdate = rolling
+		}
+	}
+	if req.PVCRetentionPolicy != nil {
+		stateful.Spec.PersistentVolumeClaimRetentionPolicy = &appsv1.StatefulSetPersistentVolumeClaimRetentionPolicy{
+			WhenDeleted: req.PVCRetentionPolicy.WhenDeleted,
+			WhenScaled:  req.PVCRetentionPolicy.WhenScaled,
+		}
+	}
+
+	return stateful
 }
 
 func StatefulSetConvertReq(state *appsv1.StatefulSet) req.StatefulSet {
@@ -46,27 +77,52 @@ func StatefulSetConvertReq(state *appsv1.StatefulSet) req.StatefulSet {
 
 	vct := make([]req.PersistentVolumeClaim, 0, len(state.Spec.VolumeClaimTemplates))
 	for _, i := range state.Spec.VolumeClaimTemplates {
-		vct = append(vct, req.PersistentVolumeClaim{
-			Name:             i.Name,
-			AccessModes:      i.Spec.AccessModes,
-			Capacity:         i.Spec.Resources.Requests.Storage().String(),
-			StorageClassName: *i.Spec.StorageClassName,
-		})
+		claim := req.PersistentVolumeClaim{
+			Name:        i.Name,
+			AccessModes: i.Spec.AccessModes,
+			Capacity:    i.Spec.Resources.Requests.Storage().String(),
+		}
+		// left out the claims use the default StorageClass
+		if i.Spec.StorageClassName != nil {
+			claim.StorageClassName = *i.Spec.StorageClassName
+		}
+		vct = append(vct, claim)
 	}
 
-	return req.StatefulSet{
-		Name:      state.Name,
-		Namespace: state.Namespace,
-		Labels:    utils.ReqMapToItem(state.Labels),
-		Replicas:  replicas,
-		Selector:  utils.ReqMapToItem(state.Spec.Selector.MatchLabels),
+	res := req.StatefulSet{
+		Name:            state.Name,
+		Namespace:       state.Namespace,
+		ResourceVersion: state.ResourceVersion,
+		Labels:          utils.ReqMapToItem(state.Labels),
+		Replicas:        replicas,
+		Selector:        utils.ReqMapToItem(state.Spec.Selector.MatchLabels),
 		Template: *PodConvertReq(&corev1.Pod{
 			ObjectMeta: state.Spec.Template.ObjectMeta,
 			Spec:       state.Spec.Template.Spec,
 		}),
 		VolumeClaimTemplates: vct,
 		ServiceName:          state.Spec.ServiceName,
+		PodManagementPolicy:  state.Spec.PodManagementPolicy,
+		UpdateStrategy: req.StatefulSetUpdateStrategy{
+			Type: state.Spec.UpdateStrategy.Type,
+		},
+	}
+	if rolling := state.Spec.UpdateStrategy.RollingUpdate; rolling != nil {
+		if rolling.Partition != nil {
+			res.UpdateStrategy.Partition = *rolling.Partition
+		}
+		if rolling.MaxUnavailable != nil {
+			res.UpdateStrategy.MaxUnavailable = rolling.MaxUnavailable.String()
+		}
 	}
+	if policy := state.Spec.PersistentVolumeClaimRetentionPolicy; policy != nil {
+		res.PVCRetentionPolicy = &req.StatefulSetPVCRetentionPolicy{
+			WhenDeleted: policy.WhenDeleted,
+			WhenScaled:  policy.WhenScaled,
+		}
+	}
+
+	return res
 }
 
 func StatefulSetConvertResp(state *appsv1.StatefulSet) resp.StatefulSet {
@@ -75,6 +131,147 @@ func StatefulSetConvertResp(state *appsv1.StatefulSet) resp.StatefulSet {
 		Namespace: state.Namespace,
 		Ready:     state.Status.ReadyReplicas,
 		Replicas:  state.Status.Replicas,
+		Updated:   state.Status.UpdatedReplicas,
 		Age:       state.CreationTimestamp.Unix(),
 	}
 }
+
+// StatefulSetOrdinal reads the ordinal from a name of the form <prefix>-<ordinal>.
+func StatefulSetOrdinal(prefix string, name string) (int32, bool) {
+	suffix, ok := strings.CutPrefix(name, prefix+"-")
+	if !ok || suffix == "" {
+		return 0, false
+	}
+	ordinal, err := strconv.ParseInt(suffix, 10, 32)
+	if err != nil || ordinal < 0 || strconv.FormatInt(ordinal, 10) != suffix {
+		return 0, false
+	}
+
+	return int32(ordinal), true
+}
+
+// StatefulSetClaim reports the volumeClaimTemplates entry and the ordinal a PVC was created for,
+// the controller names them <template>-<statefulset>-<ordinal>.
+func StatefulSetClaim(state *appsv1.StatefulSet, name string) (string, int32, bool) {
+	for _, t := range state.Spec.VolumeClaimTemplates {
+		if ordinal, ok := StatefulSetOrdinal(t.Name+"-"+state.Name, name); ok {
+			return t.Name, ordinal, true
+		}
+	}
+
+	return "", 0, false
+}
+
+// statefulSetOrdinals returns the ordinals the StatefulSet keeps pods for, [start, start+replicas).
+func statefulSetOrdinals(state *appsv1.StatefulSet) (int32, int32) {
+	var start, replicas int32 = 0, 1
+	if state.Spec.Ordinals != nil {
+		start = state.Spec.Ordinals.Start
+	}
+	if state.Spec.Replicas != nil {
+		replicas = *state.Spec.Replicas
+	}
+
+	return start, start + replicas
+}
+
+func StatefulSetRolloutConvertResp(state *appsv1.StatefulSet, pods []corev1.Pod) resp.StatefulSetRollout {
+	res := resp.StatefulSetRollout{
+		CurrentRevision: state.Status.CurrentRevision,
+		UpdateRevision:  state.Status.UpdateRevision,
+		Replicas:        state.Status.Replicas,
+		Updated:         state.Status.UpdatedReplicas,
+	}
+	if rolling := state.Spec.UpdateStrategy.RollingUpdate; rolling != nil && rolling.Partition != nil {
+		res.Partition = *rolling.Partition
+	}
+
+	byOrdinal := make(map[int32]*corev1.Pod, len(pods))
+	for i := range pods {
+		if ordinal, ok := StatefulSetOrdinal(state.Name, pods[i].Name); ok {
+			byOrdinal[ordinal] = &pods[i]
+		}
+	}
+	// the expected ordinals come first so that missing pods show up, leftovers of a scale down follow
+	start, end := statefulSetOrdinals(state)
+	for ordinal := start; ordinal < end; ordinal++ {
+		if _, ok := byOrdinal[ordinal]; !ok {
+			res.Pods = append(res.Pods, resp.StatefulSetPod{
+				Ordinal: ordinal,
+				Name:    fmt.Sprintf("%s-%d", state.Name, ordinal),
+				Phase:   "Missing",
+			})
+		}
+	}
+	for ordinal, pod := range byOrdinal {
+		p := resp.StatefulSetPod{
+			Ordinal:  ordinal,
+			Name:     pod.Name,
+			Phase:    string(pod.Status.Phase),
+			Revision: pod.Labels[appsv1.StatefulSetRevisionLabel],
+			Node:     pod.Spec.NodeName,
+		}
+		p.Updated = p.Revision != "" && p.Revision == state.Status.UpdateRevision
+		for _, c := range pod.Status.Conditions {
+			if c.Type == corev1.PodReady {
+				p.Ready = c.Status == corev1.ConditionTrue
+			}
+		}
+		for _, c := range pod.Status.ContainerStatuses {
+			p.Restarts += c.RestartCount
+		}
+		res.Pods = append(res.Pods, p)
+	}
+	sort.Slice(res.Pods, func(i, j int) bool {
+		return res.Pods[i].Ordinal < res.Pods[j].Ordinal
+	})
+
+	return res
+}
+
+// StatefulSetPVCConvertResp describes the claims created from the volumeClaimTemplates. A claim is orphaned
+// when its ordinal is outside the replicas and no pod mounts it, scaling down keeps the claims by default.
+func StatefulSetPVCConvertResp(state *appsv1.StatefulSet, pvcs []corev1.PersistentVolumeClaim, pods []corev1.Pod) []resp.StatefulSetPVC {
+	inUse := make(map[string]struct{})
+	for _, pod := range pods {
+		for _, v := range pod.Spec.Volumes {
+			if v.PersistentVolumeClaim != nil {
+				inUse[v.PersistentVolumeClaim.ClaimName] = struct{}{}
+			}
+		}
+	}
+
+	start, end := statefulSetOrdinals(state)
+	res := make([]resp.StatefulSetPVC, 0, len(pvcs))
+	for _, pvc := range pvcs {
+		template, ordinal, ok := StatefulSetClaim(state, pvc.Name)
+		if !ok {
+			continue
+		}
+		_, used := inUse[pvc.Name]
+		claim := resp.StatefulSetPVC{
+			Name:     pvc.Name,
+			Template: template,
+			Ordinal:  ordinal,
+			Status:   pvc.Status.Phase,
+			Capacity: pvc.Spec.Resources.Requests.Storage().String(),
+			Orphaned: (ordinal < start || ordinal >= end) && !used,
+			Age:      pvc.CreationTimestamp.Unix(),
+		}
+		if capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]; ok {
+			claim.Capacity = capacity.String()
+		}
+		if pvc.Spec.StorageClassName != nil {
+			claim.StorageClass = *pvc.Spec.StorageClassName
+		}
+		res = append(res, claim)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Ordinal != res[j].Ordinal {
+			return res[i].Ordinal < res[j].Ordinal
+		}
+		return res[i].Template < res[j].Template
+	})
+
+	return res
+}
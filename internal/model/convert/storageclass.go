@@ -1,6 +1,7 @@
 package convert
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -9,12 +10,18 @@ import (
 	"github.com/crazyfrankie/kube-ctl/pkg/utils"
 )
 
+const (
+	// DefaultStorageClassAnnotation marks the class of the claims that name none
+	DefaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+	// BetaDefaultStorageClassAnnotation is the old name of it, still honored by the admission plugin
+	BetaDefaultStorageClassAnnotation = "storageclass.beta.kubernetes.io/is-default-class"
+)
+
 func StorageClassReqConvert(req *req.StorageClass) *storagev1.StorageClass {
 	return &storagev1.StorageClass{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      req.Name,
-			Namespace: req.Namespace,
-			Labels:    utils.ReqItemToMap(req.Labels),
+			Name:   req.Name,
+			Labels: utils.ReqItemToMap(req.Labels),
 		},
 		Provisioner:          req.Provisioner,
 		MountOptions:         req.MountOptions,
@@ -25,6 +32,20 @@ func StorageClassReqConvert(req *req.StorageClass) *storagev1.StorageClass {
 	}
 }
 
+func StorageClassConvertReq(sc *storagev1.StorageClass) req.StorageClass {
+	return req.StorageClass{
+		Name:                 sc.Name,
+		ResourceVersion:      sc.ResourceVersion,
+		Labels:               utils.ReqMapToItem(sc.Labels),
+		Provisioner:          sc.Provisioner,
+		Parameters:           utils.ReqMapToItem(sc.Parameters),
+		ReclaimPolicy:        storageClassReclaimPolicy(sc),
+		MountOptions:         sc.MountOptions,
+		AllowVolumeExpansion: sc.AllowVolumeExpansion != nil && *sc.AllowVolumeExpansion,
+		VolumeBindingMode:    storageClassBindingMode(sc),
+	}
+}
+
 func StorageClassConvertResp(sc *storagev1.StorageClass) resp.StorageClass {
 	return resp.StorageClass{
 		Name:                 sc.Name,
@@ -32,9 +53,32 @@ func StorageClassConvertResp(sc *storagev1.StorageClass) resp.StorageClass {
 		MountOptions:         sc.MountOptions,
 		Parameters:           utils.ResMapToItem(sc.Parameters),
 		Provisioner:          sc.Provisioner,
-		ReclaimPolicy:        *sc.ReclaimPolicy,
-		VolumeBindingMode:    *sc.VolumeBindingMode,
-		AllowVolumeExpansion: *sc.AllowVolumeExpansion,
+		ReclaimPolicy:        storageClassReclaimPolicy(sc),
+		VolumeBindingMode:    storageClassBindingMode(sc),
+		AllowVolumeExpansion: sc.AllowVolumeExpansion != nil && *sc.AllowVolumeExpansion,
+		IsDefault:            IsDefaultStorageClass(sc),
 		Age:                  sc.CreationTimestamp.Unix(),
 	}
 }
+
+func IsDefaultStorageClass(sc *storagev1.StorageClass) bool {
+	return sc.Annotations[DefaultStorageClassAnnotation] == "true" || sc.Annotations[BetaDefaultStorageClassAnnotation] == "true"
+}
+
+// storageClassReclaimPolicy and storageClassBindingMode fall back to the defaults of the api server,
+// classes created before the fields existed have none.
+func storageClassReclaimPolicy(sc *storagev1.StorageClass) corev1.PersistentVolumeReclaimPolicy {
+	if sc.ReclaimPolicy == nil {
+		return corev1.PersistentVolumeReclaimDelete
+	}
+
+	return *sc.ReclaimPolicy
+}
+
+func storageClassBindingMode(sc *storagev1.StorageClass) storagev1.VolumeBindingMode {
+	if sc.VolumeBindingMode == nil {
+		return storagev1.VolumeBindingImmediate
+	}
+
+	return *sc.VolumeBindingMode
+}
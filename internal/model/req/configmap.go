@@ -1,8 +1,15 @@
 package req
 
 type ConfigMap struct {
-	Name      string `json:"name"`
-	Namespace string `json:"namespace"`
-	Labels    []Item `json:"labels"`
-	Data      []Item `json:"data"`
+	Name            string `json:"name"`
+	Namespace       string `json:"namespace"`
+	ResourceVersion string `json:"resourceVersion"` // version the edit is based on, empty skips the conflict check
+	Labels          []Item `json:"labels"`
+	Data            []Item `json:"data"`
+	BinaryData      []Item `json:"binaryData"` // values are base64 encoded, sets these keys and keeps the other existing binary keys
+	Immutable       bool   `json:"immutable"`  // cannot be reverted, the data can no longer change
+	// RemoveBinaryKeys drops existing binary keys, the detail never returns their content so leaving one out keeps it
+	RemoveBinaryKeys []string `json:"removeBinaryKeys"`
+	// RestartConsumers rolls out the Deployments, StatefulSets and DaemonSets using it after saving
+	RestartConsumers bool `json:"restartConsumers"`
 }
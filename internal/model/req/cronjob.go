@@ -5,8 +5,10 @@ import batchv1 "k8s.io/api/batch/v1"
 type CronJob struct {
 	Name                       string                    `json:"name"`
 	Namespace                  string                    `json:"namespace"`
+	ResourceVersion            string                    `json:"resourceVersion"` // version the edit is based on, empty skips the conflict check
 	Labels                     []Item                    `json:"labels"`
 	Schedule                   string                    `json:"schedule"`          // cron 表达式
+	TimeZone                   string                    `json:"timeZone"`          // IANA 时区名，如 Asia/Shanghai，为空时使用控制器所在时区
 	Suspend                    bool                      `json:"suspend"`           // 是否暂停 cronjob
 	ConcurrencyPolicy          batchv1.ConcurrencyPolicy `json:"concurrencyPolicy"` // 并发策略
 	SuccessfulJobsHistoryLimit int32                     `json:"successfulJobsHistoryLimit"`
@@ -1,9 +1,22 @@
 package req
 
+import appsv1 "k8s.io/api/apps/v1"
+
 type DaemonSet struct {
-	Name      string `json:"name"`
-	Namespace string `json:"namespace"`
-	Labels    []Item `json:"labels"`
-	Selector  []Item `json:"selector"`
-	Template  Pod    `json:"template"`
+	Name            string                  `json:"name"`
+	Namespace       string                  `json:"namespace"`
+	ResourceVersion string                  `json:"resourceVersion"` // version the edit is based on, empty skips the conflict check
+	Labels          []Item                  `json:"labels"`
+	Selector        []Item                  `json:"selector"`
+	Template        Pod                     `json:"template"`
+	UpdateStrategy  DaemonSetUpdateStrategy `json:"updateStrategy"`
+	MinReadySeconds int32                   `json:"minReadySeconds"` // 新 Pod 就绪后需保持的秒数才视为可用
+}
+
+type DaemonSetUpdateStrategy struct {
+	Type appsv1.DaemonSetUpdateStrategyType `json:"type"` // RollingUpdate(默认) | OnDelete
+	// MaxUnavailable 滚动更新时最多不可用的节点数或百分比，为空时为 1
+	MaxUnavailable string `json:"maxUnavailable"`
+	// MaxSurge 滚动更新时先在节点上启动新 Pod 再删除旧 Pod 的节点数或百分比，适合不能中断的 agent
+	MaxSurge string `json:"maxSurge"`
 }
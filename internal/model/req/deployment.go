@@ -1,10 +1,14 @@
 package req
 
 type Deployment struct {
-	Name      string `json:"name"`
-	Namespace string `json:"namespace"`
-	Labels    []Item `json:"labels"`
-	Replicas  int32  `json:"replicas"`
-	Selector  []Item `json:"selector"`
-	Template  Pod    `json:"template"`
+	Name            string         `json:"name"`
+	Namespace       string         `json:"namespace"`
+	ResourceVersion string         `json:"resourceVersion"` // version the edit is based on, empty skips the conflict check
+	Labels          []Item         `json:"labels"`
+	Replicas        int32          `json:"replicas"`
+	Selector        []Item         `json:"selector"`
+	Template        Pod            `json:"template"`
+	Autoscaler      *AutoscalerRef `json:"autoscaler,omitempty"` // HPA scaling the workload, ignored on write
+	// DisruptionBudget sums up the PDBs protecting the pods, ignored on write
+	DisruptionBudget *DisruptionBudgetRef `json:"disruptionBudget,omitempty"`
 }
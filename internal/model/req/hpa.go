@@ -0,0 +1,41 @@
+package req
+
+import autoscalingv2 "k8s.io/api/autoscaling/v2"
+
+type HPA struct {
+	Name            string `json:"name"`
+	Namespace       string `json:"namespace"`
+	ResourceVersion string `json:"resourceVersion"` // version the edit is based on, empty skips the conflict check
+	Labels          []Item `json:"labels"`
+	TargetKind      string `json:"targetKind"` // Deployment | StatefulSet
+	TargetName      string `json:"targetName"`
+	MinReplicas     int32  `json:"minReplicas"`
+	MaxReplicas     int32  `json:"maxReplicas"`
+	// Metrics defaults to 80% CPU utilization like the API server when empty
+	Metrics []HPAMetric `json:"metrics"`
+	// Behavior tunes how fast it scales up and down, nil keeps the defaults
+	Behavior *autoscalingv2.HorizontalPodAutoscalerBehavior `json:"behavior"`
+}
+
+type HPAMetric struct {
+	Type         autoscalingv2.MetricSourceType `json:"type"`         // Resource | ContainerResource | Pods | Object | External
+	ResourceName string                         `json:"resourceName"` // cpu | memory, only for Resource | ContainerResource
+	Container    string                         `json:"container"`    // container whose usage counts, only for ContainerResource
+	MetricName   string                         `json:"metricName"`   // custom or external metric, for Pods | Object | External
+	Selector     []Item                         `json:"selector"`     // labels narrowing the metric series
+	// DescribedObject is the object the metric describes, only for Object
+	DescribedObject autoscalingv2.CrossVersionObjectReference `json:"describedObject"`
+	TargetType      autoscalingv2.MetricTargetType            `json:"targetType"`  // Utilization | AverageValue | Value
+	Utilization     int32                                     `json:"utilization"` // percentage of the requests, for Utilization
+	Value           string                                    `json:"value"`       // quantity such as 500m or 1k, for AverageValue | Value
+}
+
+// AutoscalerRef links a workload to the HPA that scales it, it is read only.
+type AutoscalerRef struct {
+	Name            string `json:"name"`
+	MinReplicas     int32  `json:"minReplicas"`
+	MaxReplicas     int32  `json:"maxReplicas"`
+	CurrentReplicas int32  `json:"currentReplicas"`
+	DesiredReplicas int32  `json:"desiredReplicas"`
+	Active          bool   `json:"active"` // false when the HPA can not compute a scale, e.g. metrics are missing
+}
@@ -5,13 +5,23 @@ import (
 )
 
 type Ingress struct {
-	Name      string        `json:"name"`
-	Namespace string        `json:"namespace"`
-	Labels    []Item        `json:"labels"`
-	Rules     []IngressRule `json:"rules"`
+	Name             string                       `json:"name"`
+	Namespace        string                       `json:"namespace"`
+	ResourceVersion  string                       `json:"resourceVersion"` // version the edit is based on, empty skips the conflict check
+	Labels           []Item                       `json:"labels"`
+	Annotations      []Item                       `json:"annotations"`      // most controllers are configured via annotations
+	IngressClassName string                       `json:"ingressClassName"` // "" means the cluster default class
+	DefaultBackend   *networkingv1.IngressBackend `json:"defaultBackend"`   // requests that match no rule
+	TLS              []IngressTLS                 `json:"tls"`
+	Rules            []IngressRule                `json:"rules"`
 }
 
 type IngressRule struct {
 	Host  string                        `json:"host"`
 	Value networkingv1.IngressRuleValue `json:"value"`
 }
+
+type IngressTLS struct {
+	Hosts      []string `json:"hosts"`
+	SecretName string   `json:"secretName"` // kubernetes.io/tls secret in the same namespace
+}
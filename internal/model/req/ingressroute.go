@@ -28,6 +28,7 @@ type Middleware struct {
 type IngressRoute struct {
 	Name             string `json:"name"`
 	Namespace        string `json:"namespace"`
+	ResourceVersion  string `json:"resourceVersion"` // version the edit is based on, empty skips the conflict check
 	Labels           []Item `json:"labels"`
 	IngressRouteSpec `json:"ingressRouteSpec"`
 }
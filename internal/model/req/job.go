@@ -1,9 +1,22 @@
 package req
 
+import batchv1 "k8s.io/api/batch/v1"
+
 type Job struct {
-	Name        string `json:"name"`
-	Namespace   string `json:"namespace"`
-	Labels      []Item `json:"labels"`
-	Completions int32  `json:"completions"` // Job 的 Pod 副本数，全部副本数运行成功，才能代表job运行成功
-	Template    Pod    `json:"template"`
+	Name            string `json:"name"`
+	Namespace       string `json:"namespace"`
+	ResourceVersion string `json:"resourceVersion"` // version the edit is based on, empty skips the conflict check
+	Labels          []Item `json:"labels"`
+	Completions     int32  `json:"completions"` // Job 的 Pod 副本数，全部副本数运行成功，才能代表job运行成功
+	Parallelism     int32  `json:"parallelism"` // 同时运行的 Pod 数，0 表示与 completions 相同
+	// BackoffLimit is the retries before the Job fails, nil keeps the default of 6
+	BackoffLimit *int32 `json:"backoffLimit"`
+	// ActiveDeadlineSeconds fails the Job once it has run that long, 0 means no deadline
+	ActiveDeadlineSeconds int64 `json:"activeDeadlineSeconds"`
+	// TTLSecondsAfterFinished deletes the finished Job after that delay, nil keeps it
+	TTLSecondsAfterFinished *int32                    `json:"ttlSecondsAfterFinished"`
+	CompletionMode          batchv1.CompletionMode    `json:"completionMode"`   // NonIndexed | Indexed, Indexed gives every pod a completion index
+	PodFailurePolicy        *batchv1.PodFailurePolicy `json:"podFailurePolicy"` // requires restartPolicy Never
+	Suspend                 bool                      `json:"suspend"`          // create the Job without starting pods
+	Template                Pod                       `json:"template"`
 }
@@ -0,0 +1,51 @@
+package req
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+type NetworkPolicy struct {
+	Name            string `json:"name"`
+	Namespace       string `json:"namespace"`
+	ResourceVersion string `json:"resourceVersion"` // version the edit is based on, empty skips the conflict check
+	Labels          []Item `json:"labels"`
+	// PodSelector picks the pods the policy applies to, empty selects every pod in the namespace
+	PodSelector metav1.LabelSelector `json:"podSelector"`
+	// PolicyTypes defaults to Ingress, plus Egress when there are egress rules
+	PolicyTypes []networkingv1.PolicyType `json:"policyTypes"`
+	Ingress     []NetworkPolicyRule       `json:"ingress"` // no rule with Ingress type denies all incoming traffic
+	Egress      []NetworkPolicyRule       `json:"egress"`  // no rule with Egress type denies all outgoing traffic
+}
+
+type NetworkPolicyRule struct {
+	Peers []NetworkPolicyPeer `json:"peers"` // from of an ingress rule, to of an egress rule; empty allows every peer
+	Ports []NetworkPolicyPort `json:"ports"` // empty allows every port
+}
+
+// NetworkPolicyPeer sets exactly one of IPBlock and the selectors.
+// PodSelector alone picks pods in the policy namespace, NamespaceSelector alone every pod of the
+// matching namespaces, both together the matching pods in the matching namespaces.
+type NetworkPolicyPeer struct {
+	PodSelector       *metav1.LabelSelector `json:"podSelector"`
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector"` // {} matches every namespace
+	IPBlock           *networkingv1.IPBlock `json:"ipBlock"`
+}
+
+type NetworkPolicyPort struct {
+	Protocol corev1.Protocol     `json:"protocol"` // TCP | UDP | SCTP, defaults to TCP
+	Port     *intstr.IntOrString `json:"port"`     // number or named container port, nil matches every port
+	EndPort  int32               `json:"endPort"`  // makes Port..EndPort a range, Port must be a number
+}
+
+// Reachability asks whether the source pod can open a connection to the destination pod port.
+type Reachability struct {
+	SourceNamespace      string          `json:"sourceNamespace"`
+	SourcePod            string          `json:"sourcePod"`
+	DestinationNamespace string          `json:"destinationNamespace"`
+	DestinationPod       string          `json:"destinationPod"`
+	Port                 string          `json:"port"`     // number or named container port of the destination
+	Protocol             corev1.Protocol `json:"protocol"` // defaults to TCP
+}
@@ -3,11 +3,13 @@ package req
 import corev1 "k8s.io/api/core/v1"
 
 type UpdateLabelReq struct {
-	Name   string `json:"name"`
-	Labels []Item `json:"labels"`
+	Name            string `json:"name"`
+	ResourceVersion string `json:"resourceVersion"` // version the edit is based on, empty skips the conflict check
+	Labels          []Item `json:"labels"`
 }
 
 type UpdateTaintReq struct {
-	Name   string         `json:"name"`
-	Taints []corev1.Taint `json:"taints"`
+	Name            string         `json:"name"`
+	ResourceVersion string         `json:"resourceVersion"` // version the edit is based on, empty skips the conflict check
+	Taints          []corev1.Taint `json:"taints"`
 }
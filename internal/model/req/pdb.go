@@ -0,0 +1,23 @@
+package req
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+type PDB struct {
+	Name            string `json:"name"`
+	Namespace       string `json:"namespace"`
+	ResourceVersion string `json:"resourceVersion"` // version the edit is based on, empty skips the conflict check
+	Labels          []Item `json:"labels"`
+	// Selector picks the protected pods, matchLabels and matchExpressions both count
+	Selector metav1.LabelSelector `json:"selector"`
+	// exactly one of MinAvailable and MaxUnavailable, as a number such as 2 or a percentage such as 50%
+	MinAvailable   string `json:"minAvailable"`
+	MaxUnavailable string `json:"maxUnavailable"`
+}
+
+// DisruptionBudgetRef tells how the PDBs selecting a workload's pods limit voluntary disruptions, it is read only.
+type DisruptionBudgetRef struct {
+	Status             string   `json:"status"` // None | Blocking | Allowed
+	Message            string   `json:"message"`
+	Budgets            []string `json:"budgets"`
+	DisruptionsAllowed int32    `json:"disruptionsAllowed"` // the smallest budget left among the PDBs
+}
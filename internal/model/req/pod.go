@@ -3,20 +3,45 @@ package req
 import corev1 "k8s.io/api/core/v1"
 
 type Pod struct {
-	Base           Base                `json:"base"` // base definition info
-	Volume         []Volume            `json:"volume"`
-	Network        Network             `json:"network"`
-	InitContainers []Container         `json:"initContainers"`
-	Containers     []Container         `json:"containers"`
-	Tolerations    []corev1.Toleration `json:"tolerations"` // pod toleration params
-	NodeScheduling NodeScheduling      `json:"nodeScheduling"`
+	Base                      Base                 `json:"base"`            // base definition info
+	ResourceVersion           string               `json:"resourceVersion"` // version the edit is based on, empty skips the conflict check
+	Volume                    []Volume             `json:"volume"`
+	Network                   Network              `json:"network"`
+	SecurityContext           PodSecurityContext   `json:"securityContext"`
+	InitContainers            []Container          `json:"initContainers"`
+	Containers                []Container          `json:"containers"`
+	Tolerations               []corev1.Toleration  `json:"tolerations"` // pod toleration params
+	NodeScheduling            NodeScheduling       `json:"nodeScheduling"`
+	PodAffinity               PodAffinity          `json:"podAffinity"`
+	TopologySpreadConstraints []TopologySpreadRule `json:"topologySpreadConstraints"` // spread pods over zones, nodes...
 }
 
 type Base struct {
-	Name          string `json:"name"`
-	Labels        []Item `json:"labels"`
-	Namespace     string `json:"namespace"`
-	RestartPolicy string `json:"restartPolicy"` // reboot strategy: Always | Never | On-Failure
+	Name               string   `json:"name"`
+	Labels             []Item   `json:"labels"`
+	Namespace          string   `json:"namespace"`
+	RestartPolicy      string   `json:"restartPolicy"` // reboot strategy: Always | Never | On-Failure
+	ServiceAccountName string   `json:"serviceAccountName"`
+	ImagePullSecrets   []string `json:"imagePullSecrets"` // secret names of private registries
+	PriorityClassName  string   `json:"priorityClassName"`
+	// TerminationGracePeriodSeconds is how long the containers have to stop after SIGTERM, nil means 30s
+	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds"`
+}
+
+type PodSecurityContext struct {
+	RunAsUser           *int64         `json:"runAsUser"`
+	RunAsGroup          *int64         `json:"runAsGroup"`
+	RunAsNonRoot        bool           `json:"runAsNonRoot"`
+	FSGroup             *int64         `json:"fsGroup"`             // group owning the volumes
+	FSGroupChangePolicy string         `json:"fsGroupChangePolicy"` // OnRootMismatch | Always
+	SupplementalGroups  []int64        `json:"supplementalGroups"`
+	SeccompProfile      SeccompProfile `json:"seccompProfile"`
+	Sysctls             []Item         `json:"sysctls"`
+}
+
+type SeccompProfile struct {
+	Type             string `json:"type"`             // RuntimeDefault | Localhost | Unconfined, empty for none
+	LocalhostProfile string `json:"localhostProfile"` // profile path on the node, only for Localhost
 }
 
 type Item struct {
@@ -26,12 +51,14 @@ type Item struct {
 
 type Volume struct {
 	Name               string             `json:"name"`
-	Type               string             `json:"type"` // emptyDir | configMap | secret | hostPath | downward | pvc
+	Type               string             `json:"type"` // emptyDir | configMap | secret | hostPath | downward | pvc | projected | ephemeral
 	ConfigMapRefVolume ConfigMapRefVolume `json:"configMapRefVolume"`
 	SecretRefVolume    SecretRefVolume    `json:"secretRefVolume"`
 	HostPathVolume     HostPathVolume     `json:"hostPathVolume"`
 	DownwardAPIVolume  DownwardAPIVolume  `json:"downwardAPIVolume"`
 	PVCVolume          PVCVolume          `json:"PVCVolume"`
+	ProjectedVolume    ProjectedVolume    `json:"projectedVolume"`
+	EphemeralVolume    EphemeralVolume    `json:"ephemeralVolume"`
 }
 
 type ConfigMapRefVolume struct {
@@ -62,6 +89,34 @@ type PVCVolume struct {
 	ClaimName string `json:"claimName"`
 }
 
+// ProjectedVolume mounts several sources into one directory.
+type ProjectedVolume struct {
+	Sources []ProjectedSource `json:"sources"`
+}
+
+type ProjectedSource struct {
+	Type     string `json:"type"` // configMap | secret | downwardAPI | serviceAccountToken
+	Name     string `json:"name"` // configMap or secret name
+	Optional bool   `json:"optional"`
+	// Items maps keys of the configMap or secret to file paths, empty projects every key
+	Items               []Item                  `json:"items"`
+	DownwardAPI         []DownwardAPIVolumeItem `json:"downwardAPI"`
+	ServiceAccountToken ServiceAccountToken     `json:"serviceAccountToken"`
+}
+
+type ServiceAccountToken struct {
+	Path              string `json:"path"`
+	Audience          string `json:"audience"`          // empty means the api server
+	ExpirationSeconds int64  `json:"expirationSeconds"` // at least 600, 0 means 1h
+}
+
+// EphemeralVolume is a claim created with the pod and deleted with it.
+type EphemeralVolume struct {
+	StorageClassName string                              `json:"storageClassName"` // empty uses the default StorageClass
+	Capacity         string                              `json:"capacity"`
+	AccessModes      []corev1.PersistentVolumeAccessMode `json:"accessModes"`
+}
+
 type Network struct {
 	HostNetwork bool      `json:"hostNetwork"`
 	HostName    string    `json:"hostName"`
@@ -75,22 +130,53 @@ type DnsConfig struct {
 }
 
 type Container struct {
-	Name            string               `json:"name"`
-	Image           string               `json:"image"`
-	ImagePullPolicy string               `json:"imagePullPolicy"` // Always | IfNotPresent | Never
-	Tty             bool                 `json:"tty"`
-	Ports           []ContainerPort      `json:"ports"`
-	WorkingDir      string               `json:"workingDir"`
-	Command         []string             `json:"command"`
-	Args            []string             `json:"args"`
-	Env             []EnvVar             `json:"env"`
-	EnvsFrom        []EnvVarFromResource `json:"envsFrom"`
-	Privileged      bool                 `json:"privileged"`    // Whether to enable privileged mode (e.g. root)
-	Resources       Resource             `json:"resources"`     // Container application quota
-	VolumeMounts    []VolumeMount        `json:"volumeMounts"`  // Mounted volumes
-	StartUpProbe    ContainerProbe       `json:"startUpProbe"`  // Start the probe
-	LivenessProbe   ContainerProbe       `json:"livenessProbe"` // Survival probes
-	ReadinessProbe  ContainerProbe       `json:"readyProbe"`    // Readiness Probe
+	Name            string                `json:"name"`
+	Image           string                `json:"image"`
+	ImagePullPolicy string                `json:"imagePullPolicy"` // Always | IfNotPresent | Never
+	Tty             bool                  `json:"tty"`
+	Ports           []ContainerPort       `json:"ports"`
+	WorkingDir      string                `json:"workingDir"`
+	Command         []string              `json:"command"`
+	Args            []string              `json:"args"`
+	Env             []EnvVar              `json:"env"`
+	EnvsFrom        []EnvVarFromResource  `json:"envsFrom"`
+	Privileged      bool                  `json:"privileged"` // Whether to enable privileged mode (e.g. root)
+	SecurityContext SecurityContext       `json:"securityContext"`
+	Resources       []ResourceRequirement `json:"resources"`     // Container application quota
+	VolumeMounts    []VolumeMount         `json:"volumeMounts"`  // Mounted volumes
+	StartUpProbe    ContainerProbe        `json:"startUpProbe"`  // Start the probe
+	LivenessProbe   ContainerProbe        `json:"livenessProbe"` // Survival probes
+	ReadinessProbe  ContainerProbe        `json:"readyProbe"`    // Readiness Probe
+	Lifecycle       Lifecycle             `json:"lifecycle"`
+}
+
+// SecurityContext of a container, the user and group override the ones of the pod.
+type SecurityContext struct {
+	RunAsUser                *int64         `json:"runAsUser"`
+	RunAsGroup               *int64         `json:"runAsGroup"`
+	RunAsNonRoot             bool           `json:"runAsNonRoot"`
+	ReadOnlyRootFilesystem   bool           `json:"readOnlyRootFilesystem"`
+	AllowPrivilegeEscalation *bool          `json:"allowPrivilegeEscalation"` // nil keeps the runtime default
+	Capabilities             Capabilities   `json:"capabilities"`
+	SeccompProfile           SeccompProfile `json:"seccompProfile"`
+}
+
+type Capabilities struct {
+	Add  []string `json:"add"`  // e.g. NET_BIND_SERVICE
+	Drop []string `json:"drop"` // e.g. ALL
+}
+
+type Lifecycle struct {
+	PostStart LifecycleHandler `json:"postStart"` // runs right after the container is created
+	PreStop   LifecycleHandler `json:"preStop"`   // runs before the container is sent SIGTERM
+}
+
+type LifecycleHandler struct {
+	Enable  bool         `json:"enable"`
+	Type    string       `json:"type"` // exec | http | sleep
+	HttpGet ProbeHTTPGet `json:"httpGet"`
+	Command ProbeCommand `json:"command"`
+	Sleep   int64        `json:"sleep"` // seconds
 }
 
 type ContainerPort struct {
@@ -99,12 +185,11 @@ type ContainerPort struct {
 	HostPort      int32  `json:"hostPort"`
 }
 
-type Resource struct {
-	Enable      bool  `json:"enable"` // Whether to configure container quotas
-	MemoryReq   int32 `json:"memory"` // memory mi
-	MemoryLimit int32 `json:"memoryLimit"`
-	CPUReq      int32 `json:"CPUReq"` // cpu m
-	CPULimit    int32 `json:"CPULimit"`
+// ResourceRequirement is the request and limit of one resource, either may be left empty.
+type ResourceRequirement struct {
+	Name    string `json:"name"`    // cpu | memory | ephemeral-storage | hugepages-2Mi | extended resources such as nvidia.com/gpu
+	Request string `json:"request"` // quantity such as 250m or 512Mi, empty for none
+	Limit   string `json:"limit"`
 }
 
 type VolumeMount struct {
@@ -155,6 +240,28 @@ type NodeScheduling struct {
 	NodeAffinity []NodeAffinityTermExpressions `json:"nodeAffinity"`
 }
 
+// PodAffinity places pods relative to the pods matching the terms, e.g. apart from each other with antiAffinity.
+type PodAffinity struct {
+	Affinity     []PodAffinityTerm `json:"affinity"`
+	AntiAffinity []PodAffinityTerm `json:"antiAffinity"`
+}
+
+type PodAffinityTerm struct {
+	Type          string   `json:"type"`   // required | preferred
+	Weight        int32    `json:"weight"` // 1-100, only for preferred
+	LabelSelector []Item   `json:"labelSelector"`
+	Namespaces    []string `json:"namespaces"`  // empty means the namespace of the pod
+	TopologyKey   string   `json:"topologyKey"` // e.g. kubernetes.io/hostname, topology.kubernetes.io/zone
+}
+
+type TopologySpreadRule struct {
+	MaxSkew           int32  `json:"maxSkew"`
+	TopologyKey       string `json:"topologyKey"`
+	WhenUnsatisfiable string `json:"whenUnsatisfiable"` // DoNotSchedule | ScheduleAnyway
+	LabelSelector     []Item `json:"labelSelector"`
+	MinDomains        *int32 `json:"minDomains"` // only with DoNotSchedule
+}
+
 type NodeAffinityTermExpressions struct {
 	Key      string                      `json:"key"`
 	Value    string                      `json:"value"`
@@ -163,9 +270,15 @@ type NodeAffinityTermExpressions struct {
 
 type EnvVar struct {
 	Name    string `json:"name"`
-	RefName string `json:"refName"`
-	Value   string `json:"value"`
-	Type    string `json:"type"` // configMap | secret | default(k/v)
+	RefName string `json:"refName"` // configMap or secret name
+	// Value is the value, the key of the configMap or secret, the field path such as status.podIP,
+	// or the resource such as limits.cpu
+	Value    string `json:"value"`
+	Type     string `json:"type"`     // configMap | secret | field | resource | default(k/v)
+	Optional bool   `json:"optional"` // the configMap or secret key may be missing
+	// ContainerName is the container whose resource is read, empty means this one
+	ContainerName string `json:"containerName"`
+	Divisor       string `json:"divisor"` // unit of the resource, e.g. 1m or 1Mi, empty means 1
 }
 
 type EnvVarFromResource struct {
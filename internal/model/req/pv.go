@@ -3,17 +3,30 @@ package req
 import corev1 "k8s.io/api/core/v1"
 
 type PersistentVolume struct {
-	Name          string                               `json:"name"`
-	Labels        []Item                               `json:"labels"`
-	Capacity      string                               `json:"capacity"`
-	AccessModes   []corev1.PersistentVolumeAccessMode  `json:"accessModes"`
-	ReclaimPolicy corev1.PersistentVolumeReclaimPolicy `json:"reclaimPolicy"`
-	VolumeSource  VolumeSource                         `json:"volumeSource"`
+	Name             string                               `json:"name"`
+	Labels           []Item                               `json:"labels"`
+	Capacity         string                               `json:"capacity"`
+	AccessModes      []corev1.PersistentVolumeAccessMode  `json:"accessModes"`
+	ReclaimPolicy    corev1.PersistentVolumeReclaimPolicy `json:"reclaimPolicy"`
+	StorageClassName string                               `json:"storageClassName"` // 只有同名 StorageClass 的 PVC 才能绑定，为空时绑定不指定 StorageClass 的 PVC
+	VolumeMode       corev1.PersistentVolumeMode          `json:"volumeMode"`       // Filesystem(默认) | Block
+	MountOptions     []string                             `json:"mountOptions"`
+	ClaimRef         *PVClaimRef                          `json:"claimRef,omitempty"` // 预绑定到指定 PVC，其他 PVC 无法使用
+	VolumeSource     VolumeSource                         `json:"volumeSource"`
+}
+
+type PVClaimRef struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
 }
 
 type VolumeSource struct {
-	Type string          `json:"type"`
-	NFS  NFSVolumeSource `json:"nfs"`
+	Type     string               `json:"type"` // nfs | hostPath | local | csi | iscsi
+	NFS      NFSVolumeSource      `json:"nfs"`
+	HostPath HostPathVolumeSource `json:"hostPath"`
+	Local    LocalVolumeSource    `json:"local"`
+	CSI      CSIVolumeSource      `json:"csi"`
+	ISCSI    ISCSIVolumeSource    `json:"iscsi"`
 }
 
 type NFSVolumeSource struct {
@@ -21,3 +34,47 @@ type NFSVolumeSource struct {
 	NfsServer string `json:"nfsServer"`
 	ReadOnly  bool   `json:"readOnly"`
 }
+
+type HostPathVolumeSource struct {
+	Path string              `json:"path"`
+	Type corev1.HostPathType `json:"type"` // 为空时不检查，DirectoryOrCreate | Directory | FileOrCreate | File ...
+}
+
+type LocalVolumeSource struct {
+	Path   string `json:"path"`   // 节点上的目录或块设备
+	FSType string `json:"fsType"` // 仅块设备
+	// Nodes 为本地盘所在节点的 kubernetes.io/hostname，local 卷必须限定节点
+	Nodes []string `json:"nodes"`
+}
+
+type CSIVolumeSource struct {
+	Driver                     string     `json:"driver"`
+	VolumeHandle               string     `json:"volumeHandle"` // 存储系统中卷的唯一标识
+	FSType                     string     `json:"fsType"`
+	ReadOnly                   bool       `json:"readOnly"`
+	VolumeAttributes           []Item     `json:"volumeAttributes"`
+	ControllerPublishSecretRef *SecretRef `json:"controllerPublishSecretRef,omitempty"`
+	NodeStageSecretRef         *SecretRef `json:"nodeStageSecretRef,omitempty"`
+	NodePublishSecretRef       *SecretRef `json:"nodePublishSecretRef,omitempty"`
+	ControllerExpandSecretRef  *SecretRef `json:"controllerExpandSecretRef,omitempty"`
+	NodeExpandSecretRef        *SecretRef `json:"nodeExpandSecretRef,omitempty"`
+}
+
+type ISCSIVolumeSource struct {
+	TargetPortal      string     `json:"targetPortal"` // ip:port，端口默认 3260
+	Portals           []string   `json:"portals"`      // 多路径的其他 portal
+	IQN               string     `json:"iqn"`
+	Lun               int32      `json:"lun"`
+	ISCSIInterface    string     `json:"iscsiInterface"` // 默认 default(tcp)
+	InitiatorName     string     `json:"initiatorName"`
+	FSType            string     `json:"fsType"`
+	ReadOnly          bool       `json:"readOnly"`
+	ChapAuthDiscovery bool       `json:"chapAuthDiscovery"`
+	ChapAuthSession   bool       `json:"chapAuthSession"`
+	SecretRef         *SecretRef `json:"secretRef,omitempty"` // CHAP 认证的 Secret
+}
+
+type SecretRef struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
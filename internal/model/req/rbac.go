@@ -9,18 +9,20 @@ type ServiceAccount struct {
 }
 
 type Role struct {
-	Name      string              `json:"name"`
-	Namespace string              `json:"namespace"` // Namespace == "" ? ClusterRole : Role
-	Labels    []Item              `json:"labels"`
-	Rules     []rbacv1.PolicyRule `json:"rules"`
+	Name            string              `json:"name"`
+	Namespace       string              `json:"namespace"`       // Namespace == "" ? ClusterRole : Role
+	ResourceVersion string              `json:"resourceVersion"` // version the edit is based on, empty skips the conflict check
+	Labels          []Item              `json:"labels"`
+	Rules           []rbacv1.PolicyRule `json:"rules"`
 }
 
 type RoleBinding struct {
-	Name      string    `json:"name"`
-	Namespace string    `json:"namespace"` // Namespace == "" ?  ClusterRoleBinding: RoleBinding
-	Labels    []Item    `json:"labels"`
-	RoleRef   string    `json:"roleRef"`
-	Subjects  []Subject `json:"subjects"`
+	Name            string    `json:"name"`
+	Namespace       string    `json:"namespace"`       // Namespace == "" ?  ClusterRoleBinding: RoleBinding
+	ResourceVersion string    `json:"resourceVersion"` // version the edit is based on, empty skips the conflict check
+	Labels          []Item    `json:"labels"`
+	RoleRef         string    `json:"roleRef"`
+	Subjects        []Subject `json:"subjects"`
 }
 
 type Subject struct {
@@ -3,9 +3,58 @@ package req
 import corev1 "k8s.io/api/core/v1"
 
 type Secret struct {
-	Name      string            `json:"name"`
-	Namespace string            `json:"namespace"`
-	Labels    []Item            `json:"labels"`
-	Data      []Item            `json:"data"`
-	Type      corev1.SecretType `json:"type"` // Opaque | kubernetes.io/dockerconfigjson
+	Name            string            `json:"name"`
+	Namespace       string            `json:"namespace"`
+	ResourceVersion string            `json:"resourceVersion"` // version the edit is based on, empty skips the conflict check
+	Labels          []Item            `json:"labels"`
+	Data            []Item            `json:"data"` // plain values, a masked value keeps the stored one on update
+	Type            corev1.SecretType `json:"type"` // Opaque | kubernetes.io/dockerconfigjson
+	// RestartConsumers rolls out the Deployments, StatefulSets and DaemonSets using it after saving
+	RestartConsumers bool `json:"restartConsumers"`
+}
+
+type DockerConfigSecret struct {
+	Name            string `json:"name"`
+	Namespace       string `json:"namespace"`
+	ResourceVersion string `json:"resourceVersion"` // version the edit is based on, empty skips the conflict check
+	Labels          []Item `json:"labels"`
+	Registry        string `json:"registry"` // e.g. https://index.docker.io/v1/ | harbor.example.com
+	Username        string `json:"username"`
+	Password        string `json:"password"`
+	Email           string `json:"email"`
+}
+
+// TLSSecret is bound from a multipart form, cert and key are uploaded as files.
+type TLSSecret struct {
+	Name            string `form:"name"`
+	Namespace       string `form:"namespace"`
+	ResourceVersion string `form:"resourceVersion"` // version the edit is based on, empty skips the conflict check
+	Labels          []Item `form:"-"`               // repeated labels form fields, each key=value
+	Cert            []byte `form:"-"`               // PEM encoded certificate chain
+	Key             []byte `form:"-"`               // PEM encoded private key
+}
+
+type BasicAuthSecret struct {
+	Name            string `json:"name"`
+	Namespace       string `json:"namespace"`
+	ResourceVersion string `json:"resourceVersion"` // version the edit is based on, empty skips the conflict check
+	Labels          []Item `json:"labels"`
+	Username        string `json:"username"`
+	Password        string `json:"password"`
+}
+
+type SSHAuthSecret struct {
+	Name            string `json:"name"`
+	Namespace       string `json:"namespace"`
+	ResourceVersion string `json:"resourceVersion"` // version the edit is based on, empty skips the conflict check
+	Labels          []Item `json:"labels"`
+	PrivateKey      string `json:"privateKey"` // PEM encoded
+	KnownHosts      string `json:"knownHosts"` // optional, stored as known_hosts
+}
+
+type SecretReveal struct {
+	Name      string   `json:"name"`
+	Namespace string   `json:"namespace"`
+	Keys      []string `json:"keys"`   // empty reveals every key
+	Reason    string   `json:"reason"` // recorded in the audit event
 }
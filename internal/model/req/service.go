@@ -1,19 +1,32 @@
 package req
 
-import corev1 "k8s.io/api/core/v1"
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
 
 type Service struct {
-	Name      string             `json:"name"`
-	Namespace string             `json:"namespace"`
-	Labels    []Item             `json:"labels"`
-	Type      corev1.ServiceType `json:"type"`
-	Selector  []Item             `json:"selector"`
-	Ports     []ServicePort      `json:"ports"`
+	Name                     string                              `json:"name"`
+	Namespace                string                              `json:"namespace"`
+	ResourceVersion          string                              `json:"resourceVersion"` // version the edit is based on, empty skips the conflict check
+	Labels                   []Item                              `json:"labels"`
+	Type                     corev1.ServiceType                  `json:"type"`         // ClusterIP | NodePort | LoadBalancer | ExternalName
+	Headless                 bool                                `json:"headless"`     // clusterIP: None
+	ExternalName             string                              `json:"externalName"` // DNS name, only for ExternalName
+	Selector                 []Item                              `json:"selector"`
+	Ports                    []ServicePort                       `json:"ports"`
+	ExternalIPs              []string                            `json:"externalIPs"`
+	SessionAffinity          corev1.ServiceAffinity              `json:"sessionAffinity"`        // None | ClientIP
+	SessionAffinityTimeout   int32                               `json:"sessionAffinityTimeout"` // ClientIP sticky seconds, 0 means default(10800)
+	ExternalTrafficPolicy    corev1.ServiceExternalTrafficPolicy `json:"externalTrafficPolicy"`  // Cluster | Local, only for NodePort/LoadBalancer
+	LoadBalancerSourceRanges []string                            `json:"loadBalancerSourceRanges"`
 }
 
 type ServicePort struct {
-	Name       string `json:"name"`
-	Port       int32  `json:"port"`
-	TargetPort int32  `json:"targetPort"`
-	NodePort   int32  `json:"nodePort"`
+	Name        string             `json:"name"`
+	Protocol    corev1.Protocol    `json:"protocol"` // TCP | UDP | SCTP
+	AppProtocol string             `json:"appProtocol"`
+	Port        int32              `json:"port"`
+	TargetPort  intstr.IntOrString `json:"targetPort" swaggertype:"string"` // port number or container port name
+	NodePort    int32              `json:"nodePort"`
 }
@@ -1,12 +1,32 @@
 package req
 
+import appsv1 "k8s.io/api/apps/v1"
+
 type StatefulSet struct {
-	Name                 string                  `json:"name"`
-	Namespace            string                  `json:"namespace"`
-	Labels               []Item                  `json:"labels"`
-	Replicas             int32                   `json:"replicas"`
-	Selector             []Item                  `json:"selector"`
-	Template             Pod                     `json:"template"`
-	VolumeClaimTemplates []PersistentVolumeClaim `json:"volumeClaimTemplates"`
-	ServiceName          string                  `json:"serviceName"`
+	Name                 string                         `json:"name"`
+	Namespace            string                         `json:"namespace"`
+	ResourceVersion      string                         `json:"resourceVersion"` // version the edit is based on, empty skips the conflict check
+	Labels               []Item                         `json:"labels"`
+	Replicas             int32                          `json:"replicas"`
+	Selector             []Item                         `json:"selector"`
+	Template             Pod                            `json:"template"`
+	VolumeClaimTemplates []PersistentVolumeClaim        `json:"volumeClaimTemplates"`
+	ServiceName          string                         `json:"serviceName"`
+	PodManagementPolicy  appsv1.PodManagementPolicyType `json:"podManagementPolicy"` // OrderedReady(默认) | Parallel，创建后不可修改
+	UpdateStrategy       StatefulSetUpdateStrategy      `json:"updateStrategy"`
+	PVCRetentionPolicy   *StatefulSetPVCRetentionPolicy `json:"persistentVolumeClaimRetentionPolicy,omitempty"` // 为空时 PVC 一律保留
+	Autoscaler           *AutoscalerRef                 `json:"autoscaler,omitempty"`                           // HPA scaling the workload, ignored on write
+}
+
+type StatefulSetUpdateStrategy struct {
+	Type appsv1.StatefulSetUpdateStrategyType `json:"type"` // RollingUpdate(默认) | OnDelete
+	// Partition 只更新序号不小于该值的 Pod，用于金丝雀发布，仅 RollingUpdate 生效
+	Partition int32 `json:"partition"`
+	// MaxUnavailable 滚动更新时最多不可用的 Pod 数或百分比，为空时为 1，需开启 MaxUnavailableStatefulSet 特性
+	MaxUnavailable string `json:"maxUnavailable"`
+}
+
+type StatefulSetPVCRetentionPolicy struct {
+	WhenDeleted appsv1.PersistentVolumeClaimRetentionPolicyType `json:"whenDeleted"` // Retain | Delete，删除 StatefulSet 时
+	WhenScaled  appsv1.PersistentVolumeClaimRetentionPolicyType `json:"whenScaled"`  // Retain | Delete，缩容时
 }
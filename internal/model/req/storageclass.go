@@ -7,12 +7,12 @@ import (
 
 type StorageClass struct {
 	Name                 string                               `json:"name"`
-	Namespace            string                               `json:"namespace"`
+	ResourceVersion      string                               `json:"resourceVersion"` // version the edit is based on, empty skips the conflict check
 	Labels               []Item                               `json:"labels"`
-	Provisioner          string                               `json:"provisioner"`
+	Provisioner          string                               `json:"provisioner"` // must be registered in storageClass.provisioners of the config
 	Parameters           []Item                               `json:"parameters"`
-	ReclaimPolicy        corev1.PersistentVolumeReclaimPolicy `json:"reclaimPolicy"` // Delete | Retain
+	ReclaimPolicy        corev1.PersistentVolumeReclaimPolicy `json:"reclaimPolicy"` // Delete(default) | Retain
 	MountOptions         []string                             `json:"mountOptions"`
 	AllowVolumeExpansion bool                                 `json:"allowVolumeExpansion"` // whether permit expand
-	VolumeBindingMode    storagev1.VolumeBindingMode          `json:"volumeBindingMode"`
+	VolumeBindingMode    storagev1.VolumeBindingMode          `json:"volumeBindingMode"`    // Immediate(default) | WaitForFirstConsumer
 }
@@ -0,0 +1,32 @@
+package req
+
+import corev1 "k8s.io/api/core/v1"
+
+type VolumeSnapshot struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Labels    []Item `json:"labels"`
+	PVCName   string `json:"pvcName"` // 要快照的 PVC，需与快照在同一命名空间
+	// SnapshotClassName 为空时使用默认 VolumeSnapshotClass
+	SnapshotClassName string `json:"snapshotClassName"`
+}
+
+type VolumeSnapshotClass struct {
+	Name           string `json:"name"`
+	Labels         []Item `json:"labels"`
+	Driver         string `json:"driver"`         // CSI 驱动名，需与 StorageClass 的 provisioner 一致
+	DeletionPolicy string `json:"deletionPolicy"` // Delete(默认) | Retain
+	Parameters     []Item `json:"parameters"`
+	IsDefault      bool   `json:"isDefault"` // 设为该驱动的默认快照类
+}
+
+type VolumeSnapshotRestore struct {
+	Namespace    string `json:"namespace"`
+	SnapshotName string `json:"snapshotName"`
+	PVCName      string `json:"pvcName"` // 新建的 PVC 名称
+	// StorageClassName 为空时沿用源 PVC 的 StorageClass
+	StorageClassName string `json:"storageClassName"`
+	Capacity         string `json:"capacity"` // 为空时取快照的 restoreSize，不能小于 restoreSize
+	// AccessModes 为空时沿用源 PVC 的访问模式
+	AccessModes []corev1.PersistentVolumeAccessMode `json:"accessModes"`
+}
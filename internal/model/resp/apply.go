@@ -0,0 +1,8 @@
+package resp
+
+// FieldConflict is a field another manager owns with a different value than the one applied.
+type FieldConflict struct {
+	Field   string `json:"field"`   // e.g. .spec.replicas
+	Manager string `json:"manager"` // e.g. kube-controller-manager, argocd-controller
+	Message string `json:"message"`
+}
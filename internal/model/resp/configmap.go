@@ -5,13 +5,23 @@ type ConfigMap struct {
 	Namespace string `json:"namespace"`
 	DataNum   int    `json:"dataNum"`
 	Age       int64  `json:"age"`
+	Immutable bool   `json:"immutable"`
 }
 
 type ConfigMapDetail struct {
-	Name      string `json:"name"`
-	Namespace string `json:"namespace"`
-	DataNum   int    `json:"dataNum"`
-	Age       int64  `json:"age"`
-	Labels    []Item `json:"labels"`
-	Data      []Item `json:"data"`
+	Name            string       `json:"name"`
+	Namespace       string       `json:"namespace"`
+	ResourceVersion string       `json:"resourceVersion"`
+	DataNum         int          `json:"dataNum"`
+	Age             int64        `json:"age"`
+	Labels          []Item       `json:"labels"`
+	Data            []Item       `json:"data"`
+	BinaryData      []BinaryItem `json:"binaryData"` // content is fetched by download
+	Immutable       bool         `json:"immutable"`
+	UsedBy          []Consumer   `json:"usedBy"`
+}
+
+type BinaryItem struct {
+	Key  string `json:"key"`
+	Size int    `json:"size"`
 }
@@ -4,8 +4,35 @@ type CronJob struct {
 	Name             string `json:"name"`
 	Namespace        string `json:"namespace"`
 	Schedule         string `json:"schedule"`
+	TimeZone         string `json:"timeZone"`
 	Suspend          bool   `json:"suspend"`
 	Active           int    `json:"active"`
 	LastScheduleTime int64  `json:"lastScheduleTime"`
+	NextScheduleTime int64  `json:"nextScheduleTime"` // 0 when suspended
 	Age              int64  `json:"age"`
 }
+
+type CronJobSchedule struct {
+	Schedule string  `json:"schedule"`
+	TimeZone string  `json:"timeZone"` // the zone the schedule is read in, UTC when the CronJob sets none
+	NextRuns []int64 `json:"nextRuns"`
+}
+
+type CronJobHistory struct {
+	LastScheduleTime   int64        `json:"lastScheduleTime"`
+	LastSuccessfulTime int64        `json:"lastSuccessfulTime"`
+	Active             int          `json:"active"`
+	Succeeded          int          `json:"succeeded"`
+	Failed             int          `json:"failed"`
+	Jobs               []CronJobRun `json:"jobs"` // newest first
+}
+
+type CronJobRun struct {
+	Name           string `json:"name"`
+	Status         string `json:"status"` // Running | Complete | Failed | Suspended
+	Manual         bool   `json:"manual"` // created by run now instead of the schedule
+	StartTime      int64  `json:"startTime"`
+	CompletionTime int64  `json:"completionTime"`
+	Succeeded      int32  `json:"succeeded"`
+	Failed         int32  `json:"failed"`
+}
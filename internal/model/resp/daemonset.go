@@ -10,3 +10,21 @@ type DaemonSet struct {
 	Available int32  `json:"available"` //表示可用的守护进程副本数，即已经就绪并且可以提供服务的守护进程副本数量
 	Age       int64  `json:"age"`
 }
+
+type DaemonSetCoverage struct {
+	Desired      int32           `json:"desired"`
+	Scheduled    int32           `json:"scheduled"`
+	Ready        int32           `json:"ready"`
+	UpToDate     int32           `json:"upToDate"`
+	Misscheduled int32           `json:"misscheduled"` // pods on nodes that should not run them
+	Nodes        []DaemonSetNode `json:"nodes"`
+}
+
+type DaemonSetNode struct {
+	Node  string `json:"node"`
+	State string `json:"state"` // Running | Pending | Missing | Skipped | Misscheduled
+	Pod   string `json:"pod"`
+	Ready bool   `json:"ready"`
+	// Reasons explain why the node is skipped, or why the pod is not running on it
+	Reasons []string `json:"reasons"`
+}
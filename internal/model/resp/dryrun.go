@@ -0,0 +1,17 @@
+package resp
+
+// DryRunDiff compares the live object with the server-side dry-run result of a write.
+type DryRunDiff struct {
+	Operation string        `json:"operation"` // create | update | recreate
+	Changes   []FieldChange `json:"changes"`
+	Diff      string        `json:"diff"` // unified diff of the live and resulting YAML
+	// Warnings tell what the write would not achieve, such as replicas an HPA resets
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+type FieldChange struct {
+	Path string `json:"path"` // e.g. spec.template.spec.containers[0].image
+	Op   string `json:"op"`   // add | remove | replace
+	Old  any    `json:"old,omitempty"`
+	New  any    `json:"new,omitempty"`
+}
@@ -0,0 +1,41 @@
+package resp
+
+type HPA struct {
+	Name            string `json:"name"`
+	Namespace       string `json:"namespace"`
+	Target          string `json:"target"` // Kind/Name of the scaled workload
+	MinReplicas     int32  `json:"minReplicas"`
+	MaxReplicas     int32  `json:"maxReplicas"`
+	CurrentReplicas int32  `json:"currentReplicas"`
+	DesiredReplicas int32  `json:"desiredReplicas"`
+	Targets         string `json:"targets"` // current/target of every metric, like kubectl get hpa
+	Age             int64  `json:"age"`
+}
+
+type HPAStatus struct {
+	Name            string               `json:"name"`
+	Namespace       string               `json:"namespace"`
+	Target          string               `json:"target"`
+	MinReplicas     int32                `json:"minReplicas"`
+	MaxReplicas     int32                `json:"maxReplicas"`
+	CurrentReplicas int32                `json:"currentReplicas"`
+	DesiredReplicas int32                `json:"desiredReplicas"`
+	LastScaleTime   int64                `json:"lastScaleTime"` // 0 when it has never scaled
+	Metrics         []HPAMetricStatus    `json:"metrics"`
+	Conditions      []HPAConditionStatus `json:"conditions"`
+}
+
+type HPAMetricStatus struct {
+	Type    string `json:"type"`    // Resource | Pods | Object | External
+	Name    string `json:"name"`    // resource or metric name
+	Current string `json:"current"` // <unknown> until the metric is collected
+	Target  string `json:"target"`
+}
+
+type HPAConditionStatus struct {
+	Type               string `json:"type"` // AbleToScale | ScalingActive | ScalingLimited
+	Status             string `json:"status"`
+	Reason             string `json:"reason"`
+	Message            string `json:"message"`
+	LastTransitionTime int64  `json:"lastTransitionTime"`
+}
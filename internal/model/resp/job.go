@@ -5,6 +5,26 @@ type Job struct {
 	Namespace   string `json:"namespace"`
 	Completions int32  `json:"completions"` //控制 Job 成功完成的实例数目的  当指定的实例数目达到 Completions 字段所设定的值时，Job 将被标记为成功完成
 	Succeeded   int32  `json:"succeeded"`   //就绪的 Job 个数
+	Active      int32  `json:"active"`      // 正在运行的 Pod 数
+	Failed      int32  `json:"failed"`      // 失败的 Pod 数
+	Status      string `json:"status"`      // Running | Complete | Failed | Suspended
 	Duration    int64  `json:"duration"`    // Job 的持续时间
 	Age         int64  `json:"age"`
 }
+
+type JobPod struct {
+	Name            string               `json:"name"`
+	Phase           string               `json:"phase"`
+	Node            string               `json:"node"`
+	CompletionIndex string               `json:"completionIndex"` // only for Indexed Jobs
+	StartTime       int64                `json:"startTime"`
+	Containers      []JobContainerStatus `json:"containers"`
+}
+
+type JobContainerStatus struct {
+	Name     string `json:"name"`
+	State    string `json:"state"` // Waiting | Running | Terminated
+	Reason   string `json:"reason"`
+	ExitCode int32  `json:"exitCode"` // exit code of the terminated container, or of its last run
+	Restarts int32  `json:"restarts"`
+}
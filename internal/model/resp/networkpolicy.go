@@ -0,0 +1,27 @@
+package resp
+
+type NetworkPolicy struct {
+	Name         string   `json:"name"`
+	Namespace    string   `json:"namespace"`
+	PodSelector  string   `json:"podSelector"` // label selector string, <none> selects every pod
+	PolicyTypes  []string `json:"policyTypes"`
+	IngressRules int      `json:"ingressRules"`
+	EgressRules  int      `json:"egressRules"`
+	Age          int64    `json:"age"`
+}
+
+type Reachability struct {
+	Allowed bool          `json:"allowed"`
+	Port    int32         `json:"port"` // destination port the named port resolved to
+	Egress  PolicyVerdict `json:"egress"`
+	Ingress PolicyVerdict `json:"ingress"`
+}
+
+// PolicyVerdict is the outcome for one direction: egress of the source pod or ingress of the destination pod.
+type PolicyVerdict struct {
+	Isolated  bool     `json:"isolated"`  // some policy selects the pod for this direction, so unmatched traffic is denied
+	Allowed   bool     `json:"allowed"`   // the traffic passes in this direction
+	Policies  []string `json:"policies"`  // the policies selecting the pod for this direction
+	DecidedBy []string `json:"decidedBy"` // the policies with a rule allowing the traffic
+	Reason    string   `json:"reason"`
+}
@@ -16,6 +16,7 @@ type NodeListItem struct {
 
 type NodeDetail struct {
 	Name             string         `json:"name"`
+	ResourceVersion  string         `json:"resourceVersion"`
 	Status           string         `json:"status"`
 	Age              int64          `json:"age"`
 	Version          string         `json:"version"` // kubelet version
@@ -0,0 +1,22 @@
+package resp
+
+type PDB struct {
+	Name               string `json:"name"`
+	Namespace          string `json:"namespace"`
+	Selector           string `json:"selector"` // label selector string
+	MinAvailable       string `json:"minAvailable"`
+	MaxUnavailable     string `json:"maxUnavailable"`
+	CurrentHealthy     int32  `json:"currentHealthy"`
+	DesiredHealthy     int32  `json:"desiredHealthy"`
+	ExpectedPods       int32  `json:"expectedPods"`
+	DisruptionsAllowed int32  `json:"disruptionsAllowed"`
+	Age                int64  `json:"age"`
+}
+
+// PDBViolation is a PDB that would drop below its budget if the pods were evicted.
+type PDBViolation struct {
+	Name               string   `json:"name"`
+	Namespace          string   `json:"namespace"`
+	DisruptionsAllowed int32    `json:"disruptionsAllowed"`
+	Pods               []string `json:"pods"` // the evicted pods it protects
+}
@@ -9,3 +9,36 @@ type PodListItem struct {
 	IP       string `json:"ip"`       // Pod id
 	Node     string `json:"node"`     // Which Node the Pod is dispatched to
 }
+
+type ContainerFile struct {
+	Name    string `json:"name"`
+	Path    string `json:"path"`
+	Type    string `json:"type"` // file | dir | link | other
+	Size    int64  `json:"size"` // bytes, the total size for a directory in Stat
+	Mode    string `json:"mode"` // octal permission bits, e.g. 644
+	ModTime int64  `json:"modTime"`
+}
+
+// PodResourcePreview is how the api server would settle the resources of a pod on creation.
+type PodResourcePreview struct {
+	QOSClass    string                     `json:"qosClass"` // Guaranteed | Burstable | BestEffort
+	Containers  []ContainerResourcePreview `json:"containers"`
+	LimitRanges []string                   `json:"limitRanges"` // LimitRanges of the namespace, in the order they apply
+	Violations  []string                   `json:"violations"`  // min, max and ratio bounds the LimitRanges would reject the pod for
+}
+
+type ContainerResourcePreview struct {
+	Name      string              `json:"name"`
+	Init      bool                `json:"init"`
+	Resources []ContainerResource `json:"resources"` // effective requests and limits
+}
+
+type ContainerResource struct {
+	Name    string `json:"name"`
+	Request string `json:"request"`
+	Limit   string `json:"limit"`
+	// where a value comes from: empty when the container sets it, limit when the request
+	// defaults to the limit, otherwise the name of the LimitRange that defaults it
+	RequestSource string `json:"requestSource"`
+	LimitSource   string `json:"limitSource"`
+}
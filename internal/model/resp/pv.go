@@ -11,6 +11,8 @@ type PersistentVolumeItem struct {
 	Status               corev1.PersistentVolumePhase         `json:"status"`
 	Claim                string                               `json:"claim"` // bind for pvc
 	StorageClass         string                               `json:"storageClass"`
+	Source               string                               `json:"source"` // nfs | hostPath | local | csi | iscsi, empty for other types
+	VolumeMode           corev1.PersistentVolumeMode          `json:"volumeMode"`
 	VolumeAttributeClass string                               `json:"volumeAttributeClass"`
 	Reason               string                               `json:"reason"`
 	Age                  int64                                `json:"age"`
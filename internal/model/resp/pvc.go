@@ -12,3 +12,56 @@ type PersistentVolumeClaim struct {
 	VolumeAttributeClass string                              `json:"volumeAttributeClass"`
 	Age                  int64                               `json:"age"`
 }
+
+type PersistentVolumeClaimDetail struct {
+	Name         string                              `json:"name"`
+	Namespace    string                              `json:"namespace"`
+	Labels       []Item                              `json:"labels"`
+	Status       corev1.PersistentVolumeClaimPhase   `json:"status"`
+	StorageClass string                              `json:"storageClass"` // empty for a claim bound only to volumes without a class
+	VolumeMode   corev1.PersistentVolumeMode         `json:"volumeMode"`
+	AccessModes  []corev1.PersistentVolumeAccessMode `json:"accessModes"`
+	Requested    string                              `json:"requested"` // spec.resources.requests.storage
+	Capacity     string                              `json:"capacity"`  // capacity of the bound volume, empty while pending
+	// Resizing is set while an expansion is in progress, from the request up to the volume capacity
+	Resizing   bool           `json:"resizing"`
+	Volume     *PVCVolume     `json:"volume"` // nil while pending
+	Conditions []PVCCondition `json:"conditions"`
+	MountedBy  []PVCMount     `json:"mountedBy"`
+	Age        int64          `json:"age"`
+}
+
+type PVCVolume struct {
+	Name          string                               `json:"name"`
+	Capacity      string                               `json:"capacity"`
+	ReclaimPolicy corev1.PersistentVolumeReclaimPolicy `json:"reclaimPolicy"`
+	Status        corev1.PersistentVolumePhase         `json:"status"`
+}
+
+type PVCCondition struct {
+	Type               string `json:"type"` // Resizing | FileSystemResizePending | ...
+	Status             string `json:"status"`
+	Reason             string `json:"reason"`
+	Message            string `json:"message"`
+	LastTransitionTime int64  `json:"lastTransitionTime"`
+}
+
+type PVCMount struct {
+	Pod      string `json:"pod"`
+	Node     string `json:"node"`
+	Phase    string `json:"phase"`
+	ReadOnly bool   `json:"readOnly"`
+}
+
+type PVCDiagnosis struct {
+	Status corev1.PersistentVolumeClaimPhase `json:"status"`
+	// Cause is Bound | Lost | WaitForFirstConsumer | NoMatchingVolume | StorageClassNotFound |
+	// SelectorNotSupported | ProvisionerMissing | ProvisioningFailed | Provisioning
+	Cause        string   `json:"cause"`
+	Message      string   `json:"message"`
+	StorageClass string   `json:"storageClass"`
+	Provisioner  string   `json:"provisioner"`
+	BindingMode  string   `json:"bindingMode"`
+	Volumes      []string `json:"volumes"` // why the volumes of the class do not match the claim
+	Events       []string `json:"events"`  // recent events of the claim, newest last
+}
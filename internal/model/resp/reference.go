@@ -0,0 +1,9 @@
+package resp
+
+// Consumer is a workload or bare pod that mounts or reads a ConfigMap or Secret.
+type Consumer struct {
+	Kind        string   `json:"kind"` // Deployment | StatefulSet | DaemonSet | CronJob | Job | Pod
+	Name        string   `json:"name"`
+	Refs        []string `json:"refs"`        // where it is referenced, e.g. volume:config | env:app/LOG_LEVEL
+	Restartable bool     `json:"restartable"` // supports rollout restart
+}
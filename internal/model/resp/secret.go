@@ -11,11 +11,20 @@ type Secret struct {
 }
 
 type SecretDetail struct {
-	Name      string            `json:"name"`
-	Namespace string            `json:"namespace"`
-	DataNum   int               `json:"dataNum"`
-	Age       int64             `json:"age"`
-	Type      corev1.SecretType `json:"type"`
-	Labels    []Item            `json:"labels"`
-	Data      []Item            `json:"data"`
+	Name            string            `json:"name"`
+	Namespace       string            `json:"namespace"`
+	ResourceVersion string            `json:"resourceVersion"`
+	DataNum         int               `json:"dataNum"`
+	Age             int64             `json:"age"`
+	Type            corev1.SecretType `json:"type"`
+	Labels          []Item            `json:"labels"`
+	Data            []SecretItem      `json:"data"`
+	UsedBy          []Consumer        `json:"usedBy"`
+}
+
+type SecretItem struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`  // masked unless revealed
+	Size   int    `json:"size"`   // decoded length in bytes
+	Base64 bool   `json:"base64"` // revealed binary value is base64 encoded
 }
@@ -3,10 +3,49 @@ package resp
 import corev1 "k8s.io/api/core/v1"
 
 type Service struct {
-	Name       string             `json:"name"`
-	Namespace  string             `json:"namespace"`
-	Type       corev1.ServiceType `json:"type"`
-	ClusterIP  string             `json:"clusterIP"`
-	ExternalIP []string           `json:"externalIP"`
-	Age        int64              `json:"age"`
+	Name         string             `json:"name"`
+	Namespace    string             `json:"namespace"`
+	Type         corev1.ServiceType `json:"type"`
+	ClusterIP    string             `json:"clusterIP"`
+	ExternalIP   []string           `json:"externalIP"`
+	ExternalName string             `json:"externalName"`
+	Age          int64              `json:"age"`
+}
+
+type ServiceTopology struct {
+	Name      string             `json:"name"`
+	Namespace string             `json:"namespace"`
+	Type      corev1.ServiceType `json:"type"`
+	ClusterIP string             `json:"clusterIP"`
+	Selector  []Item             `json:"selector"`
+	Ports     []ServicePortCheck `json:"ports"`
+	Endpoints []ServiceEndpoint  `json:"endpoints"` // resolved from EndpointSlices
+	Pods      []string           `json:"pods"`      // pods matched by the selector
+	Routes    []ServiceRoute     `json:"routes"`    // Ingresses / IngressRoutes that route to the service
+	Warnings  []string           `json:"warnings"`  // why the service may answer 503
+}
+
+type ServicePortCheck struct {
+	Name       string `json:"name"`
+	Port       int32  `json:"port"`
+	TargetPort string `json:"targetPort"`
+	Resolved   bool   `json:"resolved"` // whether the targetPort exists on the matched containers
+}
+
+type ServiceEndpoint struct {
+	Address     string   `json:"address"`
+	Ready       bool     `json:"ready"`
+	Serving     bool     `json:"serving"`
+	Terminating bool     `json:"terminating"`
+	Pod         string   `json:"pod"`
+	Node        string   `json:"node"`
+	Ports       []string `json:"ports"` // name/port/protocol
+}
+
+type ServiceRoute struct {
+	Kind string `json:"kind"` // Ingress | IngressRoute
+	Name string `json:"name"`
+	Host string `json:"host"` // ingress host or IngressRoute match rule
+	Path string `json:"path"`
+	Port string `json:"port"`
 }
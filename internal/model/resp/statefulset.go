@@ -1,9 +1,44 @@
 package resp
 
+import corev1 "k8s.io/api/core/v1"
+
 type StatefulSet struct {
 	Name      string `json:"name"`
 	Namespace string `json:"namespace"`
 	Ready     int32  `json:"ready"`
 	Replicas  int32  `json:"replicas"`
+	Updated   int32  `json:"updated"` // pods at the update revision
 	Age       int64  `json:"age"`
 }
+
+type StatefulSetRollout struct {
+	CurrentRevision string           `json:"currentRevision"`
+	UpdateRevision  string           `json:"updateRevision"`
+	Partition       int32            `json:"partition"`
+	Replicas        int32            `json:"replicas"`
+	Updated         int32            `json:"updated"`
+	Pods            []StatefulSetPod `json:"pods"` // ordered by ordinal
+}
+
+type StatefulSetPod struct {
+	Ordinal  int32  `json:"ordinal"`
+	Name     string `json:"name"`
+	Phase    string `json:"phase"` // pod phase, Missing when the ordinal has no pod yet
+	Ready    bool   `json:"ready"`
+	Revision string `json:"revision"`
+	Updated  bool   `json:"updated"` // the pod runs the update revision
+	Node     string `json:"node"`
+	Restarts int32  `json:"restarts"`
+}
+
+type StatefulSetPVC struct {
+	Name         string                            `json:"name"`
+	Template     string                            `json:"template"` // volumeClaimTemplates entry it was created from
+	Ordinal      int32                             `json:"ordinal"`
+	Status       corev1.PersistentVolumeClaimPhase `json:"status"`
+	Capacity     string                            `json:"capacity"`
+	StorageClass string                            `json:"storageClass"`
+	// Orphaned is set when the ordinal is outside the replicas and no pod uses the claim any more
+	Orphaned bool  `json:"orphaned"`
+	Age      int64 `json:"age"`
+}
@@ -14,5 +14,6 @@ type StorageClass struct {
 	Parameters           []Item                               `json:"parameters"`
 	VolumeBindingMode    storagev1.VolumeBindingMode          `json:"volumeBindingMode"`
 	AllowVolumeExpansion bool                                 `json:"allowVolumeExpansion"`
+	IsDefault            bool                                 `json:"isDefault"` // claims without a StorageClass get this one
 	Age                  int64                                `json:"age"`
 }
@@ -0,0 +1,25 @@
+package resp
+
+type VolumeSnapshot struct {
+	Name            string `json:"name"`
+	Namespace       string `json:"namespace"`
+	Labels          []Item `json:"labels"`
+	PVC             string `json:"pvc"` // source claim, empty for a snapshot imported from an existing content
+	SnapshotClass   string `json:"snapshotClass"`
+	SnapshotContent string `json:"snapshotContent"` // the bound VolumeSnapshotContent
+	ReadyToUse      bool   `json:"readyToUse"`
+	RestoreSize     string `json:"restoreSize"`  // minimum size of a claim restored from it, empty until taken
+	CreationTime    int64  `json:"creationTime"` // when the storage system took the snapshot, 0 until taken
+	Error           string `json:"error"`
+	Age             int64  `json:"age"`
+}
+
+type VolumeSnapshotClass struct {
+	Name           string `json:"name"`
+	Labels         []Item `json:"labels"`
+	Driver         string `json:"driver"`
+	DeletionPolicy string `json:"deletionPolicy"`
+	Parameters     []Item `json:"parameters"`
+	IsDefault      bool   `json:"isDefault"`
+	Age            int64  `json:"age"`
+}
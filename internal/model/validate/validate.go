@@ -1,12 +1,33 @@
 package validate
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/crazyfrankie/kube-ctl/conf"
+	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
 	"github.com/crazyfrankie/kube-ctl/pkg/consts"
+	"github.com/crazyfrankie/kube-ctl/pkg/utils"
 )
 
 func PodValidate(pod *req.Pod) error {
@@ -51,21 +72,1306 @@ func PodValidate(pod *req.Pod) error {
 		pod.Base.RestartPolicy = consts.RestartPolicyAlways
 	}
 
+	return podSpecValidate(pod)
+}
+
+// podSpecValidate checks the pod settings shared by pods and the templates of the workloads.
+func podSpecValidate(pod *req.Pod) error {
+	if pod.Base.TerminationGracePeriodSeconds != nil && *pod.Base.TerminationGracePeriodSeconds < 0 {
+		return errors.New("pod terminationGracePeriodSeconds must not be negative")
+	}
+	switch corev1.PodFSGroupChangePolicy(pod.SecurityContext.FSGroupChangePolicy) {
+	case "", corev1.FSGroupChangeOnRootMismatch, corev1.FSGroupChangeAlways:
+	default:
+		return fmt.Errorf("pod fsGroupChangePolicy: %s is not supported, use OnRootMismatch or Always", pod.SecurityContext.FSGroupChangePolicy)
+	}
+	if err := seccompValidate(pod.SecurityContext.SeccompProfile); err != nil {
+		return err
+	}
+
+	for _, v := range pod.Volume {
+		if err := podVolumeValidate(&v); err != nil {
+			return fmt.Errorf("pod volume %s: %w", v.Name, err)
+		}
+	}
+	for _, c := range slices.Concat(pod.InitContainers, pod.Containers) {
+		if err := containerValidate(&c); err != nil {
+			return fmt.Errorf("pod container %s: %w", c.Name, err)
+		}
+	}
+
+	for _, terms := range [][]req.PodAffinityTerm{pod.PodAffinity.Affinity, pod.PodAffinity.AntiAffinity} {
+		for i := range terms {
+			t := &terms[i]
+			if t.TopologyKey == "" {
+				return errors.New("pod affinity topologyKey is necessary")
+			}
+			switch t.Type {
+			case "":
+				t.Type = convert.AffinityRequired
+			case convert.AffinityRequired:
+			case convert.AffinityPreferred:
+				if t.Weight < 1 || t.Weight > 100 {
+					return errors.New("pod affinity weight must be between 1 and 100")
+				}
+			default:
+				return fmt.Errorf("pod affinity type: %s is not supported, use required or preferred", t.Type)
+			}
+		}
+	}
+
+	for i := range pod.TopologySpreadConstraints {
+		r := &pod.TopologySpreadConstraints[i]
+		if r.TopologyKey == "" {
+			return errors.New("pod topology spread topologyKey is necessary")
+		}
+		if r.MaxSkew < 1 {
+			return errors.New("pod topology spread maxSkew must be positive")
+		}
+		switch corev1.UnsatisfiableConstraintAction(r.WhenUnsatisfiable) {
+		case "":
+			r.WhenUnsatisfiable = string(corev1.DoNotSchedule)
+		case corev1.DoNotSchedule, corev1.ScheduleAnyway:
+		default:
+			return fmt.Errorf("pod topology spread whenUnsatisfiable: %s is not supported, use DoNotSchedule or ScheduleAnyway", r.WhenUnsatisfiable)
+		}
+		if r.MinDomains != nil && (*r.MinDomains < 1 || r.WhenUnsatisfiable != string(corev1.DoNotSchedule)) {
+			return errors.New("pod topology spread minDomains must be positive and needs DoNotSchedule")
+		}
+	}
+
+	return nil
+}
+
+func podVolumeValidate(v *req.Volume) error {
+	switch v.Type {
+	case convert.ProjectedVolume:
+		if len(v.ProjectedVolume.Sources) == 0 {
+			return errors.New("projected volume needs sources")
+		}
+		for _, source := range v.ProjectedVolume.Sources {
+			switch source.Type {
+			case convert.ConfigMapVolume, convert.SecretVolume:
+				if source.Name == "" {
+					return fmt.Errorf("projected %s needs the name", source.Type)
+				}
+			case convert.DownwardAPIVolume:
+			case convert.ServiceAccountTokenProjection:
+				if source.ServiceAccountToken.Path == "" {
+					return errors.New("projected serviceAccountToken needs the path")
+				}
+				if exp := source.ServiceAccountToken.ExpirationSeconds; exp != 0 && exp < 600 {
+					return errors.New("projected serviceAccountToken expirationSeconds must be at least 600")
+				}
+			default:
+				return fmt.Errorf("projected source type: %s is not supported, use configMap, secret, downwardAPI or serviceAccountToken", source.Type)
+			}
+		}
+	case convert.EphemeralVolume:
+		if err := pvcCapacityValidate(v.EphemeralVolume.Capacity); err != nil {
+			return err
+		}
+		if len(v.EphemeralVolume.AccessModes) == 0 {
+			return errors.New("ephemeral volume accessModes is necessary")
+		}
+	}
+
+	return nil
+}
+
+func containerValidate(c *req.Container) error {
+	sc := c.SecurityContext
+	if c.Privileged && sc.AllowPrivilegeEscalation != nil && !*sc.AllowPrivilegeEscalation {
+		return errors.New("a privileged container can not disallow privilege escalation")
+	}
+	if err := seccompValidate(sc.SeccompProfile); err != nil {
+		return err
+	}
+	for _, e := range c.Env {
+		if err := envVarValidate(&e); err != nil {
+			return fmt.Errorf("env %s: %w", e.Name, err)
+		}
+	}
+	if err := resourcesValidate(c.Resources); err != nil {
+		return err
+	}
+
+	for _, h := range []req.LifecycleHandler{c.Lifecycle.PostStart, c.Lifecycle.PreStop} {
+		if !h.Enable {
+			continue
+		}
+		switch h.Type {
+		case convert.EXECProbe:
+			if len(h.Command.Command) == 0 {
+				return errors.New("exec lifecycle hook needs the command")
+			}
+		case convert.HTTPProbe:
+			if h.HttpGet.Port < 1 || h.HttpGet.Port > 65535 {
+				return errors.New("http lifecycle hook needs a port between 1 and 65535")
+			}
+		case convert.SleepHandler:
+			if h.Sleep < 1 {
+				return errors.New("sleep lifecycle hook needs a positive duration")
+			}
+		default:
+			return fmt.Errorf("lifecycle hook type: %s is not supported, use exec, http or sleep", h.Type)
+		}
+	}
+
+	return nil
+}
+
+// resourcesValidate checks the quantities before they are parsed, hugepages and extended resources
+// can not be overcommitted so their request needs an equal limit.
+func resourcesValidate(rs []req.ResourceRequirement) error {
+	seen := make(map[string]bool, len(rs))
+	for _, r := range rs {
+		if r.Name == "" {
+			return errors.New("resource name is necessary")
+		}
+		if seen[r.Name] {
+			return fmt.Errorf("resource %s is set twice", r.Name)
+		}
+		seen[r.Name] = true
+		if r.Request == "" && r.Limit == "" {
+			return fmt.Errorf("resource %s needs a request or a limit", r.Name)
+		}
+
+		var request, limit resource.Quantity
+		var err error
+		if r.Request != "" {
+			if request, err = resource.ParseQuantity(r.Request); err != nil {
+				return fmt.Errorf("resource %s request: %s is not a quantity", r.Name, r.Request)
+			}
+			if request.Sign() < 0 {
+				return fmt.Errorf("resource %s request can not be negative", r.Name)
+			}
+		}
+		if r.Limit != "" {
+			if limit, err = resource.ParseQuantity(r.Limit); err != nil {
+				return fmt.Errorf("resource %s limit: %s is not a quantity", r.Name, r.Limit)
+			}
+			if limit.Sign() < 0 {
+				return fmt.Errorf("resource %s limit can not be negative", r.Name)
+			}
+		}
+		if r.Request != "" && r.Limit != "" && request.Cmp(limit) > 0 {
+			return fmt.Errorf("resource %s request %s is more than the limit %s", r.Name, r.Request, r.Limit)
+		}
+
+		overcommit := r.Name == string(corev1.ResourceCPU) || r.Name == string(corev1.ResourceMemory) ||
+			r.Name == string(corev1.ResourceEphemeralStorage)
+		if !overcommit && !strings.HasPrefix(r.Name, corev1.ResourceHugePagesPrefix) && !strings.Contains(r.Name, "/") {
+			return fmt.Errorf("resource: %s is not supported, use cpu, memory, ephemeral-storage, hugepages-<size> or an extended resource such as nvidia.com/gpu", r.Name)
+		}
+		if !overcommit && r.Request != "" && (r.Limit == "" || request.Cmp(limit) != 0) {
+			return fmt.Errorf("resource %s can not be overcommitted, its limit must equal the request", r.Name)
+		}
+	}
+
+	return nil
+}
+
+// PodResourcePreviewValidate checks what the resource preview needs, the namespace its LimitRanges
+// are read from and a pod spec that converts, the name and images may still be left out.
+func PodResourcePreviewValidate(pod *req.Pod) error {
+	if pod.Base.Namespace == "" {
+		return errors.New("pod namespace is necessary")
+	}
+
+	return podSpecValidate(pod)
+}
+
+// envFieldPaths are the pod fields the downward API exposes as env vars,
+// labels and annotations are read one key at a time, e.g. metadata.labels['app'].
+var envFieldPaths = []string{
+	"metadata.name", "metadata.namespace", "metadata.uid",
+	"spec.nodeName", "spec.serviceAccountName",
+	"status.hostIP", "status.hostIPs", "status.podIP", "status.podIPs",
+}
+
+var envResources = []string{
+	"limits.cpu", "limits.memory", "limits.ephemeral-storage",
+	"requests.cpu", "requests.memory", "requests.ephemeral-storage",
+}
+
+func envVarValidate(e *req.EnvVar) error {
+	if e.Name == "" {
+		return errors.New("env name is necessary")
+	}
+
+	switch e.Type {
+	case convert.RefConfigMap, convert.RefSecret:
+		if e.RefName == "" || e.Value == "" {
+			return fmt.Errorf("%s env needs the name and key", e.Type)
+		}
+	case convert.RefField:
+		if !slices.Contains(envFieldPaths, e.Value) &&
+			!strings.HasPrefix(e.Value, "metadata.labels['") && !strings.HasPrefix(e.Value, "metadata.annotations['") {
+			return fmt.Errorf("field path: %s is not supported, use %s or metadata.labels['<key>']",
+				e.Value, strings.Join(envFieldPaths, ", "))
+		}
+	case convert.RefResource:
+		if !slices.Contains(envResources, e.Value) {
+			return fmt.Errorf("resource: %s is not supported, use %s", e.Value, strings.Join(envResources, ", "))
+		}
+		if e.Divisor != "" {
+			if q, err := resource.ParseQuantity(e.Divisor); err != nil || q.Sign() <= 0 {
+				return fmt.Errorf("divisor: %s is not a positive quantity such as 1m or 1Mi", e.Divisor)
+			}
+		}
+	}
+
+	return nil
+}
+
+func seccompValidate(profile req.SeccompProfile) error {
+	switch corev1.SeccompProfileType(profile.Type) {
+	case "", corev1.SeccompProfileTypeRuntimeDefault, corev1.SeccompProfileTypeUnconfined:
+	case corev1.SeccompProfileTypeLocalhost:
+		if profile.LocalhostProfile == "" {
+			return errors.New("seccomp profile Localhost needs the localhostProfile")
+		}
+	default:
+		return fmt.Errorf("seccomp profile type: %s is not supported, use RuntimeDefault, Localhost or Unconfined", profile.Type)
+	}
+
+	return nil
+}
+
+func IngressValidate(ingress *req.Ingress) error {
+	if ingress.Name == "" {
+		return errors.New("ingress name is necessary")
+	}
+	if ingress.DefaultBackend == nil && len(ingress.Rules) == 0 {
+		return errors.New("ingress needs a default backend or at least one rule")
+	}
+
+	backends := make([]*networkingv1.IngressBackend, 0)
+	if ingress.DefaultBackend != nil {
+		backends = append(backends, ingress.DefaultBackend)
+	}
+	for i, r := range ingress.Rules {
+		if r.Value.HTTP == nil {
+			continue
+		}
+		for j := range r.Value.HTTP.Paths {
+			if r.Value.HTTP.Paths[j].PathType == nil {
+				return fmt.Errorf("ingress rule: %d, path: %d, pathType is necessary", i, j)
+			}
+			backends = append(backends, &r.Value.HTTP.Paths[j].Backend)
+		}
+	}
+	for _, b := range backends {
+		if b.Service == nil && b.Resource == nil {
+			return errors.New("ingress backend must reference a service or a resource")
+		}
+		if b.Service != nil {
+			if b.Service.Name == "" {
+				return errors.New("ingress backend service name is necessary")
+			}
+			if b.Service.Port.Number == 0 && b.Service.Port.Name == "" {
+				return fmt.Errorf("ingress backend service: %s, port number or name is necessary", b.Service.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func ServiceValidate(svc *req.Service) error {
+	if svc.Name == "" {
+		return errors.New("service name is necessary")
+	}
+	if svc.Type == "" {
+		svc.Type = corev1.ServiceTypeClusterIP
+	}
+
+	switch svc.Type {
+	case corev1.ServiceTypeExternalName:
+		if svc.ExternalName == "" {
+			return errors.New("externalName is necessary for ExternalName service")
+		}
+		if svc.Headless {
+			return errors.New("ExternalName service can not be headless")
+		}
+	case corev1.ServiceTypeClusterIP, corev1.ServiceTypeNodePort, corev1.ServiceTypeLoadBalancer:
+		if len(svc.Ports) == 0 && !svc.Headless {
+			return errors.New("service ports is necessary")
+		}
+		if svc.Headless && svc.Type != corev1.ServiceTypeClusterIP {
+			return fmt.Errorf("%s service can not be headless", svc.Type)
+		}
+	default:
+		return fmt.Errorf("unsupported service type: %s", svc.Type)
+	}
+
+	if svc.ExternalTrafficPolicy != "" && svc.Type != corev1.ServiceTypeNodePort && svc.Type != corev1.ServiceTypeLoadBalancer {
+		return errors.New("externalTrafficPolicy is only valid for NodePort or LoadBalancer service")
+	}
+	if len(svc.LoadBalancerSourceRanges) > 0 && svc.Type != corev1.ServiceTypeLoadBalancer {
+		return errors.New("loadBalancerSourceRanges is only valid for LoadBalancer service")
+	}
+	for _, r := range svc.LoadBalancerSourceRanges {
+		if _, _, err := net.ParseCIDR(r); err != nil {
+			return fmt.Errorf("loadBalancerSourceRanges: %s is not a valid CIDR", r)
+		}
+	}
+
+	switch svc.SessionAffinity {
+	case "", corev1.ServiceAffinityNone:
+		if svc.SessionAffinityTimeout != 0 {
+			return errors.New("sessionAffinityTimeout requires ClientIP session affinity")
+		}
+	case corev1.ServiceAffinityClientIP:
+		if svc.SessionAffinityTimeout < 0 || svc.SessionAffinityTimeout > 86400 {
+			return errors.New("sessionAffinityTimeout must be in range 1-86400")
+		}
+	default:
+		return fmt.Errorf("unsupported session affinity: %s", svc.SessionAffinity)
+	}
+
+	for i, p := range svc.Ports {
+		if p.Port <= 0 || p.Port > 65535 {
+			return fmt.Errorf("service ports: %d, port must be in range 1-65535", i)
+		}
+		if len(svc.Ports) > 1 && p.Name == "" {
+			return fmt.Errorf("service ports: %d, name is necessary when exposing multiple ports", i)
+		}
+		switch p.Protocol {
+		case "":
+			svc.Ports[i].Protocol = corev1.ProtocolTCP
+		case corev1.ProtocolTCP, corev1.ProtocolUDP, corev1.ProtocolSCTP:
+		default:
+			return fmt.Errorf("service ports: %d, unsupported protocol %s", i, p.Protocol)
+		}
+		if p.NodePort != 0 && svc.Type != corev1.ServiceTypeNodePort && svc.Type != corev1.ServiceTypeLoadBalancer {
+			return fmt.Errorf("service ports: %d, nodePort is only valid for NodePort or LoadBalancer service", i)
+		}
+		if p.TargetPort.Type == intstr.String && p.TargetPort.StrVal == "" {
+			return fmt.Errorf("service ports: %d, targetPort name is empty", i)
+		}
+	}
+
 	return nil
 }
 
 func StorageClassValidate(sc *req.StorageClass) error {
-	expectedProv := conf.GetConf().StorageClass.Provisioner
-	flag := false
-	for _, i := range expectedProv {
-		if i != sc.Provisioner {
-			flag = true
-			break
+	if sc.Name == "" {
+		return errors.New("storage class name is necessary")
+	}
+
+	registry := conf.GetConf().StorageClass
+	if len(registry.Provisioners) == 0 {
+		return errors.New("provisioner: no provisioner is registered in storageClass.provisioners of the config")
+	}
+	prov := registry.Lookup(sc.Provisioner)
+	if prov == nil {
+		names := make([]string, 0, len(registry.Provisioners))
+		for _, p := range registry.Provisioners {
+			names = append(names, p.Name)
+		}
+		return fmt.Errorf("provisioner: %s is not registered, use one of %s", sc.Provisioner, strings.Join(names, ", "))
+	}
+
+	params := utils.ReqItemToMap(sc.Parameters)
+	if len(prov.AllowedParameters) > 0 {
+		for _, p := range sc.Parameters {
+			if !slices.Contains(prov.AllowedParameters, p.Key) {
+				return fmt.Errorf("parameter: %s is not supported by %s, use %s",
+					p.Key, prov.Name, strings.Join(prov.AllowedParameters, ", "))
+			}
+		}
+	}
+	for _, k := range prov.RequiredParameters {
+		if params[k] == "" {
+			return fmt.Errorf("parameter: %s is required by %s", k, prov.Name)
+		}
+	}
+
+	if sc.ReclaimPolicy == "" {
+		sc.ReclaimPolicy = corev1.PersistentVolumeReclaimDelete
+	}
+	policies := prov.ReclaimPolicies
+	if len(policies) == 0 {
+		policies = []string{string(corev1.PersistentVolumeReclaimDelete), string(corev1.PersistentVolumeReclaimRetain)}
+	}
+	if !slices.Contains(policies, string(sc.ReclaimPolicy)) {
+		return fmt.Errorf("reclaim policy: %s is not allowed for %s, use %s", sc.ReclaimPolicy, prov.Name, strings.Join(policies, " or "))
+	}
+
+	if sc.VolumeBindingMode == "" {
+		sc.VolumeBindingMode = storagev1.VolumeBindingImmediate
+	}
+	modes := prov.BindingModes
+	if len(modes) == 0 {
+		modes = []string{string(storagev1.VolumeBindingImmediate), string(storagev1.VolumeBindingWaitForFirstConsumer)}
+	}
+	if !slices.Contains(modes, string(sc.VolumeBindingMode)) {
+		return fmt.Errorf("volume binding mode: %s is not allowed for %s, use %s", sc.VolumeBindingMode, prov.Name, strings.Join(modes, " or "))
+	}
+
+	return nil
+}
+
+// StorageClassUpdateValidate checks an update of the live class. Only labels, mountOptions and
+// allowVolumeExpansion can change, so the registry is not consulted: a class created outside
+// kube-ctl, such as a cloud default, stays editable. Omitted immutable fields keep the live values.
+func StorageClassUpdateValidate(sc *req.StorageClass, live *storagev1.StorageClass) error {
+	if sc.Name == "" {
+		return errors.New("storage class name is necessary")
+	}
+	for _, o := range sc.MountOptions {
+		if o == "" {
+			return errors.New("mount option can not be empty")
+		}
+	}
+
+	if sc.Provisioner == "" {
+		sc.Provisioner = live.Provisioner
+	}
+	if len(sc.Parameters) == 0 {
+		sc.Parameters = utils.ReqMapToItem(live.Parameters)
+	}
+	if sc.ReclaimPolicy == "" && live.ReclaimPolicy != nil {
+		sc.ReclaimPolicy = *live.ReclaimPolicy
+	}
+	if sc.VolumeBindingMode == "" && live.VolumeBindingMode != nil {
+		sc.VolumeBindingMode = *live.VolumeBindingMode
+	}
+
+	return nil
+}
+
+func SecretValidate(secret *req.Secret) error {
+	if secret.Name == "" {
+		return errors.New("secret name is necessary")
+	}
+	if secret.Namespace == "" {
+		return errors.New("secret namespace is necessary")
+	}
+
+	seen := make(map[string]struct{}, len(secret.Data))
+	for _, i := range secret.Data {
+		if msgs := validation.IsConfigMapKey(i.Key); len(msgs) > 0 {
+			return fmt.Errorf("secret key: %q is invalid, %s", i.Key, msgs[0])
+		}
+		if _, ok := seen[i.Key]; ok {
+			return fmt.Errorf("secret key: %s is duplicated", i.Key)
+		}
+		seen[i.Key] = struct{}{}
+	}
+
+	return nil
+}
+
+func DockerConfigSecretValidate(secret *req.DockerConfigSecret) error {
+	if secret.Name == "" || secret.Namespace == "" {
+		return errors.New("secret name and namespace are necessary")
+	}
+	if secret.Registry == "" {
+		return errors.New("docker registry is necessary")
+	}
+	if secret.Username == "" || secret.Password == "" {
+		return errors.New("docker registry username and password are necessary")
+	}
+
+	return nil
+}
+
+func TLSSecretValidate(secret *req.TLSSecret) error {
+	if secret.Name == "" || secret.Namespace == "" {
+		return errors.New("secret name and namespace are necessary")
+	}
+	if len(secret.Cert) == 0 || len(secret.Key) == 0 {
+		return errors.New("tls cert and key are necessary")
+	}
+
+	block, _ := pem.Decode(secret.Cert)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return errors.New("tls cert is not a PEM encoded certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("tls cert is invalid, %w", err)
+	}
+	if block, _ := pem.Decode(secret.Key); block == nil || !strings.HasSuffix(block.Type, "PRIVATE KEY") {
+		return errors.New("tls key is not a PEM encoded private key")
+	}
+	if _, err := tls.X509KeyPair(secret.Cert, secret.Key); err != nil {
+		return fmt.Errorf("tls cert and key do not match, %w", err)
+	}
+	if time.Now().After(cert.NotAfter) {
+		return fmt.Errorf("tls cert expired at %s", cert.NotAfter.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func BasicAuthSecretValidate(secret *req.BasicAuthSecret) error {
+	if secret.Name == "" || secret.Namespace == "" {
+		return errors.New("secret name and namespace are necessary")
+	}
+	if secret.Username == "" && secret.Password == "" {
+		return errors.New("basic auth needs a username or a password")
+	}
+
+	return nil
+}
+
+func SSHAuthSecretValidate(secret *req.SSHAuthSecret) error {
+	if secret.Name == "" || secret.Namespace == "" {
+		return errors.New("secret name and namespace are necessary")
+	}
+	block, _ := pem.Decode([]byte(secret.PrivateKey))
+	if block == nil || !strings.HasSuffix(block.Type, "PRIVATE KEY") {
+		return errors.New("ssh private key is not PEM encoded")
+	}
+
+	return nil
+}
+
+func ConfigMapValidate(cm *req.ConfigMap) error {
+	if cm.Name == "" {
+		return errors.New("configmap name is necessary")
+	}
+	if cm.Namespace == "" {
+		return errors.New("configmap namespace is necessary")
+	}
+
+	size := 0
+	seen := make(map[string]struct{}, len(cm.Data)+len(cm.BinaryData))
+	check := func(key string) error {
+		if err := ConfigMapKeyValidate(key); err != nil {
+			return err
+		}
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("configmap key: %s is duplicated in data and binaryData", key)
+		}
+		seen[key] = struct{}{}
+		return nil
+	}
+	for _, i := range cm.Data {
+		if err := check(i.Key); err != nil {
+			return err
+		}
+		size += len(i.Key) + len(i.Value)
+	}
+	for _, i := range cm.BinaryData {
+		if err := check(i.Key); err != nil {
+			return err
+		}
+		raw, err := base64.StdEncoding.DecodeString(i.Value)
+		if err != nil {
+			return fmt.Errorf("configmap binaryData key: %s is not base64 encoded", i.Key)
+		}
+		size += len(i.Key) + len(raw)
+	}
+	for _, key := range cm.RemoveBinaryKeys {
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("configmap key: %s is both set and removed", key)
+		}
+	}
+	if size > consts.ConfigMapMaxSize {
+		return fmt.Errorf("configmap size %d bytes exceeds the limit of %d bytes", size, consts.ConfigMapMaxSize)
+	}
+
+	return nil
+}
+
+func ConfigMapKeyValidate(key string) error {
+	if msgs := validation.IsConfigMapKey(key); len(msgs) > 0 {
+		return fmt.Errorf("configmap key: %q is invalid, %s", key, msgs[0])
+	}
+
+	return nil
+}
+
+func HPAValidate(hpa *req.HPA) error {
+	if hpa.Name == "" {
+		return errors.New("hpa name is necessary")
+	}
+	if hpa.Namespace == "" {
+		return errors.New("hpa namespace is necessary")
+	}
+	if hpa.TargetKind != "Deployment" && hpa.TargetKind != "StatefulSet" {
+		return fmt.Errorf("hpa target kind: %s is not supported, use Deployment or StatefulSet", hpa.TargetKind)
+	}
+	if hpa.TargetName == "" {
+		return errors.New("hpa target name is necessary")
+	}
+	if hpa.MinReplicas == 0 {
+		hpa.MinReplicas = 1
+	}
+	if hpa.MinReplicas < 1 || hpa.MaxReplicas < hpa.MinReplicas {
+		return fmt.Errorf("hpa replicas must satisfy 1 <= min(%d) <= max(%d)", hpa.MinReplicas, hpa.MaxReplicas)
+	}
+
+	if len(hpa.Metrics) == 0 {
+		hpa.Metrics = []req.HPAMetric{{
+			Type:         autoscalingv2.ResourceMetricSourceType,
+			ResourceName: string(corev1.ResourceCPU),
+			TargetType:   autoscalingv2.UtilizationMetricType,
+			Utilization:  consts.HPADefaultCPUUtilization,
+		}}
+	}
+	for i := range hpa.Metrics {
+		if err := hpaMetricValidate(&hpa.Metrics[i]); err != nil {
+			return fmt.Errorf("hpa metric %d: %w", i, err)
+		}
+	}
+
+	if hpa.Behavior != nil {
+		if err := hpaScalingRulesValidate(hpa.Behavior.ScaleUp); err != nil {
+			return fmt.Errorf("hpa behavior scaleUp: %w", err)
+		}
+		if err := hpaScalingRulesValidate(hpa.Behavior.ScaleDown); err != nil {
+			return fmt.Errorf("hpa behavior scaleDown: %w", err)
 		}
 	}
-	if flag {
+
+	return nil
+}
+
+func hpaMetricValidate(m *req.HPAMetric) error {
+	switch m.Type {
+	case autoscalingv2.ResourceMetricSourceType, autoscalingv2.ContainerResourceMetricSourceType:
+		if m.ResourceName != string(corev1.ResourceCPU) && m.ResourceName != string(corev1.ResourceMemory) {
+			return fmt.Errorf("resource name: %s is not supported, use cpu or memory", m.ResourceName)
+		}
+		if m.TargetType == autoscalingv2.ValueMetricType {
+			return errors.New("resource metric target must be Utilization or AverageValue")
+		}
+		if m.Type == autoscalingv2.ContainerResourceMetricSourceType && m.Container == "" {
+			return errors.New("container resource metric container is necessary")
+		}
+	case autoscalingv2.PodsMetricSourceType:
+		if m.TargetType != autoscalingv2.AverageValueMetricType {
+			return errors.New("pods metric target must be AverageValue")
+		}
+	case autoscalingv2.ObjectMetricSourceType:
+		if m.DescribedObject.Kind == "" || m.DescribedObject.Name == "" {
+			return errors.New("object metric described object kind and name are necessary")
+		}
+		if m.TargetType == autoscalingv2.UtilizationMetricType {
+			return errors.New("object metric target must be Value or AverageValue")
+		}
+	case autoscalingv2.ExternalMetricSourceType:
+		if m.TargetType == autoscalingv2.UtilizationMetricType {
+			return errors.New("external metric target must be Value or AverageValue")
+		}
+	default:
+		return fmt.Errorf("metric type: %s is not supported", m.Type)
+	}
+	if m.Type != autoscalingv2.ResourceMetricSourceType && m.Type != autoscalingv2.ContainerResourceMetricSourceType && m.MetricName == "" {
+		return errors.New("metric name is necessary")
+	}
+
+	switch m.TargetType {
+	case autoscalingv2.UtilizationMetricType:
+		if m.Utilization <= 0 {
+			return errors.New("utilization must be greater than 0")
+		}
+	case autoscalingv2.AverageValueMetricType, autoscalingv2.ValueMetricType:
+		q, err := resource.ParseQuantity(m.Value)
+		if err != nil {
+			return fmt.Errorf("value: %q is not a quantity", m.Value)
+		}
+		if q.Sign() <= 0 {
+			return errors.New("value must be greater than 0")
+		}
+	default:
+		return fmt.Errorf("target type: %s is not supported", m.TargetType)
+	}
+
+	return nil
+}
+
+func hpaScalingRulesValidate(rules *autoscalingv2.HPAScalingRules) error {
+	if rules == nil {
 		return nil
 	}
+	if w := rules.StabilizationWindowSeconds; w != nil && (*w < 0 || *w > consts.HPAMaxStabilizationWindow) {
+		return fmt.Errorf("stabilization window must be between 0 and %d seconds", consts.HPAMaxStabilizationWindow)
+	}
+	if p := rules.SelectPolicy; p != nil && *p != autoscalingv2.MaxChangePolicySelect &&
+		*p != autoscalingv2.MinChangePolicySelect && *p != autoscalingv2.DisabledPolicySelect {
+		return fmt.Errorf("select policy: %s is not supported", *p)
+	}
+	for _, p := range rules.Policies {
+		if p.Type != autoscalingv2.PodsScalingPolicy && p.Type != autoscalingv2.PercentScalingPolicy {
+			return fmt.Errorf("policy type: %s is not supported, use Pods or Percent", p.Type)
+		}
+		if p.Value <= 0 {
+			return errors.New("policy value must be greater than 0")
+		}
+		if p.PeriodSeconds <= 0 || p.PeriodSeconds > consts.HPAMaxPolicyPeriod {
+			return fmt.Errorf("policy period must be between 1 and %d seconds", consts.HPAMaxPolicyPeriod)
+		}
+	}
+
+	return nil
+}
+
+func PDBValidate(pdb *req.PDB) error {
+	if pdb.Name == "" {
+		return errors.New("pdb name is necessary")
+	}
+	if pdb.Namespace == "" {
+		return errors.New("pdb namespace is necessary")
+	}
+	if len(pdb.Selector.MatchLabels) == 0 && len(pdb.Selector.MatchExpressions) == 0 {
+		return errors.New("pdb selector is necessary, an empty selector protects every pod in the namespace")
+	}
+	if _, err := metav1.LabelSelectorAsSelector(&pdb.Selector); err != nil {
+		return fmt.Errorf("pdb selector is invalid, %s", err.Error())
+	}
+	if (pdb.MinAvailable == "") == (pdb.MaxUnavailable == "") {
+		return errors.New("exactly one of pdb minAvailable and maxUnavailable is necessary")
+	}
+	for field, v := range map[string]string{"minAvailable": pdb.MinAvailable, "maxUnavailable": pdb.MaxUnavailable} {
+		if v == "" {
+			continue
+		}
+		value := intstr.Parse(v)
+		n, err := intstr.GetScaledValueFromIntOrPercent(&value, 100, true)
+		if err != nil || n < 0 {
+			return fmt.Errorf("pdb %s: %s must be a non-negative number or a percentage", field, v)
+		}
+		if value.Type == intstr.String && n > 100 {
+			return fmt.Errorf("pdb %s: %s must not exceed 100%%", field, v)
+		}
+	}
+
+	return nil
+}
+
+func NetworkPolicyValidate(np *req.NetworkPolicy) error {
+	if np.Name == "" {
+		return errors.New("networkpolicy name is necessary")
+	}
+	if np.Namespace == "" {
+		return errors.New("networkpolicy namespace is necessary")
+	}
+	if _, err := metav1.LabelSelectorAsSelector(&np.PodSelector); err != nil {
+		return fmt.Errorf("networkpolicy podSelector is invalid, %s", err.Error())
+	}
+
+	if len(np.PolicyTypes) == 0 {
+		np.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}
+		if len(np.Egress) > 0 {
+			np.PolicyTypes = append(np.PolicyTypes, networkingv1.PolicyTypeEgress)
+		}
+	}
+	hasType := make(map[networkingv1.PolicyType]bool, len(np.PolicyTypes))
+	for _, t := range np.PolicyTypes {
+		if t != networkingv1.PolicyTypeIngress && t != networkingv1.PolicyTypeEgress {
+			return fmt.Errorf("networkpolicy policy type: %s is not supported, use Ingress or Egress", t)
+		}
+		hasType[t] = true
+	}
+	if len(np.Ingress) > 0 && !hasType[networkingv1.PolicyTypeIngress] {
+		return errors.New("networkpolicy ingress rules need the Ingress policy type")
+	}
+	if len(np.Egress) > 0 && !hasType[networkingv1.PolicyTypeEgress] {
+		return errors.New("networkpolicy egress rules need the Egress policy type")
+	}
+
+	for i := range np.Ingress {
+		if err := networkPolicyRuleValidate(&np.Ingress[i]); err != nil {
+			return fmt.Errorf("networkpolicy ingress rule %d: %w", i, err)
+		}
+	}
+	for i := range np.Egress {
+		if err := networkPolicyRuleValidate(&np.Egress[i]); err != nil {
+			return fmt.Errorf("networkpolicy egress rule %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func networkPolicyRuleValidate(rule *req.NetworkPolicyRule) error {
+	for i, p := range rule.Peers {
+		if p.IPBlock != nil {
+			if p.PodSelector != nil || p.NamespaceSelector != nil {
+				return fmt.Errorf("peer %d: ipBlock can not be combined with selectors", i)
+			}
+			_, cidr, err := net.ParseCIDR(p.IPBlock.CIDR)
+			if err != nil {
+				return fmt.Errorf("peer %d: ipBlock cidr %s is not a valid CIDR", i, p.IPBlock.CIDR)
+			}
+			for _, e := range p.IPBlock.Except {
+				ip, except, err := net.ParseCIDR(e)
+				if err != nil {
+					return fmt.Errorf("peer %d: ipBlock except %s is not a valid CIDR", i, e)
+				}
+				exceptOnes, _ := except.Mask.Size()
+				cidrOnes, _ := cidr.Mask.Size()
+				if !cidr.Contains(ip) || exceptOnes <= cidrOnes {
+					return fmt.Errorf("peer %d: ipBlock except %s must be strictly within %s", i, e, p.IPBlock.CIDR)
+				}
+			}
+			continue
+		}
+		if p.PodSelector == nil && p.NamespaceSelector == nil {
+			return fmt.Errorf("peer %d: one of podSelector, namespaceSelector and ipBlock is necessary", i)
+		}
+		for _, s := range []*metav1.LabelSelector{p.PodSelector, p.NamespaceSelector} {
+			if _, err := metav1.LabelSelectorAsSelector(s); err != nil {
+				return fmt.Errorf("peer %d: selector is invalid, %s", i, err.Error())
+			}
+		}
+	}
+
+	for i, p := range rule.Ports {
+		switch p.Protocol {
+		case "":
+			rule.Ports[i].Protocol = corev1.ProtocolTCP
+		case corev1.ProtocolTCP, corev1.ProtocolUDP, corev1.ProtocolSCTP:
+		default:
+			return fmt.Errorf("port %d: unsupported protocol %s", i, p.Protocol)
+		}
+		if p.Port == nil {
+			if p.EndPort != 0 {
+				return fmt.Errorf("port %d: endPort requires port", i)
+			}
+			continue
+		}
+		if p.Port.Type == intstr.String {
+			if msgs := validation.IsValidPortName(p.Port.StrVal); len(msgs) > 0 {
+				return fmt.Errorf("port %d: %q is not a valid port name, %s", i, p.Port.StrVal, msgs[0])
+			}
+			if p.EndPort != 0 {
+				return fmt.Errorf("port %d: endPort requires a numeric port", i)
+			}
+			continue
+		}
+		if p.Port.IntVal < 1 || p.Port.IntVal > 65535 {
+			return fmt.Errorf("port %d: port must be in range 1-65535", i)
+		}
+		if p.EndPort != 0 && (p.EndPort < p.Port.IntVal || p.EndPort > 65535) {
+			return fmt.Errorf("port %d: endPort must be in range %d-65535", i, p.Port.IntVal)
+		}
+	}
+
+	return nil
+}
+
+func JobValidate(job *req.Job) error {
+	if job.Name == "" {
+		return errors.New("job name is necessary")
+	}
+	if job.Namespace == "" {
+		return errors.New("job namespace is necessary")
+	}
+	if len(job.Template.Containers) == 0 {
+		return errors.New("job template containers is necessary")
+	}
+	if err := podSpecValidate(&job.Template); err != nil {
+		return fmt.Errorf("job template: %w", err)
+	}
+	if job.Completions < 0 || job.Parallelism < 0 {
+		return errors.New("job completions and parallelism must not be negative")
+	}
+	if job.BackoffLimit != nil && *job.BackoffLimit < 0 {
+		return errors.New("job backoffLimit must not be negative")
+	}
+	if job.ActiveDeadlineSeconds < 0 {
+		return errors.New("job activeDeadlineSeconds must not be negative")
+	}
+	if job.TTLSecondsAfterFinished != nil && *job.TTLSecondsAfterFinished < 0 {
+		return errors.New("job ttlSecondsAfterFinished must not be negative")
+	}
+
+	switch job.CompletionMode {
+	case "", batchv1.NonIndexedCompletion:
+	case batchv1.IndexedCompletion:
+		if job.Completions == 0 {
+			return errors.New("indexed job needs completions")
+		}
+	default:
+		return fmt.Errorf("job completion mode: %s is not supported, use NonIndexed or Indexed", job.CompletionMode)
+	}
+
+	// Jobs can not restart their pods forever
+	switch corev1.RestartPolicy(job.Template.Base.RestartPolicy) {
+	case "":
+		job.Template.Base.RestartPolicy = string(corev1.RestartPolicyNever)
+	case corev1.RestartPolicyNever, corev1.RestartPolicyOnFailure:
+	default:
+		return fmt.Errorf("job restart policy: %s is not supported, use Never or OnFailure", job.Template.Base.RestartPolicy)
+	}
+	if job.PodFailurePolicy != nil && job.Template.Base.RestartPolicy != string(corev1.RestartPolicyNever) {
+		return errors.New("job podFailurePolicy requires restart policy Never")
+	}
+
+	return nil
+}
+
+func CronJobValidate(cron *req.CronJob) error {
+	if cron.Name == "" {
+		return errors.New("cronjob name is necessary")
+	}
+	// the controller appends an 11 character timestamp suffix to the job names
+	if len(cron.Name) > validation.DNS1035LabelMaxLength-11 {
+		return fmt.Errorf("cronjob name must be no more than %d characters", validation.DNS1035LabelMaxLength-11)
+	}
+	if cron.Namespace == "" {
+		return errors.New("cronjob namespace is necessary")
+	}
+	if len(cron.Template.Containers) == 0 {
+		return errors.New("cronjob template containers is necessary")
+	}
+	if err := podSpecValidate(&cron.Template); err != nil {
+		return fmt.Errorf("cronjob template: %w", err)
+	}
+	if _, _, err := utils.ParseSchedule(cron.Schedule, cron.TimeZone); err != nil {
+		return err
+	}
+	if cron.SuccessfulJobsHistoryLimit < 0 || cron.FailedJobsHistoryLimit < 0 {
+		return errors.New("cronjob history limits must not be negative")
+	}
+	if cron.JobBase.Completions < 0 || cron.JobBase.BackoffLimit < 0 {
+		return errors.New("cronjob completions and backoffLimit must not be negative")
+	}
+
+	switch cron.ConcurrencyPolicy {
+	case "":
+		cron.ConcurrencyPolicy = batchv1.AllowConcurrent
+	case batchv1.AllowConcurrent, batchv1.ForbidConcurrent, batchv1.ReplaceConcurrent:
+	default:
+		return fmt.Errorf("cronjob concurrency policy: %s is not supported, use Allow, Forbid or Replace", cron.ConcurrencyPolicy)
+	}
+
+	switch corev1.RestartPolicy(cron.Template.Base.RestartPolicy) {
+	case "":
+		cron.Template.Base.RestartPolicy = string(corev1.RestartPolicyNever)
+	case corev1.RestartPolicyNever, corev1.RestartPolicyOnFailure:
+	default:
+		return fmt.Errorf("cronjob restart policy: %s is not supported, use Never or OnFailure", cron.Template.Base.RestartPolicy)
+	}
+
+	return nil
+}
+
+func DeploymentValidate(deploy *req.Deployment) error {
+	if deploy.Name == "" {
+		return errors.New("deployment name is necessary")
+	}
+	if deploy.Namespace == "" {
+		return errors.New("deployment namespace is necessary")
+	}
+	if len(deploy.Template.Containers) == 0 {
+		return errors.New("deployment template containers is necessary")
+	}
+	if err := podSpecValidate(&deploy.Template); err != nil {
+		return fmt.Errorf("deployment template: %w", err)
+	}
+	if deploy.Replicas < 0 {
+		return errors.New("deployment replicas must not be negative")
+	}
+
+	return nil
+}
+
+func StatefulSetValidate(state *req.StatefulSet) error {
+	if state.Name == "" {
+		return errors.New("statefulset name is necessary")
+	}
+	if state.Namespace == "" {
+		return errors.New("statefulset namespace is necessary")
+	}
+	if len(state.Template.Containers) == 0 {
+		return errors.New("statefulset template containers is necessary")
+	}
+	if err := podSpecValidate(&state.Template); err != nil {
+		return fmt.Errorf("statefulset template: %w", err)
+	}
+	if state.Replicas < 0 {
+		return errors.New("statefulset replicas must not be negative")
+	}
+	for _, t := range state.VolumeClaimTemplates {
+		if t.Name == "" {
+			return errors.New("statefulset volumeClaimTemplates name is necessary")
+		}
+		if err := pvcCapacityValidate(t.Capacity); err != nil {
+			return fmt.Errorf("statefulset volumeClaimTemplates %s: %w", t.Name, err)
+		}
+	}
+
+	switch state.PodManagementPolicy {
+	case "", appsv1.OrderedReadyPodManagement, appsv1.ParallelPodManagement:
+	default:
+		return fmt.Errorf("statefulset pod management policy: %s is not supported, use OrderedReady or Parallel", state.PodManagementPolicy)
+	}
+
+	strategy := state.UpdateStrategy
+	switch strategy.Type {
+	case "", appsv1.RollingUpdateStatefulSetStrategyType:
+		if strategy.Partition < 0 {
+			return errors.New("statefulset update partition must not be negative")
+		}
+		if strategy.MaxUnavailable != "" {
+			maxUnavailable := intstr.Parse(strategy.MaxUnavailable)
+			value, err := intstr.GetScaledValueFromIntOrPercent(&maxUnavailable, 100, true)
+			if err != nil || value < 1 {
+				return fmt.Errorf("statefulset update maxUnavailable: %s must be a positive number or percentage", strategy.MaxUnavailable)
+			}
+		}
+	case appsv1.OnDeleteStatefulSetStrategyType:
+		if strategy.Partition != 0 || strategy.MaxUnavailable != "" {
+			return errors.New("statefulset update partition and maxUnavailable only apply to RollingUpdate")
+		}
+	default:
+		return fmt.Errorf("statefulset update strategy: %s is not supported, use RollingUpdate or OnDelete", strategy.Type)
+	}
+
+	if policy := state.PVCRetentionPolicy; policy != nil {
+		for _, p := range []appsv1.PersistentVolumeClaimRetentionPolicyType{policy.WhenDeleted, policy.WhenScaled} {
+			switch p {
+			case "", appsv1.RetainPersistentVolumeClaimRetentionPolicyType, appsv1.DeletePersistentVolumeClaimRetentionPolicyType:
+			default:
+				return fmt.Errorf("statefulset pvc retention policy: %s is not supported, use Retain or Delete", p)
+			}
+		}
+	}
+
+	return nil
+}
+
+func DaemonSetValidate(daemon *req.DaemonSet) error {
+	if daemon.Name == "" {
+		return errors.New("daemonset name is necessary")
+	}
+	if daemon.Namespace == "" {
+		return errors.New("daemonset namespace is necessary")
+	}
+	if len(daemon.Template.Containers) == 0 {
+		return errors.New("daemonset template containers is necessary")
+	}
+	if err := podSpecValidate(&daemon.Template); err != nil {
+		return fmt.Errorf("daemonset template: %w", err)
+	}
+	if daemon.MinReadySeconds < 0 {
+		return errors.New("daemonset minReadySeconds must not be negative")
+	}
+
+	strategy := daemon.UpdateStrategy
+	switch strategy.Type {
+	case "", appsv1.RollingUpdateDaemonSetStrategyType:
+		maxUnavailable, err := rollingValue("maxUnavailable", strategy.MaxUnavailable, 1)
+		if err != nil {
+			return err
+		}
+		maxSurge, err := rollingValue("maxSurge", strategy.MaxSurge, 0)
+		if err != nil {
+			return err
+		}
+		if maxSurge > 0 && strategy.MaxUnavailable == "" {
+			maxUnavailable = 0
+		}
+		if maxUnavailable == 0 && maxSurge == 0 {
+			return errors.New("daemonset update maxUnavailable and maxSurge must not both be 0")
+		}
+	case appsv1.OnDeleteDaemonSetStrategyType:
+		if strategy.MaxUnavailable != "" || strategy.MaxSurge != "" {
+			return errors.New("daemonset update maxUnavailable and maxSurge only apply to RollingUpdate")
+		}
+	default:
+		return fmt.Errorf("daemonset update strategy: %s is not supported, use RollingUpdate or OnDelete", strategy.Type)
+	}
+
+	return nil
+}
+
+// rollingValue checks a number or percentage of a rolling update, scaled against 100 so that
+// any percentage above 0% counts as non-zero.
+func rollingValue(field string, value string, def int) (int, error) {
+	if value == "" {
+		return def, nil
+	}
+	v := intstr.Parse(value)
+	if v.Type == intstr.String && !strings.HasSuffix(v.StrVal, "%") {
+		return 0, fmt.Errorf("%s: %s must be a number or percentage", field, value)
+	}
+	scaled, err := intstr.GetScaledValueFromIntOrPercent(&v, 100, true)
+	if err != nil || scaled < 0 {
+		return 0, fmt.Errorf("%s: %s must be a non-negative number or percentage", field, value)
+	}
+	if v.Type == intstr.String && scaled > 100 {
+		return 0, fmt.Errorf("%s: %s must not be more than 100%%", field, value)
+	}
+
+	return scaled, nil
+}
+
+func PVCValidate(pvc *req.PersistentVolumeClaim) error {
+	if pvc.Name == "" {
+		return errors.New("pvc name is necessary")
+	}
+	if pvc.Namespace == "" {
+		return errors.New("pvc namespace is necessary")
+	}
+	if err := pvcCapacityValidate(pvc.Capacity); err != nil {
+		return err
+	}
+	if len(pvc.AccessModes) == 0 {
+		return errors.New("pvc accessModes is necessary")
+	}
+	for _, m := range pvc.AccessModes {
+		switch m {
+		case corev1.ReadWriteOnce, corev1.ReadOnlyMany, corev1.ReadWriteMany, corev1.ReadWriteOncePod:
+		default:
+			return fmt.Errorf("pvc access mode: %s is not supported", m)
+		}
+	}
+
+	return nil
+}
+
+func pvcCapacityValidate(capacity string) error {
+	q, err := resource.ParseQuantity(capacity)
+	if err != nil {
+		return fmt.Errorf("pvc capacity: %s is not a quantity such as 10Gi", capacity)
+	}
+	if q.Sign() <= 0 {
+		return errors.New("pvc capacity must be positive")
+	}
 
-	return errors.New("unsupported provisioner type")
+	return nil
+}
+
+func PVValidate(pv *req.PersistentVolume) error {
+	if pv.Name == "" {
+		return errors.New("pv name is necessary")
+	}
+	if err := pvcCapacityValidate(pv.Capacity); err != nil {
+		return err
+	}
+	if len(pv.AccessModes) == 0 {
+		return errors.New("pv accessModes is necessary")
+	}
+	switch pv.ReclaimPolicy {
+	case "":
+		pv.ReclaimPolicy = corev1.PersistentVolumeReclaimRetain
+	case corev1.PersistentVolumeReclaimRetain, corev1.PersistentVolumeReclaimDelete, corev1.PersistentVolumeReclaimRecycle:
+	default:
+		return fmt.Errorf("pv reclaim policy: %s is not supported, use Retain, Delete or Recycle", pv.ReclaimPolicy)
+	}
+	switch pv.VolumeMode {
+	case "", corev1.PersistentVolumeFilesystem, corev1.PersistentVolumeBlock:
+	default:
+		return fmt.Errorf("pv volume mode: %s is not supported, use Filesystem or Block", pv.VolumeMode)
+	}
+	if pv.ClaimRef != nil && (pv.ClaimRef.Name == "" || pv.ClaimRef.Namespace == "") {
+		return errors.New("pv claimRef needs the namespace and name of the claim")
+	}
+
+	source := pv.VolumeSource
+	switch source.Type {
+	case convert.VolumeTypeNFS:
+		if source.NFS.NfsServer == "" || source.NFS.NfsPath == "" {
+			return errors.New("nfs volume needs the server and path")
+		}
+	case convert.VolumeTypeHostPath:
+		if !strings.HasPrefix(source.HostPath.Path, "/") {
+			return errors.New("hostPath volume needs an absolute path")
+		}
+	case convert.VolumeTypeLocal:
+		if !strings.HasPrefix(source.Local.Path, "/") {
+			return errors.New("local volume needs an absolute path")
+		}
+		// the scheduler places the pods on the node of the disk through the node affinity
+		if len(source.Local.Nodes) == 0 {
+			return errors.New("local volume needs the nodes it is on")
+		}
+	case convert.VolumeTypeCSI:
+		if source.CSI.Driver == "" || source.CSI.VolumeHandle == "" {
+			return errors.New("csi volume needs the driver and volumeHandle")
+		}
+		for _, ref := range []*req.SecretRef{source.CSI.ControllerPublishSecretRef, source.CSI.NodeStageSecretRef,
+			source.CSI.NodePublishSecretRef, source.CSI.ControllerExpandSecretRef, source.CSI.NodeExpandSecretRef} {
+			if ref != nil && (ref.Name == "" || ref.Namespace == "") {
+				return errors.New("csi volume secret refs need the name and namespace")
+			}
+		}
+	case convert.VolumeTypeISCSI:
+		if source.ISCSI.TargetPortal == "" || source.ISCSI.IQN == "" {
+			return errors.New("iscsi volume needs the targetPortal and iqn")
+		}
+		if source.ISCSI.Lun < 0 || source.ISCSI.Lun > 255 {
+			return errors.New("iscsi volume lun must be between 0 and 255")
+		}
+		if (source.ISCSI.ChapAuthDiscovery || source.ISCSI.ChapAuthSession) &&
+			(source.ISCSI.SecretRef == nil || source.ISCSI.SecretRef.Name == "") {
+			return errors.New("iscsi volume with CHAP authentication needs the secretRef")
+		}
+	default:
+		return fmt.Errorf("pv volume type: %s is not supported, use nfs, hostPath, local, csi or iscsi", source.Type)
+	}
+	if pv.VolumeMode == corev1.PersistentVolumeBlock &&
+		(source.Type == convert.VolumeTypeNFS || source.Type == convert.VolumeTypeHostPath) {
+		return fmt.Errorf("%s volume does not support the Block volume mode", source.Type)
+	}
+
+	return nil
+}
+
+func VolumeSnapshotValidate(snapshot *req.VolumeSnapshot) error {
+	if snapshot.Name == "" {
+		return errors.New("volume snapshot name is necessary")
+	}
+	if snapshot.Namespace == "" {
+		return errors.New("volume snapshot namespace is necessary")
+	}
+	if snapshot.PVCName == "" {
+		return errors.New("volume snapshot pvcName is necessary")
+	}
+
+	return nil
+}
+
+func VolumeSnapshotClassValidate(class *req.VolumeSnapshotClass) error {
+	if class.Name == "" {
+		return errors.New("volume snapshot class name is necessary")
+	}
+	if class.Driver == "" {
+		return errors.New("volume snapshot class driver is necessary")
+	}
+	switch class.DeletionPolicy {
+	case "":
+		class.DeletionPolicy = "Delete"
+	case "Delete", "Retain":
+	default:
+		return fmt.Errorf("volume snapshot class deletion policy: %s is not supported, use Delete or Retain", class.DeletionPolicy)
+	}
+
+	return nil
+}
+
+func VolumeSnapshotRestoreValidate(restore *req.VolumeSnapshotRestore) error {
+	if restore.Namespace == "" {
+		return errors.New("restore namespace is necessary")
+	}
+	if restore.SnapshotName == "" {
+		return errors.New("restore snapshotName is necessary")
+	}
+	if restore.PVCName == "" {
+		return errors.New("restore pvcName is necessary")
+	}
+	if restore.Capacity != "" {
+		if err := pvcCapacityValidate(restore.Capacity); err != nil {
+			return err
+		}
+	}
+	for _, m := range restore.AccessModes {
+		switch m {
+		case corev1.ReadWriteOnce, corev1.ReadOnlyMany, corev1.ReadWriteMany, corev1.ReadWriteOncePod:
+		default:
+			return fmt.Errorf("pvc access mode: %s is not supported", m)
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	es "errors"
+	"fmt"
+	"regexp"
+
+	"github.com/bytedance/sonic"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+)
+
+// FieldManager owns the fields kube-ctl applies, other tools keep the fields they manage.
+const FieldManager = "kube-ctl"
+
+type forceKey struct{}
+
+// WithForceConflicts makes the applies made with the returned context take over
+// the conflicting fields from their current managers instead of failing.
+// Evictions made with it skip the disruption budget check as well.
+func WithForceConflicts(ctx context.Context) context.Context {
+	return context.WithValue(ctx, forceKey{}, true)
+}
+
+func isForced(ctx context.Context) bool {
+	force, _ := ctx.Value(forceKey{}).(bool)
+
+	return force
+}
+
+func applyOptions(ctx context.Context) metav1.PatchOptions {
+	force := isForced(ctx)
+
+	return metav1.PatchOptions{
+		FieldManager: FieldManager,
+		Force:        &force,
+		DryRun:       dryRunOption(ctx),
+	}
+}
+
+// ApplyConflictError is returned when applied fields are owned by other managers with different values.
+type ApplyConflictError struct {
+	Kind      string
+	Name      string
+	Conflicts []resp.FieldConflict
+}
+
+func (e *ApplyConflictError) Error() string {
+	return fmt.Sprintf("apply %s %s conflicts with %d fields owned by other managers, force to take them over",
+		e.Kind, e.Name, len(e.Conflicts))
+}
+
+type applyClient[T runtime.Object] interface {
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (T, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions, subresources ...string) (T, error)
+}
+
+// getLive returns the stored object, found is false when it does not exist yet.
+func getLive[T runtime.Object](ctx context.Context, client applyClient[T], name string) (live T, found bool, err error) {
+	live, err = client.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		var zero T
+		if errors.IsNotFound(err) {
+			return zero, false, nil
+		}
+		return zero, false, err
+	}
+
+	return live, true, nil
+}
+
+// applyObject creates or updates obj with server-side apply. Fields left out of obj
+// stay with the tools that set them, and a non-empty resourceVersion is a precondition.
+func applyObject[T runtime.Object](ctx context.Context, client applyClient[T], obj metav1.Object, live T, found bool, resourceVersion string) error {
+	kind, err := objectKind(obj.(runtime.Object))
+	if err != nil {
+		return err
+	}
+	if !found {
+		if err := deletedConflict(kind, obj.GetName(), resourceVersion); err != nil {
+			return err
+		}
+	}
+	obj.SetResourceVersion(resourceVersion)
+
+	data, err := applyPatch(obj)
+	if err != nil {
+		return err
+	}
+	res, err := client.Patch(ctx, obj.GetName(), types.ApplyPatchType, data, applyOptions(ctx))
+	if found {
+		recordDryRun(ctx, DryRunUpdate, live, res)
+	} else {
+		recordDryRun(ctx, DryRunCreate, nil, res)
+	}
+
+	return applyError(ctx, err, kind, obj.GetName(), client.Get)
+}
+
+// objectKind fills in the apiVersion and kind the apply body needs, typed objects leave them empty.
+func objectKind(obj runtime.Object) (string, error) {
+	gvks, _, err := scheme.Scheme.ObjectKinds(obj)
+	if err != nil {
+		return "", err
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvks[0])
+
+	return gvks[0].Kind, nil
+}
+
+// applyPatch renders obj as the apply body. The status and the metadata set by the server are left out,
+// they are not ours to own.
+func applyPatch(obj any) ([]byte, error) {
+	raw, err := sonic.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]any)
+	if err := sonic.Unmarshal(raw, &m); err != nil {
+		return nil, err
+	}
+
+	delete(m, "status")
+	if meta, ok := m["metadata"].(map[string]any); ok {
+		for _, f := range []string{"creationTimestamp", "managedFields", "uid", "generation", "selfLink"} {
+			delete(meta, f)
+		}
+	}
+
+	return sonic.Marshal(m)
+}
+
+var conflictManagerRegexp = regexp.MustCompile(`conflict with "([^"]*)"`)
+
+// applyError lists the field ownership conflicts of a failed apply, a stale resourceVersion goes to conflictError.
+func applyError[T any](ctx context.Context, err error, kind string, name string,
+	get func(ctx context.Context, name string, opts metav1.GetOptions) (T, error)) error {
+	var status errors.APIStatus
+	if err == nil || !errors.IsConflict(err) || !es.As(err, &status) || status.Status().Details == nil {
+		return conflictError(ctx, err, kind, name, get)
+	}
+
+	conflicts := make([]resp.FieldConflict, 0)
+	for _, cause := range status.Status().Details.Causes {
+		if cause.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+		conflict := resp.FieldConflict{Field: cause.Field, Message: cause.Message}
+		if m := conflictManagerRegexp.FindStringSubmatch(cause.Message); m != nil {
+			conflict.Manager = m[1]
+		}
+		conflicts = append(conflicts, conflict)
+	}
+	if len(conflicts) == 0 {
+		return conflictError(ctx, err, kind, name, get)
+	}
+
+	return &ApplyConflictError{Kind: kind, Name: name, Conflicts: conflicts}
+}
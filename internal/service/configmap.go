@@ -2,20 +2,38 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"unicode/utf8"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+	"github.com/crazyfrankie/kube-ctl/pkg/consts"
+)
+
+var (
+	ErrConfigMapImmutable = fmt.Errorf("configmap is immutable")
+	ErrConfigMapTooLarge  = fmt.Errorf("configmap exceeds the size limit")
 )
 
 type ConfigMapService interface {
 	CreateOrUpdateConfigMap(ctx context.Context, req *req.ConfigMap) error
+	// UploadConfigMapFiles merges files into the configmap keyed by file name, creating it if missing.
+	UploadConfigMapFiles(ctx context.Context, namespace string, name string, files map[string][]byte) error
 	GetConfigMap(ctx context.Context, name string, namespace string) (*corev1.ConfigMap, error)
 	GetConfigMapList(ctx context.Context, namespace string) ([]corev1.ConfigMap, error)
 	DeleteConfigMap(ctx context.Context, name string, namespace string) error
+	// GetConsumers lists the workloads and bare pods that use the configmap.
+	GetConsumers(ctx context.Context, name string, namespace string) ([]resp.Consumer, error)
+	// RestartConsumers rolls out the restartable consumers so they pick up the new data.
+	RestartConsumers(ctx context.Context, name string, namespace string) ([]resp.Consumer, error)
 }
 
 type configMapService struct {
@@ -29,20 +47,99 @@ func NewConfigMapService(cs *kubernetes.Clientset) ConfigMapService {
 func (s *configMapService) CreateOrUpdateConfigMap(ctx context.Context, req *req.ConfigMap) error {
 	cm := convert.CMReqConvert(req)
 
-	if _, err := s.clientSet.CoreV1().ConfigMaps(cm.Namespace).Get(ctx, cm.Name, metav1.GetOptions{}); err == nil {
-		_, err := s.clientSet.CoreV1().ConfigMaps(cm.Namespace).Update(ctx, cm, metav1.UpdateOptions{})
-		if err != nil {
+	client := s.clientSet.CoreV1().ConfigMaps(cm.Namespace)
+	old, found, err := getLive(ctx, client, cm.Name)
+	if err != nil {
+		return err
+	}
+
+	// the detail only shows the size of binary keys, so a form never sends them back:
+	// the live keys are kept unless replaced, removed or moved to data
+	if found && len(old.BinaryData) > 0 {
+		binary := make(map[string][]byte, len(old.BinaryData)+len(cm.BinaryData))
+		maps.Copy(binary, old.BinaryData)
+		maps.Copy(binary, cm.BinaryData)
+		for _, key := range req.RemoveBinaryKeys {
+			delete(binary, key)
+		}
+		for key := range cm.Data {
+			delete(binary, key)
+		}
+		cm.BinaryData = binary
+	}
+	if size := convert.ConfigMapSize(cm); size > consts.ConfigMapMaxSize {
+		return fmt.Errorf("%w: %d bytes, limit %d bytes", ErrConfigMapTooLarge, size, consts.ConfigMapMaxSize)
+	}
+
+	if found && isImmutable(old) {
+		if !isImmutable(cm) {
+			return fmt.Errorf("%w: %s can not be made mutable again", ErrConfigMapImmutable, cm.Name)
+		}
+		if !equality.Semantic.DeepEqual(old.Data, cm.Data) || !equality.Semantic.DeepEqual(old.BinaryData, cm.BinaryData) {
+			return fmt.Errorf("%w: %s only allows label changes", ErrConfigMapImmutable, cm.Name)
+		}
+	}
+
+	return applyObject(ctx, client, cm, old, found, req.ResourceVersion)
+}
+
+// UploadConfigMapFiles stores one key per file like kubectl create configmap --from-file,
+// UTF-8 content goes to data and anything else to binaryData.
+func (s *configMapService) UploadConfigMapFiles(ctx context.Context, namespace string, name string, files map[string][]byte) error {
+	cm, err := s.clientSet.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	create := false
+	if err != nil {
+		if !errors.IsNotFound(err) {
 			return err
 		}
+		create = true
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+		}
+	}
+	if isImmutable(cm) {
+		return fmt.Errorf("%w: %s", ErrConfigMapImmutable, name)
+	}
+	live := cm.DeepCopy()
 
-		return nil
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+	if cm.BinaryData == nil {
+		cm.BinaryData = make(map[string][]byte)
+	}
+	for key, content := range files {
+		if utf8.Valid(content) {
+			cm.Data[key] = string(content)
+			delete(cm.BinaryData, key)
+		} else {
+			cm.BinaryData[key] = content
+			delete(cm.Data, key)
+		}
+	}
+	if size := convert.ConfigMapSize(cm); size > consts.ConfigMapMaxSize {
+		return fmt.Errorf("%w: %d bytes, limit %d bytes", ErrConfigMapTooLarge, size, consts.ConfigMapMaxSize)
 	}
 
-	_, err := s.clientSet.CoreV1().ConfigMaps(cm.Namespace).Create(ctx, cm, metav1.CreateOptions{})
+	var res *corev1.ConfigMap
+	if create {
+		res, err = s.clientSet.CoreV1().ConfigMaps(namespace).Create(ctx, cm, createOptions(ctx))
+		recordDryRun(ctx, DryRunCreate, nil, res)
+	} else {
+		res, err = s.clientSet.CoreV1().ConfigMaps(namespace).Update(ctx, cm, updateOptions(ctx))
+		recordDryRun(ctx, DryRunUpdate, live, res)
+	}
 
 	return err
 }
 
+func isImmutable(cm *corev1.ConfigMap) bool {
+	return cm.Immutable != nil && *cm.Immutable
+}
+
 func (s *configMapService) GetConfigMap(ctx context.Context, name string, namespace string) (*corev1.ConfigMap, error) {
 	res, err := s.clientSet.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
@@ -64,3 +161,21 @@ func (s *configMapService) GetConfigMapList(ctx context.Context, namespace strin
 func (s *configMapService) DeleteConfigMap(ctx context.Context, name string, namespace string) error {
 	return s.clientSet.CoreV1().ConfigMaps(namespace).Delete(ctx, name, metav1.DeleteOptions{})
 }
+
+func (s *configMapService) GetConsumers(ctx context.Context, name string, namespace string) ([]resp.Consumer, error) {
+	index, err := buildReferenceIndex(ctx, s.clientSet, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return index.Consumers(RefKindConfigMap, name), nil
+}
+
+func (s *configMapService) RestartConsumers(ctx context.Context, name string, namespace string) ([]resp.Consumer, error) {
+	consumers, err := s.GetConsumers(ctx, name, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return restartConsumers(ctx, s.clientSet, namespace, consumers)
+}
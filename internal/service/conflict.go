@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConflictError is returned when an update is based on a stale resourceVersion.
+// Current is the object as stored now, nil when it has been deleted meanwhile.
+type ConflictError struct {
+	Kind    string
+	Name    string
+	Current any
+}
+
+func (e *ConflictError) Error() string {
+	if e.Current == nil {
+		return fmt.Sprintf("%s %s has been deleted since it was read", e.Kind, e.Name)
+	}
+
+	return fmt.Sprintf("%s %s has been modified since it was read, reload or merge the changes", e.Kind, e.Name)
+}
+
+// conflictError replaces a 409 from the api server by a ConflictError holding the stored object.
+func conflictError[T any](ctx context.Context, err error, kind string, name string,
+	get func(ctx context.Context, name string, opts metav1.GetOptions) (T, error)) error {
+	if !errors.IsConflict(err) {
+		return err
+	}
+
+	conflict := &ConflictError{Kind: kind, Name: name}
+	if current, getErr := get(ctx, name, metav1.GetOptions{}); getErr == nil {
+		conflict.Current = current
+	}
+
+	return conflict
+}
+
+// checkVersion guards writes that are not conditional on the server, such as delete and recreate.
+func checkVersion(kind string, name string, resourceVersion string, live metav1.Object) error {
+	if resourceVersion == "" || resourceVersion == live.GetResourceVersion() {
+		return nil
+	}
+
+	return &ConflictError{Kind: kind, Name: name, Current: live}
+}
+
+// deletedConflict reports an edit of an object that no longer exists, instead of creating it again.
+func deletedConflict(kind string, name string, resourceVersion string) error {
+	if resourceVersion == "" {
+		return nil
+	}
+
+	return &ConflictError{Kind: kind, Name: name}
+}
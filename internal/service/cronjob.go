@@ -4,11 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/bytedance/sonic"
 	batchv1 "k8s.io/api/batch/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 
@@ -21,6 +26,12 @@ type CronJobService interface {
 	DeleteCronJob(ctx context.Context, name string, namespace string) error
 	GetCronJobDetail(ctx context.Context, name string, namespace string) (*batchv1.CronJob, error)
 	GetCronJobList(ctx context.Context, namespace string) ([]batchv1.CronJob, error)
+	// RunCronJob starts a Job from the job template right away and returns it.
+	RunCronJob(ctx context.Context, name string, namespace string) (*batchv1.Job, error)
+	// SuspendCronJob stops scheduling new Jobs, resuming schedules them again.
+	SuspendCronJob(ctx context.Context, name string, namespace string, suspend bool) error
+	// GetCronJobJobs lists the Jobs the CronJob owns, newest first.
+	GetCronJobJobs(ctx context.Context, cron *batchv1.CronJob) ([]batchv1.Job, error)
 }
 
 func NewCronJobService(cs *kubernetes.Clientset) CronJobService {
@@ -33,73 +44,14 @@ type cronJobService struct {
 
 func (s *cronJobService) CreateOrUpdateCronJob(ctx context.Context, req *req.CronJob) error {
 	cron := convert.CronJobReqConvert(req)
+	client := s.clientSet.BatchV1().CronJobs(cron.Namespace)
 
-	if exists, err := s.clientSet.BatchV1().CronJobs(cron.Namespace).Get(ctx, cron.Name, metav1.GetOptions{}); err == nil {
-		// 校验
-		cronCp := *cron
-		newName := cronCp.Name + "-validate"
-		cronCp.Name = newName
-		_, err := s.clientSet.BatchV1().CronJobs(cron.Namespace).Create(ctx, &cronCp, metav1.CreateOptions{
-			DryRun: []string{metav1.DryRunAll},
-		})
-		if err != nil {
-			return err
-		}
-		// 获取监听标签
-		var labelSelector []string
-		for k, v := range exists.Labels {
-			labelSelector = append(labelSelector, fmt.Sprintf("%s=%s", k, v))
-		}
-		var podSelector []string
-		for k, v := range exists.Spec.JobTemplate.Spec.Template.Labels {
-			podSelector = append(podSelector, fmt.Sprintf("%s=%s", k, v))
-		}
-		// 启动监听
-		watcher, err := s.clientSet.BatchV1().CronJobs(cron.Namespace).Watch(ctx, metav1.ListOptions{
-			LabelSelector: strings.Join(labelSelector, ","),
-		})
-		if err != nil {
-			return err
-		}
-		notify := make(chan error)
-
-		// 异步删除 Pod
-		go func() {
-			for {
-				select {
-				case e := <-watcher.ResultChan():
-					switch e.Type {
-					case watch.Deleted:
-						_, err = s.clientSet.BatchV1().CronJobs(cron.Namespace).Create(ctx, cron, metav1.CreateOptions{})
-						notify <- err
-						return
-					}
-				case <-time.After(time.Second * 5):
-					notify <- errors.New("update cronjob timeout")
-					return
-				}
-			}
-		}()
-		// 删除 cronjob
-		background := metav1.DeletePropagationForeground
-		err = s.clientSet.CoreV1().Pods(cron.Namespace).Delete(ctx, exists.Name, metav1.DeleteOptions{
-			PropagationPolicy: &background,
-		})
-		if err != nil {
-			return err
-		}
-		//监听删除后重新创建的信息
-		select {
-		case errx := <-notify:
-			if errx != nil {
-				return errx
-			}
-		}
+	live, found, err := getLive(ctx, client, cron.Name)
+	if err != nil {
+		return err
 	}
 
-	_, err := s.clientSet.BatchV1().CronJobs(cron.Namespace).Create(ctx, cron, metav1.CreateOptions{})
-
-	return err
+	return applyObject(ctx, client, cron, live, found, req.ResourceVersion)
 }
 
 func (s *cronJobService) DeleteCronJob(ctx context.Context, name string, namespace string) error {
@@ -170,3 +122,74 @@ func (s *cronJobService) GetCronJobList(ctx context.Context, namespace string) (
 
 	return res.Items, nil
 }
+
+func (s *cronJobService) RunCronJob(ctx context.Context, name string, namespace string) (*batchv1.Job, error) {
+	cron, err := s.clientSet.BatchV1().CronJobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	// the job name ends up in a label value, keep it within 63 characters
+	suffix := "-manual-" + utilrand.String(5)
+	base := cron.Name
+	if len(base)+len(suffix) > validation.LabelValueMaxLength {
+		base = base[:validation.LabelValueMaxLength-len(suffix)]
+	}
+
+	annotations := make(map[string]string, len(cron.Spec.JobTemplate.Annotations)+1)
+	for k, v := range cron.Spec.JobTemplate.Annotations {
+		annotations[k] = v
+	}
+	annotations[convert.CronJobManualAnnotation] = "manual"
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        base + suffix,
+			Namespace:   cron.Namespace,
+			Labels:      cron.Spec.JobTemplate.Labels,
+			Annotations: annotations,
+			// owned like a scheduled run, so it shows up in the history and follows the history limits
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(cron, batchv1.SchemeGroupVersion.WithKind("CronJob")),
+			},
+		},
+		Spec: *cron.Spec.JobTemplate.Spec.DeepCopy(),
+	}
+	job.Spec.Template.Labels = convert.JobTemplateLabels(job.Spec.Template.Labels)
+
+	return s.clientSet.BatchV1().Jobs(namespace).Create(ctx, job, metav1.CreateOptions{})
+}
+
+func (s *cronJobService) SuspendCronJob(ctx context.Context, name string, namespace string, suspend bool) error {
+	patch := map[string]any{
+		"spec": map[string]any{
+			"suspend": suspend,
+		},
+	}
+	data, err := sonic.Marshal(&patch)
+	if err != nil {
+		return err
+	}
+	_, err = s.clientSet.BatchV1().CronJobs(namespace).Patch(ctx, name, types.MergePatchType, data, metav1.PatchOptions{})
+
+	return err
+}
+
+func (s *cronJobService) GetCronJobJobs(ctx context.Context, cron *batchv1.CronJob) ([]batchv1.Job, error) {
+	list, err := s.clientSet.BatchV1().Jobs(cron.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]batchv1.Job, 0, len(cron.Status.Active))
+	for _, job := range list.Items {
+		if ref := metav1.GetControllerOf(&job); ref != nil && ref.UID == cron.UID {
+			res = append(res, job)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[j].CreationTimestamp.Before(&res[i].CreationTimestamp)
+	})
+
+	return res, nil
+}
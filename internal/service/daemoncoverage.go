@@ -0,0 +1,330 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+)
+
+const (
+	CoverageRunning      = "Running"
+	CoveragePending      = "Pending"
+	CoverageMissing      = "Missing"
+	CoverageSkipped      = "Skipped"
+	CoverageMisscheduled = "Misscheduled"
+)
+
+// daemonTolerations are added to every daemon pod by the DaemonSet controller,
+// so that agents keep running on nodes under pressure or being drained.
+var daemonTolerations = []corev1.Toleration{
+	{Key: corev1.TaintNodeNotReady, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute},
+	{Key: corev1.TaintNodeUnreachable, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute},
+	{Key: corev1.TaintNodeDiskPressure, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+	{Key: corev1.TaintNodeMemoryPressure, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+	{Key: corev1.TaintNodePIDPressure, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+	{Key: corev1.TaintNodeUnschedulable, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+}
+
+// coverageInput holds the DaemonSet with the nodes and the non-terminal pods of the cluster.
+type coverageInput struct {
+	daemon *appsv1.DaemonSet
+	nodes  []corev1.Node
+	pods   []corev1.Pod
+}
+
+// analyzeCoverage decides for every node whether the DaemonSet should run there the way its controller does:
+// the nodeSelector and the required node affinity must match and the taints must be tolerated. A pod already
+// running is kept on a NoSchedule taint, it is misscheduled when the node no longer matches or evicts it.
+func analyzeCoverage(in *coverageInput) *resp.DaemonSetCoverage {
+	res := &resp.DaemonSetCoverage{
+		Desired:      in.daemon.Status.DesiredNumberScheduled,
+		Scheduled:    in.daemon.Status.CurrentNumberScheduled,
+		Ready:        in.daemon.Status.NumberReady,
+		UpToDate:     in.daemon.Status.UpdatedNumberScheduled,
+		Misscheduled: in.daemon.Status.NumberMisscheduled,
+		Nodes:        make([]resp.DaemonSetNode, 0, len(in.nodes)),
+	}
+
+	daemonPods := make(map[string][]*corev1.Pod)
+	otherPods := make(map[string][]*corev1.Pod)
+	for i := range in.pods {
+		pod := &in.pods[i]
+		if isDaemonPod(in.daemon, pod) {
+			node := daemonPodNode(pod)
+			daemonPods[node] = append(daemonPods[node], pod)
+		} else if pod.Spec.NodeName != "" {
+			otherPods[pod.Spec.NodeName] = append(otherPods[pod.Spec.NodeName], pod)
+		}
+	}
+
+	spec := in.daemon.Spec.Template.Spec.DeepCopy()
+	spec.Tolerations = append(spec.Tolerations, daemonTolerations...)
+	if spec.HostNetwork {
+		spec.Tolerations = append(spec.Tolerations, corev1.Toleration{
+			Key: corev1.TaintNodeNetworkUnavailable, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule,
+		})
+	}
+
+	nodes := make([]corev1.Node, len(in.nodes))
+	copy(nodes, in.nodes)
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+	for i := range nodes {
+		node := &nodes[i]
+		reasons, evicted := nodeReasons(spec, node)
+		item := resp.DaemonSetNode{Node: node.Name, Reasons: []string{}}
+
+		pod := pickDaemonPod(daemonPods[node.Name])
+		switch {
+		case pod == nil && len(reasons) > 0:
+			item.State = CoverageSkipped
+			item.Reasons = reasons
+		case pod == nil:
+			item.State = CoverageMissing
+			item.Reasons = resourceReasons(spec, node, otherPods[node.Name])
+			if len(item.Reasons) == 0 {
+				item.Reasons = append(item.Reasons, "waiting for the DaemonSet controller to create the pod")
+			}
+		case evicted:
+			item.State = CoverageMisscheduled
+			item.Pod = pod.Name
+			item.Reasons = reasons
+		default:
+			item.Pod = pod.Name
+			item.Ready = isPodReady(pod)
+			if pod.Status.Phase == corev1.PodRunning {
+				item.State = CoverageRunning
+				break
+			}
+			item.State = CoveragePending
+			if msg := unschedulableMessage(pod); msg != "" {
+				item.Reasons = append(item.Reasons, msg)
+				item.Reasons = append(item.Reasons, resourceReasons(spec, node, otherPods[node.Name])...)
+			}
+		}
+		res.Nodes = append(res.Nodes, item)
+	}
+
+	return res
+}
+
+func isDaemonPod(daemon *appsv1.DaemonSet, pod *corev1.Pod) bool {
+	ref := metav1.GetControllerOf(pod)
+
+	return ref != nil && ref.UID == daemon.UID
+}
+
+// daemonPodNode returns the node a daemon pod is bound to, or targeted at by the node affinity
+// the controller sets while the pod waits for the scheduler.
+func daemonPodNode(pod *corev1.Pod) string {
+	if pod.Spec.NodeName != "" {
+		return pod.Spec.NodeName
+	}
+	affinity := pod.Spec.Affinity
+	if affinity == nil || affinity.NodeAffinity == nil || affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return ""
+	}
+	for _, term := range affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+		for _, field := range term.MatchFields {
+			if field.Key == metav1.ObjectNameField && field.Operator == corev1.NodeSelectorOpIn && len(field.Values) == 1 {
+				return field.Values[0]
+			}
+		}
+	}
+
+	return ""
+}
+
+// pickDaemonPod prefers the running pod when a surge update runs two pods on the node.
+func pickDaemonPod(pods []*corev1.Pod) *corev1.Pod {
+	var res *corev1.Pod
+	for _, p := range pods {
+		if p.DeletionTimestamp != nil {
+			continue
+		}
+		if res == nil || (p.Status.Phase == corev1.PodRunning && res.Status.Phase != corev1.PodRunning) {
+			res = p
+		}
+	}
+	if res == nil && len(pods) > 0 {
+		res = pods[0]
+	}
+
+	return res
+}
+
+// nodeReasons lists why a daemon pod is not placed on the node, evicted is set when
+// a pod already there has to go as well.
+func nodeReasons(spec *corev1.PodSpec, node *corev1.Node) (reasons []string, evicted bool) {
+	if spec.NodeName != "" && spec.NodeName != node.Name {
+		reasons = append(reasons, fmt.Sprintf("pod template is pinned to node %s", spec.NodeName))
+		evicted = true
+	}
+	keys := make([]string, 0, len(spec.NodeSelector))
+	for k := range spec.NodeSelector {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if v, ok := node.Labels[k]; !ok || v != spec.NodeSelector[k] {
+			reasons = append(reasons, fmt.Sprintf("nodeSelector %s=%s not matched", k, spec.NodeSelector[k]))
+			evicted = true
+		}
+	}
+	if affinity := spec.Affinity; affinity != nil && affinity.NodeAffinity != nil {
+		if required := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution; required != nil &&
+			!nodeSelectorTermsMatch(required.NodeSelectorTerms, node) {
+			reasons = append(reasons, "required node affinity not matched")
+			evicted = true
+		}
+	}
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Effect == corev1.TaintEffectPreferNoSchedule || toleratesAll(spec.Tolerations, []corev1.Taint{*taint}) {
+			continue
+		}
+		reasons = append(reasons, fmt.Sprintf("taint %s not tolerated", taint.ToString()))
+		if taint.Effect == corev1.TaintEffectNoExecute {
+			evicted = true
+		}
+	}
+
+	return reasons, evicted
+}
+
+// nodeSelectorTermsMatch reports whether any of the terms matches, the expressions of a term all have to.
+func nodeSelectorTermsMatch(terms []corev1.NodeSelectorTerm, node *corev1.Node) bool {
+	for _, term := range terms {
+		if len(term.MatchExpressions) == 0 && len(term.MatchFields) == 0 {
+			continue
+		}
+		if requirementsMatch(term.MatchExpressions, node.Labels) &&
+			requirementsMatch(term.MatchFields, map[string]string{metav1.ObjectNameField: node.Name}) {
+			return true
+		}
+	}
+
+	return false
+}
+
+var nodeSelectorOperators = map[corev1.NodeSelectorOperator]selection.Operator{
+	corev1.NodeSelectorOpIn:           selection.In,
+	corev1.NodeSelectorOpNotIn:        selection.NotIn,
+	corev1.NodeSelectorOpExists:       selection.Exists,
+	corev1.NodeSelectorOpDoesNotExist: selection.DoesNotExist,
+	corev1.NodeSelectorOpGt:           selection.GreaterThan,
+	corev1.NodeSelectorOpLt:           selection.LessThan,
+}
+
+func requirementsMatch(requirements []corev1.NodeSelectorRequirement, set map[string]string) bool {
+	for _, r := range requirements {
+		op, ok := nodeSelectorOperators[r.Operator]
+		if !ok {
+			return false
+		}
+		req, err := labels.NewRequirement(r.Key, op, r.Values)
+		if err != nil || !req.Matches(labels.Set(set)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// resourceReasons compares the requests of the daemon pod with what the other pods leave free on the node.
+func resourceReasons(spec *corev1.PodSpec, node *corev1.Node, pods []*corev1.Pod) []string {
+	reasons := make([]string, 0)
+	if allocatable, ok := node.Status.Allocatable[corev1.ResourcePods]; ok && int64(len(pods)) >= allocatable.Value() {
+		reasons = append(reasons, fmt.Sprintf("too many pods: %d of %d", len(pods), allocatable.Value()))
+	}
+
+	requests := podRequests(spec)
+	used := make(corev1.ResourceList)
+	for _, p := range pods {
+		for name, q := range podRequests(&p.Spec) {
+			sum := used[name]
+			sum.Add(q)
+			used[name] = sum
+		}
+	}
+	names := make([]string, 0, len(requests))
+	for name := range requests {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		name := corev1.ResourceName(n)
+		allocatable, ok := node.Status.Allocatable[name]
+		if !ok {
+			continue
+		}
+		free := allocatable.DeepCopy()
+		free.Sub(used[name])
+		if need := requests[name]; need.Cmp(free) > 0 {
+			reasons = append(reasons, fmt.Sprintf("insufficient %s: requests %s, %s free of %s",
+				name, need.String(), free.String(), allocatable.String()))
+		}
+	}
+
+	return reasons
+}
+
+// podRequests is what the scheduler reserves for a pod: the sum of the containers,
+// at least the largest init container, plus the overhead.
+func podRequests(spec *corev1.PodSpec) corev1.ResourceList {
+	res := make(corev1.ResourceList)
+	for _, c := range spec.Containers {
+		for name, q := range c.Resources.Requests {
+			sum := res[name]
+			sum.Add(q)
+			res[name] = sum
+		}
+	}
+	for _, c := range spec.InitContainers {
+		for name, q := range c.Resources.Requests {
+			if cur, ok := res[name]; !ok || q.Cmp(cur) > 0 {
+				res[name] = q.DeepCopy()
+			}
+		}
+	}
+	for name, q := range spec.Overhead {
+		sum := res[name]
+		sum.Add(q)
+		res[name] = sum
+	}
+	for name, q := range res {
+		if q.IsZero() {
+			delete(res, name)
+		}
+	}
+
+	return res
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+func unschedulableMessage(pod *corev1.Pod) string {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodScheduled && c.Status == corev1.ConditionFalse {
+			return c.Message
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+)
+
+func coverageDaemonSet() *appsv1.DaemonSet {
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "agent", UID: types.UID("agent-uid")},
+		Spec: appsv1.DaemonSetSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					NodeSelector: map[string]string{"role": "worker"},
+					Tolerations: []corev1.Toleration{
+						{Key: "tolerated", Operator: corev1.TolerationOpExists},
+					},
+					Containers: []corev1.Container{{
+						Name: "agent",
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("200m")},
+						},
+					}},
+				},
+			},
+		},
+	}
+}
+
+func coverageNode(name string, role string, taints ...corev1.Taint) corev1.Node {
+	return corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"role": role}},
+		Spec:       corev1.NodeSpec{Taints: taints},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:  resource.MustParse("1"),
+				corev1.ResourcePods: resource.MustParse("110"),
+			},
+		},
+	}
+}
+
+func coverageDaemonPod(daemon *appsv1.DaemonSet, node string, phase corev1.PodPhase) corev1.Pod {
+	controller := true
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: daemon.Namespace,
+			Name:      daemon.Name + "-" + node,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1", Kind: "DaemonSet", Name: daemon.Name, UID: daemon.UID, Controller: &controller,
+			}},
+		},
+		Spec:   corev1.PodSpec{NodeName: node},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+}
+
+func TestAnalyzeCoverage(t *testing.T) {
+	daemon := coverageDaemonSet()
+	busy := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "busy"},
+		Spec: corev1.PodSpec{
+			NodeName: "full",
+			Containers: []corev1.Container{{
+				Name: "busy",
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("900m")},
+				},
+			}},
+		},
+	}
+
+	in := &coverageInput{
+		daemon: daemon,
+		nodes: []corev1.Node{
+			coverageNode("running", "worker"),
+			coverageNode("tainted", "worker", corev1.Taint{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule}),
+			coverageNode("tolerated", "worker", corev1.Taint{Key: "tolerated", Effect: corev1.TaintEffectNoSchedule}),
+			coverageNode("cordoned", "worker", corev1.Taint{Key: corev1.TaintNodeUnschedulable, Effect: corev1.TaintEffectNoSchedule}),
+			coverageNode("kept", "worker", corev1.Taint{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule}),
+			coverageNode("evicted", "worker", corev1.Taint{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoExecute}),
+			coverageNode("control-plane", "master"),
+			coverageNode("relabelled", "master"),
+			coverageNode("full", "worker"),
+		},
+		pods: []corev1.Pod{
+			coverageDaemonPod(daemon, "running", corev1.PodRunning),
+			coverageDaemonPod(daemon, "kept", corev1.PodRunning),
+			coverageDaemonPod(daemon, "evicted", corev1.PodRunning),
+			coverageDaemonPod(daemon, "relabelled", corev1.PodRunning),
+			busy,
+		},
+	}
+
+	tests := []struct {
+		node   string
+		state  string
+		reason string // part of one of the reasons, empty when there should be none
+	}{
+		{node: "running", state: CoverageRunning},
+		{node: "tainted", state: CoverageSkipped, reason: "taint dedicated=gpu:NoSchedule not tolerated"},
+		{node: "tolerated", state: CoverageMissing, reason: "waiting for the DaemonSet controller"},
+		{node: "cordoned", state: CoverageMissing, reason: "waiting for the DaemonSet controller"},
+		{node: "kept", state: CoverageRunning},
+		{node: "evicted", state: CoverageMisscheduled, reason: "taint dedicated=gpu:NoExecute not tolerated"},
+		{node: "control-plane", state: CoverageSkipped, reason: "nodeSelector role=worker not matched"},
+		{node: "relabelled", state: CoverageMisscheduled, reason: "nodeSelector role=worker not matched"},
+		{node: "full", state: CoverageMissing, reason: "insufficient cpu: requests 200m, 100m free of 1"},
+	}
+
+	res := analyzeCoverage(in)
+	if len(res.Nodes) != len(tests) {
+		t.Fatalf("got %d nodes, want %d", len(res.Nodes), len(tests))
+	}
+	for _, tt := range tests {
+		t.Run(tt.node, func(t *testing.T) {
+			i := slices.IndexFunc(res.Nodes, func(n resp.DaemonSetNode) bool { return n.Node == tt.node })
+			if i < 0 {
+				t.Fatalf("node %s missing from the coverage", tt.node)
+			}
+			node := res.Nodes[i]
+			if node.State != tt.state {
+				t.Errorf("state = %s, want %s (reasons %v)", node.State, tt.state, node.Reasons)
+			}
+			if tt.reason == "" {
+				if len(node.Reasons) > 0 {
+					t.Errorf("reasons = %v, want none", node.Reasons)
+				}
+				return
+			}
+			if !slices.ContainsFunc(node.Reasons, func(r string) bool { return strings.Contains(r, tt.reason) }) {
+				t.Errorf("reasons = %v, want one containing %q", node.Reasons, tt.reason)
+			}
+		})
+	}
+}
+
+func TestAnalyzeCoveragePendingPod(t *testing.T) {
+	daemon := coverageDaemonSet()
+	node := coverageNode("small", "worker")
+	node.Status.Allocatable[corev1.ResourceCPU] = resource.MustParse("100m")
+	pod := coverageDaemonPod(daemon, "small", corev1.PodPending)
+	pod.Status.Conditions = []corev1.PodCondition{{
+		Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Message: "0/1 nodes are available: 1 Insufficient cpu.",
+	}}
+
+	res := analyzeCoverage(&coverageInput{daemon: daemon, nodes: []corev1.Node{node}, pods: []corev1.Pod{pod}})
+	got := res.Nodes[0]
+	if got.State != CoveragePending || got.Pod != pod.Name {
+		t.Fatalf("state = %s with pod %q, want %s with pod %q", got.State, got.Pod, CoveragePending, pod.Name)
+	}
+	want := []string{
+		"0/1 nodes are available: 1 Insufficient cpu.",
+		"insufficient cpu: requests 200m, 100m free of 100m",
+	}
+	if !slices.Equal(got.Reasons, want) {
+		t.Errorf("reasons = %v, want %v", got.Reasons, want)
+	}
+}
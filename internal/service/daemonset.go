@@ -2,13 +2,17 @@ package service
 
 import (
 	"context"
+	"time"
 
+	"github.com/bytedance/sonic"
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
 )
 
 type DaemonSetService interface {
@@ -16,6 +20,10 @@ type DaemonSetService interface {
 	DeleteDaemonSet(ctx context.Context, name string, namespace string) error
 	GetDaemonSetDetail(ctx context.Context, name string, namespace string) (*appsv1.DaemonSet, error)
 	GetDaemonSetList(ctx context.Context, namespace string) ([]appsv1.DaemonSet, error)
+	// GetCoverage tells for every node whether the daemon pod runs there, and why not.
+	GetCoverage(ctx context.Context, name string, namespace string) (*resp.DaemonSetCoverage, error)
+	// RestartDaemonSet rolls all the daemon pods following the update strategy.
+	RestartDaemonSet(ctx context.Context, name string, namespace string) error
 }
 
 type daemonSetService struct {
@@ -29,16 +37,13 @@ func NewDaemonSetService(cs *kubernetes.Clientset) DaemonSetService {
 func (s *daemonSetService) CreateOrUpdateDaemonSet(ctx context.Context, req *req.DaemonSet) error {
 	daemon := convert.DaemonSetReqConvert(req)
 
-	if exists, err := s.clientSet.AppsV1().DaemonSets(daemon.Namespace).Get(ctx, daemon.Name, metav1.GetOptions{}); err == nil {
-		exists.Spec = daemon.Spec
-		_, err := s.clientSet.AppsV1().DaemonSets(daemon.Namespace).Update(ctx, exists, metav1.UpdateOptions{})
-
+	client := s.clientSet.AppsV1().DaemonSets(daemon.Namespace)
+	live, found, err := getLive(ctx, client, daemon.Name)
+	if err != nil {
 		return err
 	}
 
-	_, err := s.clientSet.AppsV1().DaemonSets(daemon.Namespace).Create(ctx, daemon, metav1.CreateOptions{})
-
-	return err
+	return applyObject(ctx, client, daemon, live, found, req.ResourceVersion)
 }
 
 func (s *daemonSetService) DeleteDaemonSet(ctx context.Context, name string, namespace string) error {
@@ -62,3 +67,49 @@ func (s *daemonSetService) GetDaemonSetList(ctx context.Context, namespace strin
 
 	return res.Items, nil
 }
+
+func (s *daemonSetService) GetCoverage(ctx context.Context, name string, namespace string) (*resp.DaemonSetCoverage, error) {
+	daemon, err := s.clientSet.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	nodes, err := s.clientSet.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// the requests of the pods of every namespace count against the free resources of a node
+	pods, err := s.clientSet.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+		FieldSelector: "status.phase!=Succeeded,status.phase!=Failed",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return analyzeCoverage(&coverageInput{
+		daemon: daemon,
+		nodes:  nodes.Items,
+		pods:   pods.Items,
+	}), nil
+}
+
+func (s *daemonSetService) RestartDaemonSet(ctx context.Context, name string, namespace string) error {
+	// the same annotation kubectl rollout restart sets
+	patch := map[string]any{
+		"spec": map[string]any{
+			"template": map[string]any{
+				"metadata": map[string]any{
+					"annotations": map[string]string{
+						"kubectl.kubernetes.io/restartedAt": time.Now().Format(time.RFC3339),
+					},
+				},
+			},
+		},
+	}
+	data, err := sonic.Marshal(&patch)
+	if err != nil {
+		return err
+	}
+	_, err = s.clientSet.AppsV1().DaemonSets(namespace).Patch(ctx, name, types.MergePatchType, data, metav1.PatchOptions{})
+
+	return err
+}
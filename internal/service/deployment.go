@@ -4,6 +4,8 @@ import (
 	"context"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
@@ -16,6 +18,10 @@ type DeploymentService interface {
 	DeleteDeployment(ctx context.Context, name string, namespace string) error
 	GetDeploymentDetail(ctx context.Context, name string, namespace string) (*appsv1.Deployment, error)
 	GetDeploymentList(ctx context.Context, namespace string) ([]appsv1.Deployment, error)
+	// GetAutoscaler returns the HPA scaling the Deployment, nil when there is none.
+	GetAutoscaler(ctx context.Context, name string, namespace string) (*autoscalingv2.HorizontalPodAutoscaler, error)
+	// GetDisruptionBudgets returns the PDBs selecting the pods of the Deployment.
+	GetDisruptionBudgets(ctx context.Context, deploy *appsv1.Deployment) ([]policyv1.PodDisruptionBudget, error)
 }
 
 type deploymentService struct {
@@ -29,16 +35,13 @@ func NewDeploymentService(cs *kubernetes.Clientset) DeploymentService {
 func (s *deploymentService) CreateOrUpdateDeployment(ctx context.Context, req *req.Deployment) error {
 	deployment := convert.DeploymentReqConvert(req)
 
-	if exists, err := s.clientSet.AppsV1().Deployments(deployment.Namespace).Get(ctx, deployment.Name, metav1.GetOptions{}); err == nil {
-		exists.Spec = deployment.Spec
-		_, err := s.clientSet.AppsV1().Deployments(deployment.Namespace).Update(ctx, exists, metav1.UpdateOptions{})
-
+	client := s.clientSet.AppsV1().Deployments(deployment.Namespace)
+	live, found, err := getLive(ctx, client, deployment.Name)
+	if err != nil {
 		return err
 	}
 
-	_, err := s.clientSet.AppsV1().Deployments(deployment.Namespace).Create(ctx, deployment, metav1.CreateOptions{})
-
-	return err
+	return applyObject(ctx, client, deployment, live, found, req.ResourceVersion)
 }
 
 func (s *deploymentService) DeleteDeployment(ctx context.Context, name string, namespace string) error {
@@ -62,3 +65,11 @@ func (s *deploymentService) GetDeploymentList(ctx context.Context, namespace str
 
 	return res.Items, nil
 }
+
+func (s *deploymentService) GetAutoscaler(ctx context.Context, name string, namespace string) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	return workloadAutoscaler(ctx, s.clientSet, namespace, "Deployment", name)
+}
+
+func (s *deploymentService) GetDisruptionBudgets(ctx context.Context, deploy *appsv1.Deployment) ([]policyv1.PodDisruptionBudget, error) {
+	return podDisruptionBudgets(ctx, s.clientSet, deploy.Namespace, deploy.Spec.Template.Labels)
+}
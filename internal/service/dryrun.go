@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/bytedance/sonic"
+	"github.com/pmezard/go-difflib/difflib"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+	"github.com/crazyfrankie/kube-ctl/pkg/consts"
+)
+
+const (
+	DryRunCreate   = "create"
+	DryRunUpdate   = "update"
+	DryRunRecreate = "recreate"
+)
+
+type dryRunKey struct{}
+
+// DryRunRecorder turns the writes of a service call into server-side dry runs
+// and keeps the live object and the result for the diff.
+type DryRunRecorder struct {
+	operation string
+	live      any
+	result    any
+}
+
+// WithDryRun marks every write made with the returned context as dry run.
+func WithDryRun(ctx context.Context) (context.Context, *DryRunRecorder) {
+	rec := &DryRunRecorder{}
+
+	return context.WithValue(ctx, dryRunKey{}, rec), rec
+}
+
+func dryRunRecorder(ctx context.Context) *DryRunRecorder {
+	rec, _ := ctx.Value(dryRunKey{}).(*DryRunRecorder)
+
+	return rec
+}
+
+func isDryRun(ctx context.Context) bool {
+	return dryRunRecorder(ctx) != nil
+}
+
+func dryRunOption(ctx context.Context) []string {
+	if isDryRun(ctx) {
+		return []string{metav1.DryRunAll}
+	}
+
+	return nil
+}
+
+func createOptions(ctx context.Context) metav1.CreateOptions {
+	return metav1.CreateOptions{DryRun: dryRunOption(ctx)}
+}
+
+func updateOptions(ctx context.Context) metav1.UpdateOptions {
+	return metav1.UpdateOptions{DryRun: dryRunOption(ctx)}
+}
+
+func patchOptions(ctx context.Context) metav1.PatchOptions {
+	return metav1.PatchOptions{DryRun: dryRunOption(ctx)}
+}
+
+// recordDryRun keeps the objects of a dry-run write, live is nil for a create.
+func recordDryRun(ctx context.Context, operation string, live any, result any) {
+	if rec := dryRunRecorder(ctx); rec != nil {
+		rec.operation = operation
+		rec.live = live
+		rec.result = result
+	}
+}
+
+// Diff lists the changed fields and renders a unified YAML diff.
+// Server managed metadata and status are left out, secret values are replaced by a fingerprint.
+func (r *DryRunRecorder) Diff() (*resp.DryRunDiff, error) {
+	if r.result == nil {
+		return nil, fmt.Errorf("no write was made")
+	}
+
+	live, err := normalizeObject(r.live)
+	if err != nil {
+		return nil, err
+	}
+	result, err := normalizeObject(r.result)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]resp.FieldChange, 0)
+	diffFields("", live, result, &changes)
+
+	liveYAML, err := toYAML(live)
+	if err != nil {
+		return nil, err
+	}
+	resultYAML, err := toYAML(result)
+	if err != nil {
+		return nil, err
+	}
+	unified, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(liveYAML),
+		B:        difflib.SplitLines(resultYAML),
+		FromFile: "live",
+		ToFile:   "dry-run",
+		Context:  3,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.DryRunDiff{
+		Operation: r.operation,
+		Changes:   changes,
+		Diff:      unified,
+	}, nil
+}
+
+func normalizeObject(obj any) (map[string]any, error) {
+	if v := reflect.ValueOf(obj); obj == nil || (v.Kind() == reflect.Pointer || v.Kind() == reflect.Slice) && v.IsNil() {
+		return nil, nil
+	}
+	if secret, ok := obj.(*corev1.Secret); ok {
+		obj = maskSecret(secret)
+	}
+
+	var raw []byte
+	if b, ok := obj.([]byte); ok {
+		raw = b
+	} else {
+		var err error
+		if raw, err = sonic.Marshal(obj); err != nil {
+			return nil, err
+		}
+	}
+	m := make(map[string]any)
+	if err := sonic.Unmarshal(raw, &m); err != nil {
+		return nil, err
+	}
+
+	delete(m, "status")
+	if meta, ok := m["metadata"].(map[string]any); ok {
+		for _, f := range []string{"managedFields", "resourceVersion", "uid", "creationTimestamp", "generation", "selfLink"} {
+			delete(meta, f)
+		}
+	}
+
+	return m, nil
+}
+
+// maskSecret keeps changes visible without exposing values.
+func maskSecret(secret *corev1.Secret) *corev1.Secret {
+	masked := secret.DeepCopy()
+	for k, v := range masked.Data {
+		sum := sha256.Sum256(v)
+		masked.Data[k] = []byte(fmt.Sprintf("%s(sha256:%s)", consts.SecretMaskValue, hex.EncodeToString(sum[:4])))
+	}
+	masked.StringData = nil
+
+	return masked
+}
+
+func toYAML(m map[string]any) (string, error) {
+	if m == nil {
+		return "", nil
+	}
+	out, err := yaml.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+
+	return string(out), nil
+}
+
+var identRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func fieldPath(parent string, key string) string {
+	if !identRegexp.MatchString(key) {
+		return fmt.Sprintf("%s[%q]", parent, key)
+	}
+	if parent == "" {
+		return key
+	}
+
+	return parent + "." + key
+}
+
+func diffFields(path string, old any, new any, changes *[]resp.FieldChange) {
+	if reflect.DeepEqual(old, new) {
+		return
+	}
+
+	oldMap, oldIsMap := old.(map[string]any)
+	newMap, newIsMap := new.(map[string]any)
+	if (oldIsMap || old == nil) && (newIsMap || new == nil) && (oldIsMap || newIsMap) {
+		keys := make([]string, 0, len(oldMap)+len(newMap))
+		for k := range oldMap {
+			keys = append(keys, k)
+		}
+		for k := range newMap {
+			if _, ok := oldMap[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			diffFields(fieldPath(path, k), oldMap[k], newMap[k], changes)
+		}
+		return
+	}
+
+	oldList, oldIsList := old.([]any)
+	newList, newIsList := new.([]any)
+	if oldIsList && newIsList {
+		for i := 0; i < len(oldList) || i < len(newList); i++ {
+			var o, n any
+			if i < len(oldList) {
+				o = oldList[i]
+			}
+			if i < len(newList) {
+				n = newList[i]
+			}
+			diffFields(fmt.Sprintf("%s[%d]", path, i), o, n, changes)
+		}
+		return
+	}
+
+	change := resp.FieldChange{Path: strings.TrimPrefix(path, "."), Old: old, New: new}
+	switch {
+	case old == nil:
+		change.Op = "add"
+	case new == nil:
+		change.Op = "remove"
+	default:
+		change.Op = "replace"
+	}
+	*changes = append(*changes, change)
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
+	"github.com/crazyfrankie/kube-ctl/internal/model/req"
+)
+
+var (
+	ErrHPATarget = fmt.Errorf("invalid hpa target")
+)
+
+type HPAService interface {
+	CreateOrUpdateHPA(ctx context.Context, req *req.HPA) error
+	DeleteHPA(ctx context.Context, name string, namespace string) error
+	GetHPADetail(ctx context.Context, name string, namespace string) (*autoscalingv2.HorizontalPodAutoscaler, error)
+	GetHPAList(ctx context.Context, namespace string) ([]autoscalingv2.HorizontalPodAutoscaler, error)
+}
+
+type hpaService struct {
+	clientSet *kubernetes.Clientset
+}
+
+func NewHPAService(cs *kubernetes.Clientset) HPAService {
+	return &hpaService{clientSet: cs}
+}
+
+func (s *hpaService) CreateOrUpdateHPA(ctx context.Context, req *req.HPA) error {
+	if err := s.checkTarget(ctx, req); err != nil {
+		return err
+	}
+	hpa := convert.HPAReqConvert(req)
+
+	client := s.clientSet.AutoscalingV2().HorizontalPodAutoscalers(hpa.Namespace)
+	live, found, err := getLive(ctx, client, hpa.Name)
+	if err != nil {
+		return err
+	}
+	// two HPAs on one workload fight over its replicas
+	other, err := workloadAutoscaler(ctx, s.clientSet, req.Namespace, req.TargetKind, req.TargetName)
+	if err != nil {
+		return err
+	}
+	if other != nil && other.Name != hpa.Name {
+		return fmt.Errorf("%w: %s %s is already scaled by hpa %s", ErrHPATarget, req.TargetKind, req.TargetName, other.Name)
+	}
+
+	return applyObject(ctx, client, hpa, live, found, req.ResourceVersion)
+}
+
+// checkTarget makes sure the scaled workload exists, an HPA on a missing target only reports FailedGetScale.
+func (s *hpaService) checkTarget(ctx context.Context, req *req.HPA) error {
+	var err error
+	switch req.TargetKind {
+	case "Deployment":
+		_, err = s.clientSet.AppsV1().Deployments(req.Namespace).Get(ctx, req.TargetName, metav1.GetOptions{})
+	case "StatefulSet":
+		_, err = s.clientSet.AppsV1().StatefulSets(req.Namespace).Get(ctx, req.TargetName, metav1.GetOptions{})
+	default:
+		return fmt.Errorf("%w: kind %s is not supported", ErrHPATarget, req.TargetKind)
+	}
+	if errors.IsNotFound(err) {
+		return fmt.Errorf("%w: %s %s not found", ErrHPATarget, req.TargetKind, req.TargetName)
+	}
+
+	return err
+}
+
+func (s *hpaService) DeleteHPA(ctx context.Context, name string, namespace string) error {
+	return s.clientSet.AutoscalingV2().HorizontalPodAutoscalers(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+}
+
+func (s *hpaService) GetHPADetail(ctx context.Context, name string, namespace string) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	res, err := s.clientSet.AutoscalingV2().HorizontalPodAutoscalers(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *hpaService) GetHPAList(ctx context.Context, namespace string) ([]autoscalingv2.HorizontalPodAutoscaler, error) {
+	res, err := s.clientSet.AutoscalingV2().HorizontalPodAutoscalers(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Items, nil
+}
+
+// workloadAutoscaler finds the HPA whose scale target is the workload, nil when it is not autoscaled.
+func workloadAutoscaler(ctx context.Context, cs *kubernetes.Clientset, namespace string, kind string, name string) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	hpas, err := cs.AutoscalingV2().HorizontalPodAutoscalers(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for i := range hpas.Items {
+		ref := hpas.Items[i].Spec.ScaleTargetRef
+		if ref.Kind == kind && ref.Name == name {
+			return &hpas.Items[i], nil
+		}
+	}
+
+	return nil, nil
+}
@@ -2,13 +2,20 @@ package service
 
 import (
 	"context"
+	"fmt"
 
+	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	ErrIngressReference = fmt.Errorf("invalid ingress reference")
 )
 
 type IngressService interface {
@@ -29,16 +36,76 @@ func NewIngressService(cs *kubernetes.Clientset) IngressService {
 func (s *ingressService) CreateOrUpdateIngress(ctx context.Context, req *req.Ingress) error {
 	ingress := convert.IngressReqConvert(req)
 
-	if exists, err := s.clientSet.NetworkingV1().Ingresses(ingress.Namespace).Get(ctx, ingress.Name, metav1.GetOptions{}); err == nil {
-		exists.Spec = ingress.Spec
-		_, err := s.clientSet.NetworkingV1().Ingresses(ingress.Namespace).Update(ctx, exists, metav1.UpdateOptions{})
+	// Check the backends and certificates before anything is sent,
+	// a dangling reference is accepted by the apiserver but only surfaces as 503 later.
+	if err := s.checkReferences(ctx, ingress); err != nil {
+		return err
+	}
 
+	client := s.clientSet.NetworkingV1().Ingresses(ingress.Namespace)
+	live, found, err := getLive(ctx, client, ingress.Name)
+	if err != nil {
 		return err
 	}
 
-	_, err := s.clientSet.NetworkingV1().Ingresses(ingress.Namespace).Create(ctx, ingress, metav1.CreateOptions{})
+	return applyObject(ctx, client, ingress, live, found, req.ResourceVersion)
+}
+
+// checkReferences verifies that every referenced Service exposes the referenced port
+// and that every TLS secret exists in the ingress namespace.
+func (s *ingressService) checkReferences(ctx context.Context, ingress *networkingv1.Ingress) error {
+	services := make(map[string]*corev1.Service)
+	for _, b := range convert.IngressBackends(ingress) {
+		if b.Service == nil {
+			continue
+		}
+		svc, ok := services[b.Service.Name]
+		if !ok {
+			res, err := s.clientSet.CoreV1().Services(ingress.Namespace).Get(ctx, b.Service.Name, metav1.GetOptions{})
+			if err != nil {
+				if errors.IsNotFound(err) {
+					return fmt.Errorf("%w: service %s not found in namespace %s", ErrIngressReference, b.Service.Name, ingress.Namespace)
+				}
+				return err
+			}
+			svc = res
+			services[b.Service.Name] = svc
+		}
+		if !serviceHasPort(svc, b.Service.Port) {
+			port := b.Service.Port.Name
+			if port == "" {
+				port = fmt.Sprintf("%d", b.Service.Port.Number)
+			}
+			return fmt.Errorf("%w: service %s has no port %s", ErrIngressReference, svc.Name, port)
+		}
+	}
+
+	for _, t := range ingress.Spec.TLS {
+		if t.SecretName == "" {
+			continue
+		}
+		if _, err := s.clientSet.CoreV1().Secrets(ingress.Namespace).Get(ctx, t.SecretName, metav1.GetOptions{}); err != nil {
+			if errors.IsNotFound(err) {
+				return fmt.Errorf("%w: tls secret %s not found in namespace %s", ErrIngressReference, t.SecretName, ingress.Namespace)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func serviceHasPort(svc *corev1.Service, port networkingv1.ServiceBackendPort) bool {
+	for _, p := range svc.Spec.Ports {
+		if port.Name != "" && p.Name == port.Name {
+			return true
+		}
+		if port.Name == "" && p.Port == port.Number {
+			return true
+		}
+	}
 
-	return err
+	return false
 }
 
 func (s *ingressService) DeleteIngress(ctx context.Context, name string, namespace string) error {
@@ -3,10 +3,12 @@ package service
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/bytedance/sonic"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
@@ -54,39 +56,54 @@ func NewIngressRouteService(cs *kubernetes.Clientset) IngressRouteService {
 func (s *ingressRouteService) CreateOrUpdateIngressRoute(ctx context.Context, request *req.IngressRoute) error {
 	ig := &IngressRoute{
 		TypeMeta: metav1.TypeMeta{
-			Kind:       "traefik.io/v1alpha1",
-			APIVersion: "IngressRoute",
+			Kind:       "IngressRoute",
+			APIVersion: "traefik.io/v1alpha1",
 		},
 		Metadata: metav1.ObjectMeta{
-			Name:      request.Name,
-			Namespace: request.Namespace,
-			Labels:    utils.ReqItemToMap(request.Labels),
+			Name:            request.Name,
+			Namespace:       request.Namespace,
+			Labels:          utils.ReqItemToMap(request.Labels),
+			ResourceVersion: request.ResourceVersion,
 		},
 		Spec: request.IngressRouteSpec,
 	}
 	url := fmt.Sprintf("/apis/traefik.io/v1alpha1/namespaces/%s/ingressroutes/%s", ig.Metadata.Namespace, ig.Metadata.Name)
 
-	result, _ := sonic.Marshal(ig)
-	if raw, err := s.clientSet.NetworkingV1().RESTClient().Get().AbsPath(url).DoRaw(ctx); err == nil {
-		var ingre IngressRoute
-		err := sonic.Unmarshal(raw, &ingre)
-		if err != nil {
+	raw, err := s.clientSet.NetworkingV1().RESTClient().Get().AbsPath(url).DoRaw(ctx)
+	found := err == nil
+	if err != nil {
+		if !errors.IsNotFound(err) {
 			return err
 		}
-		// update
-		ingre.Spec = ig.Spec
-		result, err := sonic.Marshal(ingre)
-		if err != nil {
+		if err := deletedConflict("IngressRoute", ig.Metadata.Name, request.ResourceVersion); err != nil {
 			return err
 		}
-		_, err = s.clientSet.NetworkingV1().RESTClient().Put().AbsPath(url).Body(result).DoRaw(ctx)
+	}
 
+	data, err := applyPatch(ig)
+	if err != nil {
 		return err
 	}
+	// the CRD has no typed client, the apply options go as query parameters
+	opts := applyOptions(ctx)
+	patch := s.clientSet.NetworkingV1().RESTClient().Patch(types.ApplyPatchType).AbsPath(url).
+		Param("fieldManager", opts.FieldManager).
+		Param("force", strconv.FormatBool(*opts.Force)).
+		Body(data)
+	for _, d := range opts.DryRun {
+		patch = patch.Param("dryRun", d)
+	}
+	res, err := patch.DoRaw(ctx)
+	if found {
+		recordDryRun(ctx, DryRunUpdate, raw, res)
+	} else {
+		recordDryRun(ctx, DryRunCreate, nil, res)
+	}
 
-	_, err := s.clientSet.NetworkingV1().RESTClient().Post().AbsPath(url).Body(result).DoRaw(ctx)
-
-	return err
+	return applyError(ctx, err, "IngressRoute", ig.Metadata.Name,
+		func(ctx context.Context, name string, _ metav1.GetOptions) (*IngressRoute, error) {
+			return s.GetIngressRouteDetail(ctx, name, ig.Metadata.Namespace)
+		})
 }
 
 func (s *ingressRouteService) DeleteIngressRoute(ctx context.Context, name string, namespace string) error {
@@ -4,11 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/bytedance/sonic"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/utils/pointer"
@@ -17,11 +25,24 @@ import (
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
 )
 
+// jobRecreateTimeout bounds the wait for the pods of an edited Job to terminate before it is created again
+const jobRecreateTimeout = time.Minute
+
+var (
+	ErrJobState = fmt.Errorf("invalid job state")
+)
+
 type JobService interface {
 	CreateOrUpdateJob(ctx context.Context, req *req.Job) error
 	DeleteJob(ctx context.Context, name string, namespace string) error
 	GetJobDetail(ctx context.Context, name string, namespace string) (*batchv1.Job, error)
 	GetJobList(ctx context.Context, namespace string) ([]batchv1.Job, error)
+	// GetJobPods lists the pods the Job created, oldest first.
+	GetJobPods(ctx context.Context, name string, namespace string) ([]corev1.Pod, error)
+	// RerunJob starts a fresh copy of a finished Job and returns it.
+	RerunJob(ctx context.Context, name string, namespace string) (*batchv1.Job, error)
+	// SuspendJob stops the pods of a running Job, resuming starts them again.
+	SuspendJob(ctx context.Context, name string, namespace string, suspend bool) error
 }
 
 type jobService struct {
@@ -36,86 +57,81 @@ func (s *jobService) CreateOrUpdateJob(ctx context.Context, req *req.Job) error
 	job := convert.JobReqConvert(req)
 
 	if exists, err := s.clientSet.BatchV1().Jobs(job.Namespace).Get(ctx, job.Name, metav1.GetOptions{}); err == nil {
+		// 删除后重建，无法由 apiserver 校验版本，在这里比较
+		if err := checkVersion("Job", job.Name, req.ResourceVersion, exists); err != nil {
+			return err
+		}
 		// 校验
 		jobCp := *job
 		newName := jobCp.Name + "-validate"
 		jobCp.Name = newName
-		_, err := s.clientSet.BatchV1().Jobs(job.Namespace).Create(ctx, &jobCp, metav1.CreateOptions{
+		validated, err := s.clientSet.BatchV1().Jobs(job.Namespace).Create(ctx, &jobCp, metav1.CreateOptions{
 			DryRun: []string{metav1.DryRunAll},
 		})
 		if err != nil {
 			return err
 		}
-		// 获取监听标签
-		var labelSelector []string
-		for k, v := range exists.Labels {
-			labelSelector = append(labelSelector, fmt.Sprintf("%s=%s", k, v))
+		// 预演模式下只对比校验结果，不做删除重建
+		if isDryRun(ctx) {
+			validated.Name = job.Name
+			recordDryRun(ctx, DryRunRecreate, exists, validated)
+			return nil
 		}
-		var podSelector []string
-		for k, v := range exists.Spec.Template.Labels {
-			podSelector = append(podSelector, fmt.Sprintf("%s=%s", k, v))
-		}
-		// 启动监听
-		watcher, err := s.clientSet.BatchV1().Jobs(job.Namespace).Watch(ctx, metav1.ListOptions{
-			LabelSelector: strings.Join(labelSelector, ","),
+		// 模板不可修改，前台删除 Job 及其 Pod 后重建，从当前版本开始监听避免漏掉删除事件
+		client := s.clientSet.BatchV1().Jobs(job.Namespace)
+		watcher, err := client.Watch(ctx, metav1.ListOptions{
+			FieldSelector:   fields.OneTermEqualSelector("metadata.name", exists.Name).String(),
+			ResourceVersion: exists.ResourceVersion,
 		})
 		if err != nil {
 			return err
 		}
-		notify := make(chan error)
-
-		// 异步删除 Pod
-		go func() {
-			for {
-				select {
-				case e := <-watcher.ResultChan():
-					switch e.Type {
-					case watch.Deleted:
-						// 删除关联的 pod
-						if list, err := s.clientSet.CoreV1().Pods(exists.Namespace).List(ctx, metav1.ListOptions{
-							LabelSelector: strings.Join(podSelector, ","),
-						}); err == nil {
-							for _, i := range list.Items {
-								// delete pod
-								background := metav1.DeletePropagationBackground
-								err = s.clientSet.CoreV1().Pods(i.Namespace).Delete(ctx, i.Name, metav1.DeleteOptions{
-									GracePeriodSeconds: pointer.Int64(0),
-									PropagationPolicy:  &background,
-								})
-							}
-						}
-						_, err = s.clientSet.BatchV1().Jobs(job.Namespace).Create(ctx, job, metav1.CreateOptions{})
-						notify <- err
-						return
-					}
-				case <-time.After(time.Second * 5):
-					notify <- errors.New("update job timeout")
-					return
-				}
-			}
-		}()
-		// 删除 Job
-		background := metav1.DeletePropagationForeground
-		err = s.clientSet.CoreV1().Pods(job.Namespace).Delete(ctx, exists.Name, metav1.DeleteOptions{
-			PropagationPolicy: &background,
+		defer watcher.Stop()
+
+		foreground := metav1.DeletePropagationForeground
+		err = client.Delete(ctx, exists.Name, metav1.DeleteOptions{
+			PropagationPolicy: &foreground,
+			Preconditions:     &metav1.Preconditions{UID: &exists.UID},
 		})
 		if err != nil {
 			return err
 		}
-		//监听删除后重新创建的信息
-		select {
-		case errx := <-notify:
-			if errx != nil {
-				return errx
-			}
+		if err := waitJobDeleted(watcher); err != nil {
+			return err
 		}
+
+		_, err = client.Create(ctx, job, metav1.CreateOptions{})
+		return err
 	}
 
-	_, err := s.clientSet.BatchV1().Jobs(job.Namespace).Create(ctx, job, metav1.CreateOptions{})
+	if err := deletedConflict("Job", job.Name, req.ResourceVersion); err != nil {
+		return err
+	}
+
+	res, err := s.clientSet.BatchV1().Jobs(job.Namespace).Create(ctx, job, createOptions(ctx))
+	recordDryRun(ctx, DryRunCreate, nil, res)
 
 	return err
 }
 
+// waitJobDeleted waits for the Job to be gone, with foreground propagation that is after its pods.
+func waitJobDeleted(watcher watch.Interface) error {
+	timeout := time.After(jobRecreateTimeout)
+	for {
+		select {
+		case e, ok := <-watcher.ResultChan():
+			if !ok {
+				return errors.New("update job: watch closed before the job was deleted")
+			}
+			if e.Type == watch.Deleted {
+				return nil
+			}
+		case <-timeout:
+			return errors.New("update job timeout")
+		}
+	}
+}
+
 func (s *jobService) DeleteJob(ctx context.Context, name string, namespace string) error {
 	job, err := s.clientSet.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
@@ -199,3 +215,85 @@ func (s *jobService) GetJobList(ctx context.Context, namespace string) ([]batchv
 
 	return res.Items, nil
 }
+
+func (s *jobService) GetJobPods(ctx context.Context, name string, namespace string) ([]corev1.Pod, error) {
+	job, err := s.clientSet.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	selector, err := metav1.LabelSelectorAsSelector(job.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := s.clientSet.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(pods.Items, func(i, j int) bool {
+		return pods.Items[i].CreationTimestamp.Before(&pods.Items[j].CreationTimestamp)
+	})
+
+	return pods.Items, nil
+}
+
+var rerunSuffixRegexp = regexp.MustCompile(`-rerun-[a-z0-9]{5}$`)
+
+func (s *jobService) RerunJob(ctx context.Context, name string, namespace string) (*batchv1.Job, error) {
+	job, err := s.clientSet.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if status := convert.JobStatus(job); status != convert.JobStatusComplete && status != convert.JobStatusFailed {
+		return nil, fmt.Errorf("%w: job %s is %s, only a finished job can be rerun", ErrJobState, name, status)
+	}
+
+	// the job name ends up in a label value, keep it within 63 characters
+	suffix := "-rerun-" + utilrand.String(5)
+	base := rerunSuffixRegexp.ReplaceAllString(job.Name, "")
+	if len(base)+len(suffix) > validation.LabelValueMaxLength {
+		base = base[:validation.LabelValueMaxLength-len(suffix)]
+	}
+
+	rerun := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        base + suffix,
+			Namespace:   job.Namespace,
+			Labels:      job.Labels,
+			Annotations: job.Annotations,
+		},
+		Spec: *job.Spec.DeepCopy(),
+	}
+	// a new selector and controller labels are generated for the copy
+	rerun.Spec.Selector = nil
+	rerun.Spec.ManualSelector = nil
+	rerun.Spec.Suspend = nil
+	rerun.Spec.Template.Labels = convert.JobTemplateLabels(job.Spec.Template.Labels)
+
+	return s.clientSet.BatchV1().Jobs(namespace).Create(ctx, rerun, metav1.CreateOptions{})
+}
+
+func (s *jobService) SuspendJob(ctx context.Context, name string, namespace string, suspend bool) error {
+	job, err := s.clientSet.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if status := convert.JobStatus(job); status == convert.JobStatusComplete || status == convert.JobStatusFailed {
+		return fmt.Errorf("%w: job %s is %s, rerun it instead", ErrJobState, name, status)
+	}
+
+	patch := map[string]any{
+		"spec": map[string]any{
+			"suspend": suspend,
+		},
+	}
+	data, err := sonic.Marshal(&patch)
+	if err != nil {
+		return err
+	}
+	_, err = s.clientSet.BatchV1().Jobs(namespace).Patch(ctx, name, types.MergePatchType, data, metav1.PatchOptions{})
+
+	return err
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
+	"github.com/crazyfrankie/kube-ctl/internal/model/req"
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+)
+
+var (
+	ErrReachabilityTarget = fmt.Errorf("invalid reachability target")
+)
+
+type NetworkPolicyService interface {
+	CreateOrUpdateNetworkPolicy(ctx context.Context, req *req.NetworkPolicy) error
+	DeleteNetworkPolicy(ctx context.Context, name string, namespace string) error
+	GetNetworkPolicyDetail(ctx context.Context, name string, namespace string) (*networkingv1.NetworkPolicy, error)
+	GetNetworkPolicyList(ctx context.Context, namespace string) ([]networkingv1.NetworkPolicy, error)
+	// AnalyzeReachability tells whether the policies let the source pod reach the destination pod port.
+	AnalyzeReachability(ctx context.Context, req *req.Reachability) (*resp.Reachability, error)
+}
+
+type networkPolicyService struct {
+	clientSet *kubernetes.Clientset
+}
+
+func NewNetworkPolicyService(cs *kubernetes.Clientset) NetworkPolicyService {
+	return &networkPolicyService{clientSet: cs}
+}
+
+func (s *networkPolicyService) CreateOrUpdateNetworkPolicy(ctx context.Context, req *req.NetworkPolicy) error {
+	np := convert.NetworkPolicyReqConvert(req)
+
+	client := s.clientSet.NetworkingV1().NetworkPolicies(np.Namespace)
+	live, found, err := getLive(ctx, client, np.Name)
+	if err != nil {
+		return err
+	}
+
+	return applyObject(ctx, client, np, live, found, req.ResourceVersion)
+}
+
+func (s *networkPolicyService) DeleteNetworkPolicy(ctx context.Context, name string, namespace string) error {
+	return s.clientSet.NetworkingV1().NetworkPolicies(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+}
+
+func (s *networkPolicyService) GetNetworkPolicyDetail(ctx context.Context, name string, namespace string) (*networkingv1.NetworkPolicy, error) {
+	res, err := s.clientSet.NetworkingV1().NetworkPolicies(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *networkPolicyService) GetNetworkPolicyList(ctx context.Context, namespace string) ([]networkingv1.NetworkPolicy, error) {
+	res, err := s.clientSet.NetworkingV1().NetworkPolicies(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Items, nil
+}
+
+func (s *networkPolicyService) AnalyzeReachability(ctx context.Context, req *req.Reachability) (*resp.Reachability, error) {
+	in := &reachabilityInput{port: req.Port, protocol: req.Protocol}
+	var err error
+	if in.source, in.sourceNamespace, err = s.podWithNamespace(ctx, req.SourceNamespace, req.SourcePod); err != nil {
+		return nil, err
+	}
+	if in.destination, in.destinationNamespace, err = s.podWithNamespace(ctx, req.DestinationNamespace, req.DestinationPod); err != nil {
+		return nil, err
+	}
+	if in.sourcePolicies, err = s.GetNetworkPolicyList(ctx, req.SourceNamespace); err != nil {
+		return nil, err
+	}
+	if in.destinationPolicies, err = s.GetNetworkPolicyList(ctx, req.DestinationNamespace); err != nil {
+		return nil, err
+	}
+
+	return analyzeReachability(in)
+}
+
+func (s *networkPolicyService) podWithNamespace(ctx context.Context, namespace string, name string) (*corev1.Pod, *corev1.Namespace, error) {
+	pod, err := s.clientSet.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, nil, fmt.Errorf("%w: pod %s/%s not found", ErrReachabilityTarget, namespace, name)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	ns, err := s.clientSet.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pod, ns, nil
+}
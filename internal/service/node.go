@@ -10,6 +10,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
 )
 
 type NodeService interface {
@@ -18,6 +19,8 @@ type NodeService interface {
 	UpdateNodeLabel(ctx context.Context, req req.UpdateLabelReq) error
 	UpdateNodeTaints(ctx context.Context, req req.UpdateTaintReq) error
 	GetNodePods(ctx context.Context, namespace string, nodeName string) ([]corev1.Pod, error)
+	// CheckDrain lists the PDBs that evicting every pod of the node would violate.
+	CheckDrain(ctx context.Context, name string) ([]resp.PDBViolation, error)
 }
 
 type nodeService struct {
@@ -53,10 +56,15 @@ func (s *nodeService) UpdateNodeLabel(ctx context.Context, req req.UpdateLabelRe
 	}
 
 	labels["$patch"] = "replace"
+	metadata := map[string]any{
+		"labels": labels,
+	}
+	// a resourceVersion in the patch makes the api server reject it when the node changed
+	if req.ResourceVersion != "" {
+		metadata["resourceVersion"] = req.ResourceVersion
+	}
 	update := map[string]any{
-		"metadata": map[string]any{
-			"labels": labels,
-		},
+		"metadata": metadata,
 	}
 
 	data, err := sonic.Marshal(&update)
@@ -64,26 +72,134 @@ func (s *nodeService) UpdateNodeLabel(ctx context.Context, req req.UpdateLabelRe
 		return err
 	}
 
-	_, err = s.clientSet.CoreV1().Nodes().Patch(ctx, req.Name, types.StrategicMergePatchType, data, metav1.PatchOptions{})
+	live := s.dryRunLive(ctx, req.Name)
+	res, err := s.clientSet.CoreV1().Nodes().Patch(ctx, req.Name, types.StrategicMergePatchType, data, patchOptions(ctx))
+	recordDryRun(ctx, DryRunUpdate, live, res)
 
-	return err
+	return conflictError(ctx, err, "Node", req.Name, s.clientSet.CoreV1().Nodes().Get)
 }
 
 func (s *nodeService) UpdateNodeTaints(ctx context.Context, req req.UpdateTaintReq) error {
+	if err := s.checkNoExecute(ctx, req); err != nil {
+		return err
+	}
+
 	taints := map[string]any{
 		"spec": map[string]any{
 			"taints": req.Taints,
 		},
 	}
+	if req.ResourceVersion != "" {
+		taints["metadata"] = map[string]any{
+			"resourceVersion": req.ResourceVersion,
+		}
+	}
 
 	data, err := sonic.Marshal(taints)
 	if err != nil {
 		return err
 	}
 
-	_, err = s.clientSet.CoreV1().Nodes().Patch(ctx, req.Name, types.StrategicMergePatchType, data, metav1.PatchOptions{})
+	live := s.dryRunLive(ctx, req.Name)
+	res, err := s.clientSet.CoreV1().Nodes().Patch(ctx, req.Name, types.StrategicMergePatchType, data, patchOptions(ctx))
+	recordDryRun(ctx, DryRunUpdate, live, res)
+
+	return conflictError(ctx, err, "Node", req.Name, s.clientSet.CoreV1().Nodes().Get)
+}
+
+// checkNoExecute makes sure the pods evicted by newly added NoExecute taints do not violate a PDB.
+func (s *nodeService) checkNoExecute(ctx context.Context, req req.UpdateTaintReq) error {
+	node, err := s.clientSet.CoreV1().Nodes().Get(ctx, req.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	added := make([]corev1.Taint, 0)
+	for _, t := range req.Taints {
+		if t.Effect != corev1.TaintEffectNoExecute {
+			continue
+		}
+		exists := false
+		for _, old := range node.Spec.Taints {
+			if old.MatchTaint(&t) {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			added = append(added, t)
+		}
+	}
+	if len(added) == 0 {
+		return nil
+	}
+
+	pods, err := s.nodePods(ctx, req.Name)
+	if err != nil {
+		return err
+	}
+	evicted := make([]corev1.Pod, 0, len(pods))
+	for _, p := range pods {
+		if !toleratesAll(p.Spec.Tolerations, added) {
+			evicted = append(evicted, p)
+		}
+	}
+
+	return checkDisruption(ctx, s.clientSet, "tainting node "+req.Name+" NoExecute", evicted)
+}
+
+func toleratesAll(tolerations []corev1.Toleration, taints []corev1.Taint) bool {
+	for _, taint := range taints {
+		tolerated := false
+		for _, t := range tolerations {
+			if t.ToleratesTaint(&taint) {
+				tolerated = true
+				break
+			}
+		}
+		if !tolerated {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *nodeService) CheckDrain(ctx context.Context, name string) ([]resp.PDBViolation, error) {
+	pods, err := s.nodePods(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	// like kubectl drain, DaemonSet pods and static pods are left in place
+	evicted := make([]corev1.Pod, 0, len(pods))
+	for _, p := range pods {
+		if owner := metav1.GetControllerOf(&p); owner != nil && (owner.Kind == "DaemonSet" || owner.Kind == "Node") {
+			continue
+		}
+		evicted = append(evicted, p)
+	}
+
+	return disruptionViolations(ctx, s.clientSet, evicted)
+}
+
+func (s *nodeService) nodePods(ctx context.Context, name string) ([]corev1.Pod, error) {
+	pods, err := s.clientSet.CoreV1().Pods("").List(ctx, metav1.ListOptions{
+		FieldSelector: "spec.nodeName=" + name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pods.Items, nil
+}
+
+// dryRunLive fetches the node a dry-run patch is compared against.
+func (s *nodeService) dryRunLive(ctx context.Context, name string) *corev1.Node {
+	if !isDryRun(ctx) {
+		return nil
+	}
+	live, _ := s.clientSet.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
 
-	return err
+	return live
 }
 
 func (s *nodeService) GetNodePods(ctx context.Context, namespace string, nodeName string) ([]corev1.Pod, error) {
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
+	"github.com/crazyfrankie/kube-ctl/internal/model/req"
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+)
+
+type PDBService interface {
+	CreateOrUpdatePDB(ctx context.Context, req *req.PDB) error
+	DeletePDB(ctx context.Context, name string, namespace string) error
+	GetPDBDetail(ctx context.Context, name string, namespace string) (*policyv1.PodDisruptionBudget, error)
+	GetPDBList(ctx context.Context, namespace string) ([]policyv1.PodDisruptionBudget, error)
+}
+
+type pdbService struct {
+	clientSet *kubernetes.Clientset
+}
+
+func NewPDBService(cs *kubernetes.Clientset) PDBService {
+	return &pdbService{clientSet: cs}
+}
+
+func (s *pdbService) CreateOrUpdatePDB(ctx context.Context, req *req.PDB) error {
+	pdb := convert.PDBReqConvert(req)
+
+	client := s.clientSet.PolicyV1().PodDisruptionBudgets(pdb.Namespace)
+	live, found, err := getLive(ctx, client, pdb.Name)
+	if err != nil {
+		return err
+	}
+
+	return applyObject(ctx, client, pdb, live, found, req.ResourceVersion)
+}
+
+func (s *pdbService) DeletePDB(ctx context.Context, name string, namespace string) error {
+	return s.clientSet.PolicyV1().PodDisruptionBudgets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+}
+
+func (s *pdbService) GetPDBDetail(ctx context.Context, name string, namespace string) (*policyv1.PodDisruptionBudget, error) {
+	res, err := s.clientSet.PolicyV1().PodDisruptionBudgets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *pdbService) GetPDBList(ctx context.Context, namespace string) ([]policyv1.PodDisruptionBudget, error) {
+	res, err := s.clientSet.PolicyV1().PodDisruptionBudgets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Items, nil
+}
+
+// DisruptionError is returned when removing pods would take PDBs below their budget.
+// The write can be repeated with WithForceConflicts to go ahead anyway.
+type DisruptionError struct {
+	Action     string
+	Violations []resp.PDBViolation
+}
+
+func (e *DisruptionError) Error() string {
+	names := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		names = append(names, v.Namespace+"/"+v.Name)
+	}
+
+	return fmt.Sprintf("%s would violate the disruption budget of %s, force to go ahead", e.Action, strings.Join(names, ","))
+}
+
+// checkDisruption fails with a DisruptionError when evicting pods violates a PDB, unless forced.
+func checkDisruption(ctx context.Context, cs *kubernetes.Clientset, action string, pods []corev1.Pod) error {
+	if isForced(ctx) || len(pods) == 0 {
+		return nil
+	}
+
+	violations, err := disruptionViolations(ctx, cs, pods)
+	if err != nil {
+		return err
+	}
+	if len(violations) > 0 {
+		return &DisruptionError{Action: action, Violations: violations}
+	}
+
+	return nil
+}
+
+// disruptionViolations lists the PDBs that protect more of the pods than they allow to disrupt.
+// Pods that already finished or are being deleted are not counted, they do not lower the budget.
+func disruptionViolations(ctx context.Context, cs *kubernetes.Clientset, pods []corev1.Pod) ([]resp.PDBViolation, error) {
+	byNamespace := make(map[string][]corev1.Pod)
+	for _, p := range pods {
+		if p.DeletionTimestamp != nil || p.Status.Phase == corev1.PodSucceeded || p.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		byNamespace[p.Namespace] = append(byNamespace[p.Namespace], p)
+	}
+
+	violations := make([]resp.PDBViolation, 0)
+	for ns, nsPods := range byNamespace {
+		pdbs, err := cs.PolicyV1().PodDisruptionBudgets(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, pdb := range pdbs.Items {
+			selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+			if err != nil {
+				continue
+			}
+			protected := make([]string, 0)
+			for _, p := range nsPods {
+				if selector.Matches(labels.Set(p.Labels)) {
+					protected = append(protected, p.Name)
+				}
+			}
+			if int32(len(protected)) > pdb.Status.DisruptionsAllowed {
+				violations = append(violations, resp.PDBViolation{
+					Name:               pdb.Name,
+					Namespace:          ns,
+					DisruptionsAllowed: pdb.Status.DisruptionsAllowed,
+					Pods:               protected,
+				})
+			}
+		}
+	}
+	sort.Slice(violations, func(i, j int) bool {
+		if violations[i].Namespace != violations[j].Namespace {
+			return violations[i].Namespace < violations[j].Namespace
+		}
+		return violations[i].Name < violations[j].Name
+	})
+
+	return violations, nil
+}
+
+// podDisruptionBudgets returns the PDBs whose selector matches pods with the given labels.
+func podDisruptionBudgets(ctx context.Context, cs *kubernetes.Clientset, namespace string, podLabels map[string]string) ([]policyv1.PodDisruptionBudget, error) {
+	pdbs, err := cs.PolicyV1().PodDisruptionBudgets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]policyv1.PodDisruptionBudget, 0)
+	for _, pdb := range pdbs.Items {
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			continue
+		}
+		if selector.Matches(labels.Set(podLabels)) {
+			res = append(res, pdb)
+		}
+	}
+
+	return res, nil
+}
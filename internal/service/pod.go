@@ -14,6 +14,7 @@ import (
 
 	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
 )
 
 type PodService interface {
@@ -23,6 +24,9 @@ type PodService interface {
 	DeletePod(ctx context.Context, namespace string, name string) error
 	GetNamespace(ctx context.Context) ([]corev1.Namespace, error)
 	SearchPod(ctx context.Context, namespace string, name string) (*corev1.Pod, error)
+	// PreviewResources shows the requests and limits the pod would run with after defaulting
+	// and the LimitRanges of its namespace, along with its QoS class.
+	PreviewResources(ctx context.Context, req *req.Pod) (*resp.PodResourcePreview, error)
 }
 
 type podService struct {
@@ -37,14 +41,24 @@ func (s *podService) CreateOrUpdatePod(ctx context.Context, reqPod *req.Pod) err
 	pod := convert.PodReqConvert(reqPod)
 	if get, err := s.clientSet.CoreV1().Pods(pod.Namespace).
 		Get(ctx, pod.Name, metav1.GetOptions{}); err == nil {
+		// The pod is deleted and created again, so the version is compared here
+		if err := checkVersion("Pod", pod.Name, reqPod.ResourceVersion, get); err != nil {
+			return err
+		}
 		// Verify that the parameters are legal
 		cPod := *pod
 		cPod.Name = cPod.Name + "-validate"
-		_, err := s.clientSet.CoreV1().Pods(cPod.Namespace).Create(ctx,
+		validated, err := s.clientSet.CoreV1().Pods(cPod.Namespace).Create(ctx,
 			&cPod, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
 		if err != nil {
 			return err
 		}
+		// Pods are replaced rather than updated, the validated copy is what would be created
+		if isDryRun(ctx) {
+			validated.Name = pod.Name
+			recordDryRun(ctx, DryRunRecreate, get, validated)
+			return nil
+		}
 
 		bg := metav1.DeletePropagationBackground
 		var period int64 = 0
@@ -107,8 +121,13 @@ func (s *podService) CreateOrUpdatePod(ctx context.Context, reqPod *req.Pod) err
 		}
 	}
 
-	_, err := s.clientSet.CoreV1().Pods(pod.Namespace).Create(ctx,
-		pod, metav1.CreateOptions{})
+	if err := deletedConflict("Pod", pod.Name, reqPod.ResourceVersion); err != nil {
+		return err
+	}
+
+	res, err := s.clientSet.CoreV1().Pods(pod.Namespace).Create(ctx,
+		pod, createOptions(ctx))
+	recordDryRun(ctx, DryRunCreate, nil, res)
 	if err != nil {
 		return es.New(fmt.Sprintf("failed create pod, name: %s, %s", pod.Name, err.Error()))
 	}
@@ -134,7 +153,16 @@ func (s *podService) GetPodList(ctx context.Context, namespace string) ([]corev1
 	return pods.Items, nil
 }
 
+// DeletePod refuses to delete a pod protected by a PDB without budget left, unless forced.
 func (s *podService) DeletePod(ctx context.Context, namespace string, name string) error {
+	pod, err := s.clientSet.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if err := checkDisruption(ctx, s.clientSet, "deleting pod "+name, []corev1.Pod{*pod}); err != nil {
+		return err
+	}
+
 	return s.clientSet.CoreV1().Pods(namespace).Delete(ctx, name, metav1.DeleteOptions{})
 }
 
@@ -155,3 +183,13 @@ func (s *podService) SearchPod(ctx context.Context, namespace string, name strin
 
 	return pod, nil
 }
+
+func (s *podService) PreviewResources(ctx context.Context, req *req.Pod) (*resp.PodResourcePreview, error) {
+	pod := convert.PodReqConvert(req)
+	list, err := s.clientSet.CoreV1().LimitRanges(pod.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return previewResources(pod, list.Items), nil
+}
@@ -0,0 +1,440 @@
+package service
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"context"
+	es "errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+
+	"github.com/crazyfrankie/kube-ctl/conf"
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+)
+
+const (
+	defaultMaxDownloadMB = 100
+	defaultMaxUploadMB   = 50
+
+	FileFormatTar = "tar"
+	FileFormatZip = "zip"
+	FileFormatRaw = "raw"
+
+	// %n path, %F type, %s size, %a permission, %Y mtime
+	statFormat = "%n\t%F\t%s\t%a\t%Y"
+)
+
+var (
+	ErrFilePath     = fmt.Errorf("invalid container path")
+	ErrFileTooLarge = fmt.Errorf("file size exceeds the limit")
+)
+
+type PodFileService interface {
+	// ListDir lists the entries directly under dir.
+	ListDir(ctx context.Context, namespace string, name string, container string, dir string) ([]resp.ContainerFile, error)
+	// Stat describes p, the size of a directory is the sum of its content.
+	Stat(ctx context.Context, namespace string, name string, container string, p string) (*resp.ContainerFile, error)
+	// Download streams p to w as a tar or zip archive, or the raw content of a regular file.
+	Download(ctx context.Context, namespace string, name string, container string, p string, format string, w io.Writer) error
+	// Upload writes files into dir, which is created if missing.
+	Upload(ctx context.Context, namespace string, name string, container string, dir string, files []*multipart.FileHeader) error
+	MaxDownloadSize() int64
+	MaxUploadSize() int64
+}
+
+type podFileService struct {
+	clientSet   *kubernetes.Clientset
+	config      *rest.Config
+	maxDownload int64
+	maxUpload   int64
+}
+
+func NewPodFileService(cs *kubernetes.Clientset, cfg *rest.Config) PodFileService {
+	ft := conf.GetConf().FileTransfer
+	svc := &podFileService{
+		clientSet:   cs,
+		config:      cfg,
+		maxDownload: ft.MaxDownloadMB << 20,
+		maxUpload:   ft.MaxUploadMB << 20,
+	}
+	if svc.maxDownload <= 0 {
+		svc.maxDownload = defaultMaxDownloadMB << 20
+	}
+	if svc.maxUpload <= 0 {
+		svc.maxUpload = defaultMaxUploadMB << 20
+	}
+
+	return svc
+}
+
+func (s *podFileService) MaxDownloadSize() int64 {
+	return s.maxDownload
+}
+
+func (s *podFileService) MaxUploadSize() int64 {
+	return s.maxUpload
+}
+
+func (s *podFileService) ListDir(ctx context.Context, namespace string, name string, container string, dir string) ([]resp.ContainerFile, error) {
+	dir, err := cleanContainerPath(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var stdout bytes.Buffer
+	cmd := []string{"find", dir, "-mindepth", "1", "-maxdepth", "1", "-exec", "stat", "-c", statFormat, "{}", "+"}
+	if err := s.exec(ctx, namespace, name, container, cmd, nil, &stdout); err != nil {
+		return nil, err
+	}
+
+	files := make([]resp.ContainerFile, 0)
+	for _, line := range strings.Split(stdout.String(), "\n") {
+		if f, ok := parseStat(line); ok {
+			files = append(files, f)
+		}
+	}
+	sort.Slice(files, func(i, j int) bool {
+		if (files[i].Type == "dir") != (files[j].Type == "dir") {
+			return files[i].Type == "dir"
+		}
+		return files[i].Name < files[j].Name
+	})
+
+	return files, nil
+}
+
+func (s *podFileService) Stat(ctx context.Context, namespace string, name string, container string, p string) (*resp.ContainerFile, error) {
+	p, err := cleanContainerPath(p)
+	if err != nil {
+		return nil, err
+	}
+
+	var stdout bytes.Buffer
+	if err := s.exec(ctx, namespace, name, container, []string{"stat", "-c", statFormat, p}, nil, &stdout); err != nil {
+		return nil, err
+	}
+	f, ok := parseStat(stdout.String())
+	if !ok {
+		return nil, fmt.Errorf("unexpected stat output %q", stdout.String())
+	}
+	if f.Type != "dir" {
+		return &f, nil
+	}
+
+	stdout.Reset()
+	if err := s.exec(ctx, namespace, name, container, []string{"du", "-sk", p}, nil, &stdout); err != nil {
+		return nil, err
+	}
+	fields := strings.Fields(stdout.String())
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("unexpected du output %q", stdout.String())
+	}
+	kb, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	f.Size = kb << 10
+
+	return &f, nil
+}
+
+func (s *podFileService) Download(ctx context.Context, namespace string, name string, container string, p string, format string, w io.Writer) error {
+	p, err := cleanContainerPath(p)
+	if err != nil {
+		return err
+	}
+
+	// The limit applies to the file content as Stat sized it, not to the archive headers and padding
+	var repack func(r io.Reader, w io.Writer, limit int64) error
+	switch format {
+	case FileFormatRaw:
+		return s.exec(ctx, namespace, name, container, []string{"cat", p}, nil, &limitWriter{w: w, remain: s.maxDownload})
+	case FileFormatTar, "":
+		repack = copyTar
+	case FileFormatZip:
+		repack = tarToZip
+	default:
+		return fmt.Errorf("%w: unsupported format %s", ErrFilePath, format)
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(s.exec(ctx, namespace, name, container, tarCommand(p), nil, pw))
+	}()
+	err = repack(pr, w, s.maxDownload)
+	// unblock the exec stream if the repacking stopped early
+	pr.CloseWithError(err)
+
+	return err
+}
+
+func (s *podFileService) Upload(ctx context.Context, namespace string, name string, container string, dir string, files []*multipart.FileHeader) error {
+	dir, err := cleanContainerPath(dir)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("%w: no file to upload", ErrFilePath)
+	}
+
+	var total int64
+	for _, f := range files {
+		if f.Filename == "" || f.Filename != path.Base(f.Filename) || f.Filename == ".." || strings.ContainsAny(f.Filename, "/\\") {
+			return fmt.Errorf("%w: illegal file name %q", ErrFilePath, f.Filename)
+		}
+		total += f.Size
+	}
+	if total > s.maxUpload {
+		return fmt.Errorf("%w: upload %d bytes, limit %d bytes", ErrFileTooLarge, total, s.maxUpload)
+	}
+
+	if err := s.exec(ctx, namespace, name, container, []string{"mkdir", "-p", dir}, nil, nil); err != nil {
+		return err
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(writeTar(pw, files))
+	}()
+	err = s.exec(ctx, namespace, name, container, []string{"tar", "xf", "-", "-C", dir}, pr, nil)
+	pr.CloseWithError(err)
+
+	return err
+}
+
+// exec runs cmd in the container, a non-zero exit is reported with its stderr.
+func (s *podFileService) exec(ctx context.Context, namespace string, name string, container string, cmd []string, stdin io.Reader, stdout io.Writer) error {
+	req := s.clientSet.CoreV1().RESTClient().Post().
+		Resource("pods").Namespace(namespace).Name(name).SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   cmd,
+			Stdin:     stdin != nil,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(s.config, http.MethodPost, req.URL())
+	if err != nil {
+		return err
+	}
+
+	if stdout == nil {
+		stdout = io.Discard
+	}
+	var stderr bytes.Buffer
+	err = executor.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: &stderr,
+	})
+	if err == nil {
+		return nil
+	}
+
+	var exitErr utilexec.ExitError
+	if es.As(err, &exitErr) {
+		msg := strings.TrimSpace(stderr.String())
+		if strings.Contains(msg, "No such file") || strings.Contains(msg, "Not a directory") {
+			return fmt.Errorf("%w: %s", ErrFilePath, msg)
+		}
+		return fmt.Errorf("%s %s: %s", cmd[0], exitErr.Error(), msg)
+	}
+
+	return err
+}
+
+// cleanContainerPath only accepts absolute paths without any ".." element.
+func cleanContainerPath(p string) (string, error) {
+	if p == "" || !path.IsAbs(p) {
+		return "", fmt.Errorf("%w: %q must be an absolute path", ErrFilePath, p)
+	}
+	if strings.ContainsRune(p, 0) {
+		return "", fmt.Errorf("%w: %q contains NUL", ErrFilePath, p)
+	}
+	for _, elem := range strings.Split(p, "/") {
+		if elem == ".." {
+			return "", fmt.Errorf("%w: %q contains '..'", ErrFilePath, p)
+		}
+	}
+
+	return path.Clean(p), nil
+}
+
+func tarCommand(p string) []string {
+	if p == "/" {
+		return []string{"tar", "cf", "-", "-C", "/", "."}
+	}
+
+	// the base name is relative, -- keeps a name such as --checkpoint-action=... from being read as an option
+	return []string{"tar", "cf", "-", "-C", path.Dir(p), "--", path.Base(p)}
+}
+
+func parseStat(line string) (resp.ContainerFile, bool) {
+	fields := strings.Split(strings.TrimRight(line, "\r\n"), "\t")
+	if len(fields) != 5 {
+		return resp.ContainerFile{}, false
+	}
+
+	f := resp.ContainerFile{
+		Name: path.Base(fields[0]),
+		Path: fields[0],
+		Mode: fields[3],
+	}
+	switch {
+	case strings.HasPrefix(fields[1], "regular"):
+		f.Type = "file"
+	case fields[1] == "directory":
+		f.Type = "dir"
+	case fields[1] == "symbolic link":
+		f.Type = "link"
+	default:
+		f.Type = "other"
+	}
+	f.Size, _ = strconv.ParseInt(fields[2], 10, 64)
+	f.ModTime, _ = strconv.ParseInt(fields[4], 10, 64)
+
+	return f, true
+}
+
+// copyTar re-writes a tar stream entry by entry, failing once the regular files add up to more than limit bytes.
+func copyTar(r io.Reader, w io.Writer, limit int64) error {
+	tr := tar.NewReader(r)
+	tw := tar.NewWriter(w)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if limit, err = takePayload(hdr, limit); err != nil {
+			return err
+		}
+
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if _, err := io.Copy(tw, tr); err != nil {
+			return err
+		}
+	}
+
+	return tw.Close()
+}
+
+// tarToZip re-packs a tar stream as zip, rejecting entries that escape the archive root
+// and failing once the regular files add up to more than limit bytes.
+func tarToZip(r io.Reader, w io.Writer, limit int64) error {
+	tr := tar.NewReader(r)
+	zw := zip.NewWriter(w)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if limit, err = takePayload(hdr, limit); err != nil {
+			return err
+		}
+
+		name := path.Clean(strings.TrimPrefix(hdr.Name, "./"))
+		if path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return fmt.Errorf("%w: archive entry %q escapes the root", ErrFilePath, hdr.Name)
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if name == "." {
+				continue
+			}
+			if _, err := zw.CreateHeader(&zip.FileHeader{Name: name + "/", Modified: hdr.ModTime}); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			fh := &zip.FileHeader{Name: name, Method: zip.Deflate, Modified: hdr.ModTime}
+			fh.SetMode(hdr.FileInfo().Mode())
+			fw, err := zw.CreateHeader(fh)
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(fw, tr); err != nil {
+				return err
+			}
+		default:
+			// links and devices have no portable zip form
+		}
+	}
+
+	return zw.Close()
+}
+
+func writeTar(w io.Writer, files []*multipart.FileHeader) error {
+	tw := tar.NewWriter(w)
+	for _, f := range files {
+		if err := writeTarFile(tw, f); err != nil {
+			return err
+		}
+	}
+
+	return tw.Close()
+}
+
+func writeTarFile(tw *tar.Writer, f *multipart.FileHeader) error {
+	src, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	if err := tw.WriteHeader(&tar.Header{Name: f.Filename, Mode: 0644, Size: f.Size}); err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, src)
+
+	return err
+}
+
+// takePayload takes the content of a regular file entry out of the remaining budget.
+func takePayload(hdr *tar.Header, remain int64) (int64, error) {
+	if hdr.Typeflag != tar.TypeReg {
+		return remain, nil
+	}
+	if hdr.Size > remain {
+		return 0, fmt.Errorf("%w: %s goes past the download limit", ErrFileTooLarge, hdr.Name)
+	}
+
+	return remain - hdr.Size, nil
+}
+
+// limitWriter fails once more than remain bytes are written.
+type limitWriter struct {
+	w      io.Writer
+	remain int64
+}
+
+func (l *limitWriter) Write(p []byte) (int, error) {
+	if int64(len(p)) > l.remain {
+		return 0, ErrFileTooLarge
+	}
+	l.remain -= int64(len(p))
+
+	return l.w.Write(p)
+}
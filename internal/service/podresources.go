@@ -0,0 +1,183 @@
+package service
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+)
+
+// resourceFromLimit is the source of a request the api server copies from the limit
+const resourceFromLimit = "limit"
+
+// previewResources settles the resources of a new pod the way the api server does: a request left out
+// takes the limit, then the LimitRanger admission fills in the defaults of the Container LimitRanges
+// and checks their bounds. It makes no api calls, the QoS class is computed from the result.
+func previewResources(pod *corev1.Pod, limitRanges []corev1.LimitRange) *resp.PodResourcePreview {
+	slices.SortFunc(limitRanges, func(a, b corev1.LimitRange) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	res := &resp.PodResourcePreview{
+		LimitRanges: make([]string, 0, len(limitRanges)),
+		Violations:  []string{},
+	}
+	for _, lr := range limitRanges {
+		res.LimitRanges = append(res.LimitRanges, lr.Name)
+	}
+
+	containers := slices.Concat(pod.Spec.InitContainers, pod.Spec.Containers)
+	settled := make([]corev1.ResourceRequirements, 0, len(containers))
+	for i, c := range containers {
+		requests, limits := c.Resources.Requests.DeepCopy(), c.Resources.Limits.DeepCopy()
+		if requests == nil {
+			requests = corev1.ResourceList{}
+		}
+		if limits == nil {
+			limits = corev1.ResourceList{}
+		}
+		requestSource, limitSource := map[corev1.ResourceName]string{}, map[corev1.ResourceName]string{}
+		for name, q := range limits {
+			if _, ok := requests[name]; !ok {
+				requests[name] = q.DeepCopy()
+				requestSource[name] = resourceFromLimit
+			}
+		}
+
+		for _, lr := range limitRanges {
+			for _, item := range lr.Spec.Limits {
+				if item.Type != corev1.LimitTypeContainer {
+					continue
+				}
+				defaultResources(limits, item.Default, limitSource, lr.Name)
+				defaultResources(requests, item.DefaultRequest, requestSource, lr.Name)
+			}
+		}
+		for _, lr := range limitRanges {
+			for _, item := range lr.Spec.Limits {
+				if item.Type == corev1.LimitTypeContainer {
+					res.Violations = append(res.Violations, limitRangeViolations(c.Name, lr.Name, &item, requests, limits)...)
+				}
+			}
+		}
+
+		preview := resp.ContainerResourcePreview{
+			Name: c.Name,
+			Init: i < len(pod.Spec.InitContainers),
+		}
+		for _, name := range convert.ResourceNames(requests, limits) {
+			r := resp.ContainerResource{
+				Name:          string(name),
+				RequestSource: requestSource[name],
+				LimitSource:   limitSource[name],
+			}
+			if q, ok := requests[name]; ok {
+				r.Request = q.String()
+			}
+			if q, ok := limits[name]; ok {
+				r.Limit = q.String()
+			}
+			preview.Resources = append(preview.Resources, r)
+		}
+		res.Containers = append(res.Containers, preview)
+		settled = append(settled, corev1.ResourceRequirements{Requests: requests, Limits: limits})
+	}
+	res.QOSClass = string(podQOSClass(settled))
+
+	return res
+}
+
+// defaultResources sets the defaults the container leaves out, the first LimitRange to default a resource wins.
+func defaultResources(list corev1.ResourceList, defaults corev1.ResourceList, source map[corev1.ResourceName]string, limitRange string) {
+	for name, q := range defaults {
+		if _, ok := list[name]; !ok {
+			list[name] = q.DeepCopy()
+			source[name] = limitRange
+		}
+	}
+}
+
+// limitRangeViolations lists the bounds of a Container LimitRange item the container breaks,
+// a bounded resource the container has no value for is rejected as well.
+func limitRangeViolations(container, limitRange string, item *corev1.LimitRangeItem, requests, limits corev1.ResourceList) []string {
+	var res []string
+	for name, min := range item.Min {
+		if q, ok := requests[name]; !ok {
+			res = append(res, fmt.Sprintf("container %s has no %s request, LimitRange %s requires at least %s",
+				container, name, limitRange, min.String()))
+		} else if q.Cmp(min) < 0 {
+			res = append(res, fmt.Sprintf("container %s %s request %s is below the minimum %s of LimitRange %s",
+				container, name, q.String(), min.String(), limitRange))
+		}
+	}
+	for name, max := range item.Max {
+		if q, ok := limits[name]; !ok {
+			res = append(res, fmt.Sprintf("container %s has no %s limit, LimitRange %s allows at most %s",
+				container, name, limitRange, max.String()))
+		} else if q.Cmp(max) > 0 {
+			res = append(res, fmt.Sprintf("container %s %s limit %s is above the maximum %s of LimitRange %s",
+				container, name, q.String(), max.String(), limitRange))
+		}
+	}
+	for name, ratio := range item.MaxLimitRequestRatio {
+		request, ok := requests[name]
+		limit, found := limits[name]
+		if !ok || !found || request.IsZero() {
+			continue
+		}
+		if float64(limit.MilliValue())/float64(request.MilliValue()) > ratio.AsApproximateFloat64() {
+			res = append(res, fmt.Sprintf("container %s %s limit %s is more than %s times the request %s, the maximum ratio of LimitRange %s",
+				container, name, limit.String(), ratio.String(), request.String(), limitRange))
+		}
+	}
+	slices.Sort(res)
+
+	return res
+}
+
+// podQOSClass follows the kubelet: only cpu and memory count, a pod whose every container limits both
+// to what it requests is Guaranteed, one that sets none of them is BestEffort, the rest are Burstable.
+func podQOSClass(containers []corev1.ResourceRequirements) corev1.PodQOSClass {
+	qosResources := []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+	requests, limits := corev1.ResourceList{}, corev1.ResourceList{}
+	guaranteed := true
+	for _, c := range containers {
+		for _, name := range qosResources {
+			if q, ok := c.Requests[name]; ok && !q.IsZero() {
+				addQuantity(requests, name, q)
+			}
+			if q, ok := c.Limits[name]; ok && !q.IsZero() {
+				addQuantity(limits, name, q)
+			} else {
+				guaranteed = false
+			}
+		}
+	}
+
+	if len(requests) == 0 && len(limits) == 0 {
+		return corev1.PodQOSBestEffort
+	}
+	if guaranteed {
+		for _, name := range qosResources {
+			request, limit := requests[name], limits[name]
+			if request.Cmp(limit) != 0 {
+				guaranteed = false
+			}
+		}
+	}
+	if guaranteed {
+		return corev1.PodQOSGuaranteed
+	}
+
+	return corev1.PodQOSBurstable
+}
+
+func addQuantity(list corev1.ResourceList, name corev1.ResourceName, q resource.Quantity) {
+	sum := list[name]
+	sum.Add(q)
+	list[name] = sum
+}
@@ -0,0 +1,410 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+
+	"github.com/crazyfrankie/kube-ctl/conf"
+)
+
+const (
+	defaultMaxForwardSessions = 20
+	defaultForwardIdleTimeout = 10 * time.Minute
+	forwardReadyTimeout       = 10 * time.Second
+)
+
+var (
+	ErrTooManySessions = fmt.Errorf("too many port-forward sessions")
+	ErrForwardTarget   = fmt.Errorf("invalid port-forward target")
+)
+
+type PortForwardService interface {
+	// ForwardPod returns a session tunnelling to the pod port, port is a number or a container port name.
+	ForwardPod(ctx context.Context, namespace string, name string, port string) (*PortForwardSession, error)
+	// ForwardService resolves the service port to a ready backing pod and forwards to it.
+	ForwardService(ctx context.Context, namespace string, name string, port string) (*PortForwardSession, error)
+}
+
+// PortForwardSession is a pods/portforward stream exposed on a loopback listener.
+// Sessions are shared by all requests to the same pod port and closed once idle.
+type PortForwardSession struct {
+	key        string
+	addr       string
+	stopCh     chan struct{}
+	stopOnce   sync.Once
+	active     atomic.Int32
+	lastActive atomic.Int64
+	idle       time.Duration
+	// ready is closed once the tunnel is dialled, err is set before when dialling failed
+	ready chan struct{}
+	err   error
+}
+
+// Addr is the local address that forwards to the pod port.
+func (s *PortForwardSession) Addr() string {
+	return s.addr
+}
+
+// Acquire marks the session in use, an acquired session is never reaped.
+func (s *PortForwardSession) Acquire() {
+	s.active.Add(1)
+	s.touch()
+}
+
+func (s *PortForwardSession) Release() {
+	s.active.Add(-1)
+	s.touch()
+}
+
+// Pipe copies raw bytes between rw and the forwarded port until either side closes
+// or no traffic is seen for the idle timeout.
+func (s *PortForwardSession) Pipe(rw io.ReadWriteCloser) error {
+	s.Acquire()
+	defer s.Release()
+
+	conn, err := net.Dial("tcp", s.addr)
+	if err != nil {
+		return err
+	}
+
+	var last atomic.Int64
+	last.Store(time.Now().UnixNano())
+	done := make(chan error, 2)
+	var wg sync.WaitGroup
+	cp := func(dst io.Writer, src io.Reader) {
+		defer wg.Done()
+		buf := make([]byte, 32*1024)
+		for {
+			n, err := src.Read(buf)
+			if n > 0 {
+				last.Store(time.Now().UnixNano())
+				s.touch()
+				if _, werr := dst.Write(buf[:n]); werr != nil {
+					done <- werr
+					return
+				}
+			}
+			if err != nil {
+				done <- err
+				return
+			}
+		}
+	}
+	wg.Add(2)
+	go cp(conn, rw)
+	go cp(rw, conn)
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	// Closing both ends unblocks the copies, wait for them so neither writes after Pipe returns
+	defer wg.Wait()
+	defer conn.Close()
+	defer rw.Close()
+	for {
+		select {
+		case err := <-done:
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		case <-s.stopCh:
+			return nil
+		case <-ticker.C:
+			if time.Since(time.Unix(0, last.Load())) > s.idle {
+				return nil
+			}
+		}
+	}
+}
+
+func (s *PortForwardSession) touch() {
+	s.lastActive.Store(time.Now().UnixNano())
+}
+
+// dialled reports whether the tunnel is up, sessions still dialling are neither used nor reaped.
+func (s *PortForwardSession) dialled() bool {
+	select {
+	case <-s.ready:
+		return s.err == nil
+	default:
+		return false
+	}
+}
+
+func (s *PortForwardSession) close() {
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+}
+
+type portForwardService struct {
+	clientSet   *kubernetes.Clientset
+	config      *rest.Config
+	maxSessions int
+	idleTimeout time.Duration
+
+	mu       sync.Mutex
+	sessions map[string]*PortForwardSession
+}
+
+func NewPortForwardService(cs *kubernetes.Clientset, cfg *rest.Config) PortForwardService {
+	pf := conf.GetConf().PortForward
+	svc := &portForwardService{
+		clientSet:   cs,
+		config:      cfg,
+		maxSessions: pf.MaxSessions,
+		idleTimeout: pf.IdleTimeout,
+		sessions:    make(map[string]*PortForwardSession),
+	}
+	if svc.maxSessions <= 0 {
+		svc.maxSessions = defaultMaxForwardSessions
+	}
+	if svc.idleTimeout <= 0 {
+		svc.idleTimeout = defaultForwardIdleTimeout
+	}
+
+	go svc.reap()
+
+	return svc
+}
+
+func (s *portForwardService) ForwardPod(ctx context.Context, namespace string, name string, port string) (*PortForwardSession, error) {
+	pod, err := s.clientSet.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if pod.Status.Phase != corev1.PodRunning {
+		return nil, fmt.Errorf("%w: pod %s is %s", ErrForwardTarget, name, pod.Status.Phase)
+	}
+
+	target, err := resolvePodPort(pod, port)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.forward(namespace, name, target)
+}
+
+func (s *portForwardService) ForwardService(ctx context.Context, namespace string, name string, port string) (*PortForwardSession, error) {
+	svc, err := s.clientSet.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if len(svc.Spec.Selector) == 0 {
+		return nil, fmt.Errorf("%w: service %s has no selector", ErrForwardTarget, name)
+	}
+
+	var svcPort *corev1.ServicePort
+	for i, p := range svc.Spec.Ports {
+		if p.Name == port || strconv.Itoa(int(p.Port)) == port {
+			svcPort = &svc.Spec.Ports[i]
+			break
+		}
+	}
+	if svcPort == nil {
+		return nil, fmt.Errorf("%w: service %s has no port %s", ErrForwardTarget, name, port)
+	}
+
+	// The endpoint slice already carries the targetPort resolved against the pod spec
+	slices, err := s.clientSet.DiscoveryV1().EndpointSlices(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", discoveryv1.LabelServiceName, name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, slice := range slices.Items {
+		var target int32
+		for _, p := range slice.Ports {
+			if p.Name != nil && *p.Name == svcPort.Name && p.Port != nil {
+				target = *p.Port
+			}
+		}
+		if target == 0 {
+			continue
+		}
+		for _, e := range slice.Endpoints {
+			if e.Conditions.Ready != nil && !*e.Conditions.Ready {
+				continue
+			}
+			if e.TargetRef == nil || e.TargetRef.Kind != "Pod" {
+				continue
+			}
+			return s.forward(namespace, e.TargetRef.Name, target)
+		}
+	}
+
+	return nil, fmt.Errorf("%w: service %s has no ready endpoint for port %s", ErrForwardTarget, name, port)
+}
+
+func resolvePodPort(pod *corev1.Pod, port string) (int32, error) {
+	if n, err := strconv.Atoi(port); err == nil {
+		if n <= 0 || n > 65535 {
+			return 0, fmt.Errorf("%w: port %d out of range", ErrForwardTarget, n)
+		}
+		return int32(n), nil
+	}
+	for _, c := range pod.Spec.Containers {
+		for _, p := range c.Ports {
+			if p.Name == port {
+				return p.ContainerPort, nil
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("%w: pod %s has no port named %s", ErrForwardTarget, pod.Name, port)
+}
+
+func (s *portForwardService) forward(namespace string, pod string, port int32) (*PortForwardSession, error) {
+	key := fmt.Sprintf("%s/%s:%d", namespace, pod, port)
+
+	s.mu.Lock()
+	if session, ok := s.sessions[key]; ok {
+		s.mu.Unlock()
+		<-session.ready
+		if session.err != nil {
+			return nil, session.err
+		}
+		session.touch()
+		return session, nil
+	}
+	if len(s.sessions) >= s.maxSessions && !s.evictIdleLocked() {
+		s.mu.Unlock()
+		return nil, ErrTooManySessions
+	}
+	// Reserve the key so concurrent requests wait for this dial, which runs without the lock
+	// so a slow pod does not hold up the others
+	session := &PortForwardSession{
+		key:    key,
+		stopCh: make(chan struct{}),
+		idle:   s.idleTimeout,
+		ready:  make(chan struct{}),
+	}
+	s.sessions[key] = session
+	s.mu.Unlock()
+
+	if err := s.dial(session, namespace, pod, port); err != nil {
+		session.err = err
+		s.remove(session)
+		close(session.ready)
+		return nil, err
+	}
+	close(session.ready)
+
+	return session, nil
+}
+
+// dial opens the pods/portforward stream of session on a loopback port.
+func (s *portForwardService) dial(session *PortForwardSession, namespace string, pod string, port int32) error {
+	transport, upgrader, err := spdy.RoundTripperFor(s.config)
+	if err != nil {
+		return err
+	}
+	url := s.clientSet.CoreV1().RESTClient().Post().
+		Resource("pods").Namespace(namespace).Name(pod).SubResource("portforward").URL()
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, url)
+
+	readyCh := make(chan struct{})
+	// Let the kernel pick a free local port
+	fw, err := portforward.NewOnAddresses(dialer, []string{"127.0.0.1"}, []string{fmt.Sprintf("0:%d", port)},
+		session.stopCh, readyCh, io.Discard, io.Discard)
+	if err != nil {
+		return err
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- fw.ForwardPorts()
+	}()
+
+	select {
+	case <-readyCh:
+	case err := <-errCh:
+		// ForwardPorts can also end without an error before it is ready, the session has no address then
+		if err == nil {
+			err = fmt.Errorf("port-forward to %s ended before it was ready", session.key)
+		}
+		return err
+	case <-time.After(forwardReadyTimeout):
+		session.close()
+		return fmt.Errorf("port-forward to %s not ready after %s", session.key, forwardReadyTimeout)
+	}
+
+	ports, err := fw.GetPorts()
+	if err != nil || len(ports) == 0 {
+		session.close()
+		return fmt.Errorf("port-forward to %s has no local port: %v", session.key, err)
+	}
+	session.addr = fmt.Sprintf("127.0.0.1:%d", ports[0].Local)
+	session.touch()
+
+	// Drop the session once the stream ends, e.g. the pod is gone
+	go func() {
+		<-errCh
+		session.close()
+		s.remove(session)
+	}()
+
+	return nil
+}
+
+func (s *portForwardService) remove(session *PortForwardSession) {
+	s.mu.Lock()
+	if s.sessions[session.key] == session {
+		delete(s.sessions, session.key)
+	}
+	s.mu.Unlock()
+}
+
+// evictIdleLocked closes the least recently used session that is not in use.
+func (s *portForwardService) evictIdleLocked() bool {
+	var victim *PortForwardSession
+	for _, session := range s.sessions {
+		if !session.dialled() || session.active.Load() > 0 {
+			continue
+		}
+		if victim == nil || session.lastActive.Load() < victim.lastActive.Load() {
+			victim = session
+		}
+	}
+	if victim == nil {
+		return false
+	}
+	victim.close()
+	delete(s.sessions, victim.key)
+
+	return true
+}
+
+func (s *portForwardService) reap() {
+	ticker := time.NewTicker(s.idleTimeout / 2)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		s.mu.Lock()
+		for key, session := range s.sessions {
+			if !session.dialled() || session.active.Load() > 0 {
+				continue
+			}
+			if time.Since(time.Unix(0, session.lastActive.Load())) > s.idleTimeout {
+				session.close()
+				delete(s.sessions, key)
+			}
+		}
+		s.mu.Unlock()
+	}
+}
@@ -27,7 +27,8 @@ func NewPVService(cs *kubernetes.Clientset) PVService {
 
 func (s *pvService) CreatePV(ctx context.Context, req *req.PersistentVolume) error {
 	pv := convert.PVReqConvert(req)
-	_, err := s.clientSet.CoreV1().PersistentVolumes().Create(ctx, pv, metav1.CreateOptions{})
+	res, err := s.clientSet.CoreV1().PersistentVolumes().Create(ctx, pv, createOptions(ctx))
+	recordDryRun(ctx, DryRunCreate, nil, res)
 
 	return err
 }
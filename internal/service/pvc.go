@@ -2,19 +2,38 @@ package service
 
 import (
 	"context"
-	corev1 "k8s.io/api/core/v1"
+	"fmt"
 
+	"github.com/bytedance/sonic"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+)
+
+var (
+	ErrPVCExpand = fmt.Errorf("pvc can not be expanded")
 )
 
 type PVCService interface {
 	CreatePVC(ctx context.Context, req *req.PersistentVolumeClaim) error
 	DeletePVC(ctx context.Context, name string, namespace string) error
 	GetPVCList(ctx context.Context, namespace string) ([]corev1.PersistentVolumeClaim, error)
+	GetPVCDetail(ctx context.Context, name string, namespace string) (*corev1.PersistentVolumeClaim, error)
+	// GetPVCVolume returns the volume the claim is bound to, nil while it is pending.
+	GetPVCVolume(ctx context.Context, pvc *corev1.PersistentVolumeClaim) (*corev1.PersistentVolume, error)
+	// GetPVCPods lists the pods of the namespace that mount the claim.
+	GetPVCPods(ctx context.Context, pvc *corev1.PersistentVolumeClaim) ([]corev1.Pod, error)
+	// ExpandPVC raises the requested storage of a bound claim, its StorageClass has to allow expansion.
+	ExpandPVC(ctx context.Context, name string, namespace string, capacity string) error
+	// DiagnosePVC explains why a claim is pending.
+	DiagnosePVC(ctx context.Context, name string, namespace string) (*resp.PVCDiagnosis, error)
 }
 
 type pvcService struct {
@@ -27,7 +46,8 @@ func NewPVCService(cs *kubernetes.Clientset) PVCService {
 
 func (s *pvcService) CreatePVC(ctx context.Context, req *req.PersistentVolumeClaim) error {
 	pvc := convert.PVCReqConvert(req)
-	_, err := s.clientSet.CoreV1().PersistentVolumeClaims(pvc.Namespace).Create(ctx, pvc, metav1.CreateOptions{})
+	res, err := s.clientSet.CoreV1().PersistentVolumeClaims(pvc.Namespace).Create(ctx, pvc, createOptions(ctx))
+	recordDryRun(ctx, DryRunCreate, nil, res)
 
 	return err
 }
@@ -41,6 +61,133 @@ func (s *pvcService) GetPVCList(ctx context.Context, namespace string) ([]corev1
 	if err != nil {
 		return nil, err
 	}
-	
+
 	return list.Items, nil
 }
+
+func (s *pvcService) GetPVCDetail(ctx context.Context, name string, namespace string) (*corev1.PersistentVolumeClaim, error) {
+	return s.clientSet.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, name, metav1.GetOptions{})
+}
+
+func (s *pvcService) GetPVCVolume(ctx context.Context, pvc *corev1.PersistentVolumeClaim) (*corev1.PersistentVolume, error) {
+	if pvc.Spec.VolumeName == "" {
+		return nil, nil
+	}
+	pv, err := s.clientSet.CoreV1().PersistentVolumes().Get(ctx, pvc.Spec.VolumeName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+
+	return pv, err
+}
+
+func (s *pvcService) GetPVCPods(ctx context.Context, pvc *corev1.PersistentVolumeClaim) ([]corev1.Pod, error) {
+	list, err := s.clientSet.CoreV1().Pods(pvc.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]corev1.Pod, 0)
+	for _, pod := range list.Items {
+		for _, v := range pod.Spec.Volumes {
+			if v.PersistentVolumeClaim != nil && v.PersistentVolumeClaim.ClaimName == pvc.Name {
+				res = append(res, pod)
+				break
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (s *pvcService) ExpandPVC(ctx context.Context, name string, namespace string, capacity string) error {
+	size, err := resource.ParseQuantity(capacity)
+	if err != nil {
+		return fmt.Errorf("%w: capacity %s is not a quantity such as 20Gi", ErrPVCExpand, capacity)
+	}
+	pvc, err := s.clientSet.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if pvc.Status.Phase != corev1.ClaimBound {
+		return fmt.Errorf("%w: pvc %s is %s, only a bound claim can be expanded", ErrPVCExpand, name, pvc.Status.Phase)
+	}
+	if current := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; size.Cmp(current) <= 0 {
+		return fmt.Errorf("%w: capacity %s must be more than the current %s, claims can not shrink", ErrPVCExpand, capacity, current.String())
+	}
+	if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName == "" {
+		return fmt.Errorf("%w: pvc %s has no StorageClass", ErrPVCExpand, name)
+	}
+	class, err := s.clientSet.StorageV1().StorageClasses().Get(ctx, *pvc.Spec.StorageClassName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return fmt.Errorf("%w: StorageClass %s does not exist", ErrPVCExpand, *pvc.Spec.StorageClassName)
+		}
+		return err
+	}
+	if class.AllowVolumeExpansion == nil || !*class.AllowVolumeExpansion {
+		return fmt.Errorf("%w: StorageClass %s does not allow volume expansion", ErrPVCExpand, class.Name)
+	}
+
+	patch := map[string]any{
+		"spec": map[string]any{
+			"resources": map[string]any{
+				"requests": map[string]string{
+					string(corev1.ResourceStorage): size.String(),
+				},
+			},
+		},
+	}
+	data, err := sonic.Marshal(&patch)
+	if err != nil {
+		return err
+	}
+	_, err = s.clientSet.CoreV1().PersistentVolumeClaims(namespace).Patch(ctx, name, types.MergePatchType, data, metav1.PatchOptions{})
+
+	return err
+}
+
+func (s *pvcService) DiagnosePVC(ctx context.Context, name string, namespace string) (*resp.PVCDiagnosis, error) {
+	pvc, err := s.clientSet.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	in := &pvcDiagnosisInput{pvc: pvc}
+	if pvc.Spec.StorageClassName != nil {
+		in.className = *pvc.Spec.StorageClassName
+	}
+	if in.className != "" {
+		in.class, err = s.clientSet.StorageV1().StorageClasses().Get(ctx, in.className, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			in.class, err = nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	volumes, err := s.clientSet.CoreV1().PersistentVolumes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	in.volumes = volumes.Items
+	drivers, err := s.clientSet.StorageV1().CSIDrivers().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range drivers.Items {
+		in.csiDrivers = append(in.csiDrivers, d.Name)
+	}
+	if in.pods, err = s.GetPVCPods(ctx, pvc); err != nil {
+		return nil, err
+	}
+	events, err := s.clientSet.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: "involvedObject.kind=PersistentVolumeClaim,involvedObject.uid=" + string(pvc.UID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	in.events = events.Items
+
+	return diagnosePVC(in), nil
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+)
+
+const (
+	PVCCauseBound                = "Bound"
+	PVCCauseLost                 = "Lost"
+	PVCCauseBinding              = "Binding"
+	PVCCauseWaitForFirstConsumer = "WaitForFirstConsumer"
+	PVCCauseNoMatchingVolume     = "NoMatchingVolume"
+	PVCCauseStorageClassNotFound = "StorageClassNotFound"
+	PVCCauseSelectorNotSupported = "SelectorNotSupported"
+	PVCCauseProvisionerMissing   = "ProvisionerMissing"
+	PVCCauseProvisioningFailed   = "ProvisioningFailed"
+	PVCCauseProvisioning         = "Provisioning"
+
+	// noProvisioner marks the StorageClasses of statically created volumes, such as local volumes
+	noProvisioner = "kubernetes.io/no-provisioner"
+	// selectedNodeAnnotation is set by the scheduler once a pod using a WaitForFirstConsumer claim is placed
+	selectedNodeAnnotation = "volume.kubernetes.io/selected-node"
+	// pvcEventLimit is how many of the latest events of the claim are shown
+	pvcEventLimit = 10
+)
+
+// pvcDiagnosisInput holds the claim with the class it names (nil when it names none or the class is missing),
+// the volumes, the CSIDrivers, the pods using the claim and its events.
+type pvcDiagnosisInput struct {
+	pvc        *corev1.PersistentVolumeClaim
+	className  string
+	class      *storagev1.StorageClass
+	volumes    []corev1.PersistentVolume
+	csiDrivers []string
+	pods       []corev1.Pod
+	events     []corev1.Event
+}
+
+// diagnosePVC explains the phase of a claim the way the PV controller gets there: a pending claim
+// binds to an available volume of its class first, and is dynamically provisioned otherwise.
+func diagnosePVC(in *pvcDiagnosisInput) *resp.PVCDiagnosis {
+	res := &resp.PVCDiagnosis{
+		Status:       in.pvc.Status.Phase,
+		StorageClass: in.className,
+		Volumes:      []string{},
+		Events:       eventMessages(in.events),
+	}
+	waitForConsumer := false
+	if in.class != nil {
+		res.Provisioner = in.class.Provisioner
+		res.BindingMode = string(storagev1.VolumeBindingImmediate)
+		if in.class.VolumeBindingMode != nil {
+			res.BindingMode = string(*in.class.VolumeBindingMode)
+			waitForConsumer = *in.class.VolumeBindingMode == storagev1.VolumeBindingWaitForFirstConsumer
+		}
+	}
+
+	switch in.pvc.Status.Phase {
+	case corev1.ClaimBound:
+		res.Cause = PVCCauseBound
+		res.Message = fmt.Sprintf("bound to volume %s", in.pvc.Spec.VolumeName)
+		return res
+	case corev1.ClaimLost:
+		res.Cause = PVCCauseLost
+		res.Message = fmt.Sprintf("the bound volume %s no longer exists, recreate it to get the claim back", in.pvc.Spec.VolumeName)
+		return res
+	}
+
+	matched, mismatches := matchVolumes(in.pvc, in.className, in.volumes)
+	res.Volumes = mismatches
+	switch {
+	case in.pvc.Spec.VolumeName != "" && matched == "":
+		res.Cause = PVCCauseNoMatchingVolume
+		res.Message = fmt.Sprintf("the claim asks for volume %s, which does not exist or does not match", in.pvc.Spec.VolumeName)
+	case waitForConsumer && in.pvc.Annotations[selectedNodeAnnotation] == "":
+		res.Cause = PVCCauseWaitForFirstConsumer
+		res.Message = consumerMessage(in.className, in.pods)
+	case matched != "":
+		res.Cause = PVCCauseBinding
+		res.Message = fmt.Sprintf("volume %s matches, the PV controller binds it shortly", matched)
+	case in.class == nil && in.className != "":
+		res.Cause = PVCCauseStorageClassNotFound
+		res.Message = fmt.Sprintf("StorageClass %s does not exist, create it or a volume of that class", in.className)
+	case in.class == nil:
+		res.Cause = PVCCauseNoMatchingVolume
+		res.Message = "the claim has no StorageClass and there is no default one, it only binds to an available volume without a class"
+	case in.class.Provisioner == noProvisioner:
+		res.Cause = PVCCauseNoMatchingVolume
+		res.Message = fmt.Sprintf("StorageClass %s provisions nothing, create a volume that matches the claim", in.className)
+	case in.pvc.Spec.Selector != nil:
+		res.Cause = PVCCauseSelectorNotSupported
+		res.Message = "volumes are not provisioned for a claim with a selector, remove it or create a matching volume"
+	case !strings.HasPrefix(in.class.Provisioner, "kubernetes.io/") && !slices.Contains(in.csiDrivers, in.class.Provisioner):
+		res.Cause = PVCCauseProvisionerMissing
+		res.Message = fmt.Sprintf("no CSIDriver %s is registered, install the driver or, for a provisioner that is not CSI, make sure it is running", in.class.Provisioner)
+	default:
+		if msg := lastEvent(in.events, "ProvisioningFailed"); msg != "" {
+			res.Cause = PVCCauseProvisioningFailed
+			res.Message = msg
+			break
+		}
+		res.Cause = PVCCauseProvisioning
+		res.Message = fmt.Sprintf("waiting for %s to provision the volume", in.class.Provisioner)
+	}
+
+	return res
+}
+
+// matchVolumes returns the available volume the claim can bind to, and why the other volumes of its class can not.
+func matchVolumes(pvc *corev1.PersistentVolumeClaim, className string, volumes []corev1.PersistentVolume) (string, []string) {
+	request := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	mode := corev1.PersistentVolumeFilesystem
+	if pvc.Spec.VolumeMode != nil {
+		mode = *pvc.Spec.VolumeMode
+	}
+	var selector labels.Selector
+	if pvc.Spec.Selector != nil {
+		selector, _ = metav1.LabelSelectorAsSelector(pvc.Spec.Selector)
+	}
+
+	var matched string
+	mismatches := make([]string, 0)
+	for _, pv := range volumes {
+		if pv.Spec.StorageClassName != className || (pvc.Spec.VolumeName != "" && pv.Name != pvc.Spec.VolumeName) {
+			continue
+		}
+		var reasons []string
+		if ref := pv.Spec.ClaimRef; ref != nil && (ref.Namespace != pvc.Namespace || ref.Name != pvc.Name ||
+			(ref.UID != "" && ref.UID != pvc.UID)) {
+			reasons = append(reasons, fmt.Sprintf("reserved for claim %s/%s", ref.Namespace, ref.Name))
+		} else if pv.Status.Phase != corev1.VolumeAvailable && pv.Status.Phase != corev1.VolumePending {
+			reasons = append(reasons, fmt.Sprintf("status is %s", pv.Status.Phase))
+		}
+		if capacity := pv.Spec.Capacity[corev1.ResourceStorage]; capacity.Cmp(request) < 0 {
+			reasons = append(reasons, fmt.Sprintf("capacity %s is less than the %s requested", capacity.String(), request.String()))
+		}
+		for _, m := range pvc.Spec.AccessModes {
+			if !slices.Contains(pv.Spec.AccessModes, m) {
+				reasons = append(reasons, fmt.Sprintf("access mode %s is not supported", m))
+			}
+		}
+		pvMode := corev1.PersistentVolumeFilesystem
+		if pv.Spec.VolumeMode != nil {
+			pvMode = *pv.Spec.VolumeMode
+		}
+		if pvMode != mode {
+			reasons = append(reasons, fmt.Sprintf("volume mode is %s", pvMode))
+		}
+		if selector != nil && !selector.Matches(labels.Set(pv.Labels)) {
+			reasons = append(reasons, "labels do not match the selector")
+		}
+
+		if len(reasons) == 0 {
+			if matched == "" {
+				matched = pv.Name
+			}
+			continue
+		}
+		mismatches = append(mismatches, fmt.Sprintf("%s: %s", pv.Name, strings.Join(reasons, ", ")))
+	}
+	sort.Strings(mismatches)
+
+	return matched, mismatches
+}
+
+func consumerMessage(className string, pods []corev1.Pod) string {
+	if len(pods) == 0 {
+		return fmt.Sprintf("StorageClass %s binds the volume once a pod using the claim is scheduled, no pod uses it yet", className)
+	}
+	pod := &pods[0]
+	if msg := unschedulableMessage(pod); msg != "" {
+		return fmt.Sprintf("pod %s uses the claim but can not be scheduled: %s", pod.Name, msg)
+	}
+
+	return fmt.Sprintf("pod %s uses the claim and is waiting for the scheduler", pod.Name)
+}
+
+// eventMessages renders the latest events of the claim, oldest first.
+func eventMessages(events []corev1.Event) []string {
+	sorted := make([]corev1.Event, len(events))
+	copy(sorted, events)
+	sort.Slice(sorted, func(i, j int) bool {
+		return eventTime(&sorted[i]).Before(eventTime(&sorted[j]))
+	})
+	if len(sorted) > pvcEventLimit {
+		sorted = sorted[len(sorted)-pvcEventLimit:]
+	}
+
+	res := make([]string, 0, len(sorted))
+	for _, e := range sorted {
+		res = append(res, fmt.Sprintf("%s %s: %s", e.Type, e.Reason, e.Message))
+	}
+
+	return res
+}
+
+func lastEvent(events []corev1.Event, reason string) string {
+	var last *corev1.Event
+	for i := range events {
+		if events[i].Reason != reason {
+			continue
+		}
+		if last == nil || eventTime(last).Before(eventTime(&events[i])) {
+			last = &events[i]
+		}
+	}
+	if last == nil {
+		return ""
+	}
+
+	return last.Message
+}
+
+func eventTime(e *corev1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	default:
+		return e.CreationTimestamp.Time
+	}
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func diagnosisClaim(className string, phase corev1.PersistentVolumeClaimPhase) *corev1.PersistentVolumeClaim {
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "data"},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			StorageClassName: &className,
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")},
+			},
+		},
+		Status: corev1.PersistentVolumeClaimStatus{Phase: phase},
+	}
+}
+
+func diagnosisClass(name string, provisioner string, mode storagev1.VolumeBindingMode) *storagev1.StorageClass {
+	return &storagev1.StorageClass{
+		ObjectMeta:        metav1.ObjectMeta{Name: name},
+		Provisioner:       provisioner,
+		VolumeBindingMode: &mode,
+	}
+}
+
+func diagnosisVolume(name string, className string, capacity string) corev1.PersistentVolume {
+	return corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: corev1.PersistentVolumeSpec{
+			StorageClassName: className,
+			Capacity:         corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(capacity)},
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+		},
+		Status: corev1.PersistentVolumeStatus{Phase: corev1.VolumeAvailable},
+	}
+}
+
+func diagnosisEvent(reason string, message string, at time.Time) corev1.Event {
+	return corev1.Event{
+		Type:          corev1.EventTypeWarning,
+		Reason:        reason,
+		Message:       message,
+		LastTimestamp: metav1.NewTime(at),
+	}
+}
+
+func TestDiagnosePVC(t *testing.T) {
+	now := time.Now()
+	local := diagnosisClass("local", noProvisioner, storagev1.VolumeBindingWaitForFirstConsumer)
+	ebs := diagnosisClass("ebs", "ebs.csi.aws.com", storagev1.VolumeBindingImmediate)
+	lazyEBS := diagnosisClass("ebs-lazy", "ebs.csi.aws.com", storagev1.VolumeBindingWaitForFirstConsumer)
+	inTree := diagnosisClass("gp2", "kubernetes.io/aws-ebs", storagev1.VolumeBindingImmediate)
+
+	unschedulable := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "app"},
+		Status: corev1.PodStatus{Conditions: []corev1.PodCondition{{
+			Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Message: "0/3 nodes are available",
+		}}},
+	}
+	reserved := diagnosisVolume("reserved", "ebs", "20Gi")
+	reserved.Spec.ClaimRef = &corev1.ObjectReference{Namespace: "other", Name: "claim"}
+
+	tests := []struct {
+		name    string
+		in      pvcDiagnosisInput
+		cause   string
+		message string   // part of the message
+		volumes []string // the mismatching volumes, nil for none
+	}{
+		{
+			name: "bound",
+			in: pvcDiagnosisInput{
+				pvc: func() *corev1.PersistentVolumeClaim {
+					pvc := diagnosisClaim("ebs", corev1.ClaimBound)
+					pvc.Spec.VolumeName = "pv-1"
+					return pvc
+				}(),
+				className: "ebs", class: ebs,
+			},
+			cause:   PVCCauseBound,
+			message: "bound to volume pv-1",
+		},
+		{
+			name:    "wait for first consumer without pods",
+			in:      pvcDiagnosisInput{pvc: diagnosisClaim("ebs-lazy", corev1.ClaimPending), className: "ebs-lazy", class: lazyEBS},
+			cause:   PVCCauseWaitForFirstConsumer,
+			message: "no pod uses it yet",
+		},
+		{
+			name: "wait for first consumer with an unschedulable pod",
+			in: pvcDiagnosisInput{
+				pvc: diagnosisClaim("ebs-lazy", corev1.ClaimPending), className: "ebs-lazy", class: lazyEBS,
+				pods: []corev1.Pod{unschedulable},
+			},
+			cause:   PVCCauseWaitForFirstConsumer,
+			message: "pod app uses the claim but can not be scheduled: 0/3 nodes are available",
+		},
+		{
+			name: "wait for first consumer once a node is selected provisions",
+			in: pvcDiagnosisInput{
+				pvc: func() *corev1.PersistentVolumeClaim {
+					pvc := diagnosisClaim("ebs-lazy", corev1.ClaimPending)
+					pvc.Annotations = map[string]string{selectedNodeAnnotation: "node-1"}
+					return pvc
+				}(),
+				className: "ebs-lazy", class: lazyEBS, csiDrivers: []string{"ebs.csi.aws.com"},
+			},
+			cause:   PVCCauseProvisioning,
+			message: "waiting for ebs.csi.aws.com",
+		},
+		{
+			name: "matching volume binds",
+			in: pvcDiagnosisInput{
+				pvc: diagnosisClaim("ebs", corev1.ClaimPending), className: "ebs", class: ebs,
+				volumes: []corev1.PersistentVolume{diagnosisVolume("pv-1", "ebs", "10Gi")},
+			},
+			cause:   PVCCauseBinding,
+			message: "volume pv-1 matches",
+		},
+		{
+			name: "no matching volume of a static class",
+			in: pvcDiagnosisInput{
+				pvc: func() *corev1.PersistentVolumeClaim {
+					pvc := diagnosisClaim("local", corev1.ClaimPending)
+					pvc.Annotations = map[string]string{selectedNodeAnnotation: "node-1"}
+					return pvc
+				}(),
+				className: "local", class: local,
+				volumes: []corev1.PersistentVolume{
+					diagnosisVolume("small", "local", "5Gi"),
+					diagnosisVolume("other-class", "ebs", "10Gi"),
+				},
+			},
+			cause:   PVCCauseNoMatchingVolume,
+			message: "StorageClass local provisions nothing",
+			volumes: []string{"small: capacity 5Gi is less than the 10Gi requested"},
+		},
+		{
+			name: "named volume does not exist",
+			in: pvcDiagnosisInput{
+				pvc: func() *corev1.PersistentVolumeClaim {
+					pvc := diagnosisClaim("ebs", corev1.ClaimPending)
+					pvc.Spec.VolumeName = "gone"
+					return pvc
+				}(),
+				className: "ebs", class: ebs,
+			},
+			cause:   PVCCauseNoMatchingVolume,
+			message: "asks for volume gone",
+		},
+		{
+			name:    "storage class does not exist",
+			in:      pvcDiagnosisInput{pvc: diagnosisClaim("fast", corev1.ClaimPending), className: "fast"},
+			cause:   PVCCauseStorageClassNotFound,
+			message: "StorageClass fast does not exist",
+		},
+		{
+			name:    "no class and no default",
+			in:      pvcDiagnosisInput{pvc: diagnosisClaim("", corev1.ClaimPending)},
+			cause:   PVCCauseNoMatchingVolume,
+			message: "no default one",
+		},
+		{
+			name: "selector is not provisioned",
+			in: pvcDiagnosisInput{
+				pvc: func() *corev1.PersistentVolumeClaim {
+					pvc := diagnosisClaim("ebs", corev1.ClaimPending)
+					pvc.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "gold"}}
+					return pvc
+				}(),
+				className: "ebs", class: ebs, csiDrivers: []string{"ebs.csi.aws.com"},
+				volumes: []corev1.PersistentVolume{reserved},
+			},
+			cause:   PVCCauseSelectorNotSupported,
+			volumes: []string{"reserved: reserved for claim other/claim, labels do not match the selector"},
+		},
+		{
+			name:    "csi driver is not registered",
+			in:      pvcDiagnosisInput{pvc: diagnosisClaim("ebs", corev1.ClaimPending), className: "ebs", class: ebs},
+			cause:   PVCCauseProvisionerMissing,
+			message: "no CSIDriver ebs.csi.aws.com is registered",
+		},
+		{
+			name:    "in-tree provisioner needs no CSIDriver",
+			in:      pvcDiagnosisInput{pvc: diagnosisClaim("gp2", corev1.ClaimPending), className: "gp2", class: inTree},
+			cause:   PVCCauseProvisioning,
+			message: "waiting for kubernetes.io/aws-ebs",
+		},
+		{
+			name: "provisioning failed reports the latest failure",
+			in: pvcDiagnosisInput{
+				pvc: diagnosisClaim("ebs", corev1.ClaimPending), className: "ebs", class: ebs, csiDrivers: []string{"ebs.csi.aws.com"},
+				events: []corev1.Event{
+					diagnosisEvent("ProvisioningFailed", "quota exceeded", now.Add(-time.Minute)),
+					diagnosisEvent("ProvisioningFailed", "zone unavailable", now),
+					diagnosisEvent("Provisioning", "external provisioner is provisioning", now.Add(-2*time.Minute)),
+				},
+			},
+			cause:   PVCCauseProvisioningFailed,
+			message: "zone unavailable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := diagnosePVC(&tt.in)
+			if res.Cause != tt.cause {
+				t.Errorf("cause = %s, want %s (%s)", res.Cause, tt.cause, res.Message)
+			}
+			if !strings.Contains(res.Message, tt.message) {
+				t.Errorf("message = %q, want it to contain %q", res.Message, tt.message)
+			}
+			if tt.volumes == nil {
+				tt.volumes = []string{}
+			}
+			if !slices.Equal(res.Volumes, tt.volumes) {
+				t.Errorf("volumes = %v, want %v", res.Volumes, tt.volumes)
+			}
+		})
+	}
+}
+
+func TestDiagnosePVCEvents(t *testing.T) {
+	now := time.Now()
+	res := diagnosePVC(&pvcDiagnosisInput{
+		pvc: diagnosisClaim("", corev1.ClaimPending),
+		events: []corev1.Event{
+			diagnosisEvent("FailedBinding", "second", now),
+			diagnosisEvent("FailedBinding", "first", now.Add(-time.Minute)),
+		},
+	})
+
+	want := []string{"Warning FailedBinding: first", "Warning FailedBinding: second"}
+	if !slices.Equal(res.Events, want) {
+		t.Errorf("events = %v, want %v", res.Events, want)
+	}
+}
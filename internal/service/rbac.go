@@ -49,7 +49,8 @@ func NewRbacService(cs *kubernetes.Clientset) RbacService {
 func (s *rbacService) CreateServiceAccount(ctx context.Context, req *req.ServiceAccount) error {
 	sa := convert.ServiceAccountReqConvert(req)
 
-	_, err := s.clientSet.CoreV1().ServiceAccounts(req.Namespace).Create(ctx, sa, metav1.CreateOptions{})
+	res, err := s.clientSet.CoreV1().ServiceAccounts(req.Namespace).Create(ctx, sa, createOptions(ctx))
+	recordDryRun(ctx, DryRunCreate, nil, res)
 
 	return err
 }
@@ -73,33 +74,24 @@ func (s *rbacService) CreateOrUpdateRole(ctx context.Context, req *req.Role) err
 	// create or update ClusterRole
 	if req.Namespace == "" {
 		clusterRole := convert.ClusterRoleReqConvert(req)
-		if exists, err := s.clientSet.RbacV1().ClusterRoles().Get(ctx, clusterRole.Name, metav1.GetOptions{}); err == nil {
-			exists.ObjectMeta.Labels = clusterRole.Labels
-			exists.Rules = clusterRole.Rules
-
-			_, err := s.clientSet.RbacV1().ClusterRoles().Update(ctx, exists, metav1.UpdateOptions{})
-
+		client := s.clientSet.RbacV1().ClusterRoles()
+		live, found, err := getLive(ctx, client, clusterRole.Name)
+		if err != nil {
 			return err
 		}
 
-		_, err := s.clientSet.RbacV1().ClusterRoles().Create(ctx, clusterRole, metav1.CreateOptions{})
-
-		return err
+		return applyObject(ctx, client, clusterRole, live, found, req.ResourceVersion)
 	}
 
 	// create or update Role
 	role := convert.RoleReqConvert(req)
-	if exists, err := s.clientSet.RbacV1().Roles(role.Namespace).Get(ctx, role.Name, metav1.GetOptions{}); err == nil {
-		exists.ObjectMeta.Labels = role.Labels
-		exists.Rules = role.Rules
-
-		_, err := s.clientSet.RbacV1().Roles(role.Namespace).Update(ctx, exists, metav1.UpdateOptions{})
-
+	client := s.clientSet.RbacV1().Roles(role.Namespace)
+	live, found, err := getLive(ctx, client, role.Name)
+	if err != nil {
 		return err
 	}
-	_, err := s.clientSet.RbacV1().Roles(req.Namespace).Create(ctx, role, metav1.CreateOptions{})
 
-	return err
+	return applyObject(ctx, client, role, live, found, req.ResourceVersion)
 }
 
 func (s *rbacService) CreateOrUpdateRoleBinding(ctx context.Context, req *req.RoleBinding) error {
@@ -108,35 +100,24 @@ func (s *rbacService) CreateOrUpdateRoleBinding(ctx context.Context, req *req.Ro
 	// create ClusterRoleBinding
 	if req.Namespace == "" {
 		clusterRb := convert.ClusterRoleBindingReqConvert(req)
-		if exists, err := s.clientSet.RbacV1().ClusterRoleBindings().Get(ctx, clusterRb.Name, metav1.GetOptions{}); err == nil {
-			exists.ObjectMeta.Labels = clusterRb.Labels
-			exists.Subjects = clusterRb.Subjects
-			exists.RoleRef = clusterRb.RoleRef
-
-			_, err := s.clientSet.RbacV1().ClusterRoleBindings().Update(ctx, exists, metav1.UpdateOptions{})
-
+		client := s.clientSet.RbacV1().ClusterRoleBindings()
+		live, found, err := getLive(ctx, client, clusterRb.Name)
+		if err != nil {
 			return err
 		}
-		_, err := s.clientSet.RbacV1().ClusterRoleBindings().Create(ctx, clusterRb, metav1.CreateOptions{})
 
-		return err
+		return applyObject(ctx, client, clusterRb, live, found, req.ResourceVersion)
 	}
 
 	// create RoleBinding
 	rb := convert.RoleBindingReqConvert(req)
-
-	if exists, err := s.clientSet.RbacV1().RoleBindings(rb.Namespace).Get(ctx, rb.Name, metav1.GetOptions{}); err == nil {
-		exists.ObjectMeta.Labels = rb.Labels
-		exists.Subjects = rb.Subjects
-		exists.RoleRef = rb.RoleRef
-
-		_, err := s.clientSet.RbacV1().RoleBindings(rb.Namespace).Update(ctx, exists, metav1.UpdateOptions{})
-
+	client := s.clientSet.RbacV1().RoleBindings(rb.Namespace)
+	live, found, err := getLive(ctx, client, rb.Name)
+	if err != nil {
 		return err
 	}
-	_, err := s.clientSet.RbacV1().RoleBindings(req.Namespace).Create(ctx, rb, metav1.CreateOptions{})
 
-	return err
+	return applyObject(ctx, client, rb, live, found, req.ResourceVersion)
 }
 
 func (s *rbacService) DeleteRole(ctx context.Context, name string, namespace string) error {
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+)
+
+// reachabilityInput is everything the analysis looks at, fetched beforehand so the analysis itself
+// makes no api calls. The policies are those of the source and of the destination namespace.
+type reachabilityInput struct {
+	source               *corev1.Pod
+	sourceNamespace      *corev1.Namespace
+	destination          *corev1.Pod
+	destinationNamespace *corev1.Namespace
+	port                 string
+	protocol             corev1.Protocol
+	sourcePolicies       []networkingv1.NetworkPolicy
+	destinationPolicies  []networkingv1.NetworkPolicy
+}
+
+// analyzeReachability evaluates the policies the way the network plugin enforces them: the traffic passes
+// when the source pod may send it and the destination pod may receive it. A pod selected by no policy
+// for a direction is not isolated in that direction, one selected by some needs a rule of any of them to match.
+func analyzeReachability(in *reachabilityInput) (*resp.Reachability, error) {
+	protocol := in.protocol
+	if protocol == "" {
+		protocol = corev1.ProtocolTCP
+	}
+	port, portName, err := resolvePort(in.destination, in.port, protocol)
+	if err != nil {
+		return nil, err
+	}
+	target := fmt.Sprintf("%s/%s:%d/%s", in.destination.Namespace, in.destination.Name, port, protocol)
+
+	egress := policyVerdict(in.sourcePolicies, networkingv1.PolicyTypeEgress, in.source,
+		func(np *networkingv1.NetworkPolicy) bool {
+			for _, rule := range np.Spec.Egress {
+				if peersMatch(rule.To, np.Namespace, in.destination, in.destinationNamespace) &&
+					portsMatch(rule.Ports, port, portName, protocol) {
+					return true
+				}
+			}
+			return false
+		})
+	egress.Reason = verdictReason(egress, "egress", in.source, "to "+target)
+
+	ingress := policyVerdict(in.destinationPolicies, networkingv1.PolicyTypeIngress, in.destination,
+		func(np *networkingv1.NetworkPolicy) bool {
+			for _, rule := range np.Spec.Ingress {
+				if peersMatch(rule.From, np.Namespace, in.source, in.sourceNamespace) &&
+					portsMatch(rule.Ports, port, portName, protocol) {
+					return true
+				}
+			}
+			return false
+		})
+	ingress.Reason = verdictReason(ingress, "ingress", in.destination,
+		fmt.Sprintf("from %s/%s on %d/%s", in.source.Namespace, in.source.Name, port, protocol))
+
+	return &resp.Reachability{
+		Allowed: egress.Allowed && ingress.Allowed,
+		Port:    port,
+		Egress:  egress,
+		Ingress: ingress,
+	}, nil
+}
+
+func policyVerdict(policies []networkingv1.NetworkPolicy, policyType networkingv1.PolicyType, pod *corev1.Pod,
+	allows func(np *networkingv1.NetworkPolicy) bool) resp.PolicyVerdict {
+	verdict := resp.PolicyVerdict{Policies: []string{}, DecidedBy: []string{}}
+	for i := range policies {
+		np := &policies[i]
+		if np.Namespace != pod.Namespace || !hasPolicyType(np, policyType) || !selectorMatches(&np.Spec.PodSelector, pod.Labels) {
+			continue
+		}
+		verdict.Policies = append(verdict.Policies, np.Name)
+		if allows(np) {
+			verdict.DecidedBy = append(verdict.DecidedBy, np.Name)
+		}
+	}
+	verdict.Isolated = len(verdict.Policies) > 0
+	verdict.Allowed = !verdict.Isolated || len(verdict.DecidedBy) > 0
+
+	return verdict
+}
+
+func verdictReason(verdict resp.PolicyVerdict, direction string, pod *corev1.Pod, traffic string) string {
+	switch {
+	case !verdict.Isolated:
+		return fmt.Sprintf("no policy selects %s/%s for %s, all %s traffic is allowed", pod.Namespace, pod.Name, direction, direction)
+	case verdict.Allowed:
+		return fmt.Sprintf("%s %s allowed by %s", direction, traffic, strings.Join(verdict.DecidedBy, ","))
+	default:
+		return fmt.Sprintf("%s/%s is isolated for %s by %s and no rule allows traffic %s",
+			pod.Namespace, pod.Name, direction, strings.Join(verdict.Policies, ","), traffic)
+	}
+}
+
+// hasPolicyType applies the defaulting of the api server: Ingress always, Egress when there are egress rules.
+func hasPolicyType(np *networkingv1.NetworkPolicy, policyType networkingv1.PolicyType) bool {
+	if len(np.Spec.PolicyTypes) == 0 {
+		return policyType == networkingv1.PolicyTypeIngress || len(np.Spec.Egress) > 0
+	}
+	for _, t := range np.Spec.PolicyTypes {
+		if t == policyType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func selectorMatches(selector *metav1.LabelSelector, set map[string]string) bool {
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return false
+	}
+
+	return s.Matches(labels.Set(set))
+}
+
+// peersMatch tells whether the peer pod is one of peers, no peers means any peer.
+func peersMatch(peers []networkingv1.NetworkPolicyPeer, policyNamespace string, pod *corev1.Pod, ns *corev1.Namespace) bool {
+	if len(peers) == 0 {
+		return true
+	}
+	for _, p := range peers {
+		if p.IPBlock != nil {
+			if ipBlockContains(p.IPBlock, pod.Status.PodIP) {
+				return true
+			}
+			continue
+		}
+		if p.NamespaceSelector == nil {
+			if pod.Namespace != policyNamespace {
+				continue
+			}
+		} else if !selectorMatches(p.NamespaceSelector, ns.Labels) {
+			continue
+		}
+		if p.PodSelector == nil || selectorMatches(p.PodSelector, pod.Labels) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ipBlockContains(block *networkingv1.IPBlock, podIP string) bool {
+	ip := net.ParseIP(podIP)
+	if ip == nil {
+		return false
+	}
+	if _, cidr, err := net.ParseCIDR(block.CIDR); err != nil || !cidr.Contains(ip) {
+		return false
+	}
+	for _, e := range block.Except {
+		if _, except, err := net.ParseCIDR(e); err == nil && except.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// portsMatch tells whether the destination port is one of ports, no ports means any port.
+// A named port in a rule matches by the container port name of the destination pod.
+func portsMatch(ports []networkingv1.NetworkPolicyPort, port int32, portName string, protocol corev1.Protocol) bool {
+	if len(ports) == 0 {
+		return true
+	}
+	for _, p := range ports {
+		ruleProtocol := corev1.ProtocolTCP
+		if p.Protocol != nil {
+			ruleProtocol = *p.Protocol
+		}
+		if ruleProtocol != protocol {
+			continue
+		}
+		switch {
+		case p.Port == nil:
+			return true
+		case p.Port.Type == intstr.String:
+			if portName != "" && p.Port.StrVal == portName {
+				return true
+			}
+		case p.EndPort != nil:
+			if port >= p.Port.IntVal && port <= *p.EndPort {
+				return true
+			}
+		case p.Port.IntVal == port:
+			return true
+		}
+	}
+
+	return false
+}
+
+// resolvePort turns the requested port into the number and the container port name of the destination,
+// the name is empty when the number is not a declared container port.
+func resolvePort(pod *corev1.Pod, port string, protocol corev1.Protocol) (int32, string, error) {
+	number, err := strconv.Atoi(port)
+	for _, c := range pod.Spec.Containers {
+		for _, p := range c.Ports {
+			pp := p.Protocol
+			if pp == "" {
+				pp = corev1.ProtocolTCP
+			}
+			if pp != protocol {
+				continue
+			}
+			if err == nil && p.ContainerPort == int32(number) {
+				return p.ContainerPort, p.Name, nil
+			}
+			if err != nil && p.Name == port {
+				return p.ContainerPort, p.Name, nil
+			}
+		}
+	}
+	if err != nil {
+		return 0, "", fmt.Errorf("%w: pod %s has no %s port named %s", ErrReachabilityTarget, pod.Name, protocol, port)
+	}
+	if number < 1 || number > 65535 {
+		return 0, "", fmt.Errorf("%w: port %d must be in range 1-65535", ErrReachabilityTarget, number)
+	}
+
+	return int32(number), "", nil
+}
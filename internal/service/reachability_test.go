@@ -0,0 +1,324 @@
+package service
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func reachabilityNamespace(name string) *corev1.Namespace {
+	return &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"name": name}},
+	}
+}
+
+func reachabilityPod(namespace string, name string, app string, ip string, ports ...corev1.ContainerPort) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: map[string]string{"app": app}},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: name, Ports: ports}}},
+		Status:     corev1.PodStatus{PodIP: ip},
+	}
+}
+
+func reachabilityPolicy(namespace string, name string, app string, types ...networkingv1.PolicyType) networkingv1.NetworkPolicy {
+	return networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": app}},
+			PolicyTypes: types,
+		},
+	}
+}
+
+func reachabilityIngress(np networkingv1.NetworkPolicy, rules ...networkingv1.NetworkPolicyIngressRule) networkingv1.NetworkPolicy {
+	np.Spec.Ingress = rules
+	return np
+}
+
+func reachabilityEgress(np networkingv1.NetworkPolicy, rules ...networkingv1.NetworkPolicyEgressRule) networkingv1.NetworkPolicy {
+	np.Spec.Egress = rules
+	return np
+}
+
+func reachabilityAppSelector(app string) *metav1.LabelSelector {
+	return &metav1.LabelSelector{MatchLabels: map[string]string{"app": app}}
+}
+
+func reachabilityNamespaceSelector(name string) *metav1.LabelSelector {
+	return &metav1.LabelSelector{MatchLabels: map[string]string{"name": name}}
+}
+
+func reachabilityPorts(port intstr.IntOrString, endPort *int32) []networkingv1.NetworkPolicyPort {
+	return []networkingv1.NetworkPolicyPort{{Port: &port, EndPort: endPort}}
+}
+
+func TestAnalyzeReachability(t *testing.T) {
+	// web/frontend (10.0.1.5) talks to db/postgres (10.0.2.7) on 5432, declared as the container port "pg"
+	fromWeb := networkingv1.NetworkPolicyPeer{NamespaceSelector: reachabilityNamespaceSelector("web")}
+	endPort := func(p int32) *int32 { return &p }
+
+	tests := []struct {
+		name                string
+		port                string
+		sourcePolicies      []networkingv1.NetworkPolicy
+		destinationPolicies []networkingv1.NetworkPolicy
+
+		allowed         bool
+		egressIsolated  bool
+		ingressIsolated bool
+		decidedBy       []string // the ingress policies allowing the traffic
+	}{
+		{
+			name:    "no isolating policy",
+			port:    "5432",
+			allowed: true,
+			destinationPolicies: []networkingv1.NetworkPolicy{
+				// selects other pods only
+				reachabilityPolicy("db", "deny-redis", "redis", networkingv1.PolicyTypeIngress),
+			},
+		},
+		{
+			name: "ingress only isolation denies",
+			port: "5432",
+			destinationPolicies: []networkingv1.NetworkPolicy{
+				reachabilityPolicy("db", "deny-all", "postgres", networkingv1.PolicyTypeIngress),
+			},
+			ingressIsolated: true,
+		},
+		{
+			name: "ingress only isolation allows from namespace",
+			port: "5432",
+			destinationPolicies: []networkingv1.NetworkPolicy{
+				reachabilityPolicy("db", "deny-all", "postgres", networkingv1.PolicyTypeIngress),
+				reachabilityIngress(reachabilityPolicy("db", "from-web", "postgres", networkingv1.PolicyTypeIngress),
+					networkingv1.NetworkPolicyIngressRule{From: []networkingv1.NetworkPolicyPeer{fromWeb}}),
+			},
+			allowed:         true,
+			ingressIsolated: true,
+			decidedBy:       []string{"from-web"},
+		},
+		{
+			name: "egress and ingress both allow",
+			port: "5432",
+			sourcePolicies: []networkingv1.NetworkPolicy{
+				reachabilityEgress(reachabilityPolicy("web", "to-db", "frontend", networkingv1.PolicyTypeEgress),
+					networkingv1.NetworkPolicyEgressRule{
+						To:    []networkingv1.NetworkPolicyPeer{{NamespaceSelector: reachabilityNamespaceSelector("db")}},
+						Ports: reachabilityPorts(intstr.FromInt32(5432), nil),
+					}),
+			},
+			destinationPolicies: []networkingv1.NetworkPolicy{
+				reachabilityIngress(reachabilityPolicy("db", "from-web", "postgres", networkingv1.PolicyTypeIngress),
+					networkingv1.NetworkPolicyIngressRule{From: []networkingv1.NetworkPolicyPeer{fromWeb}}),
+			},
+			allowed:         true,
+			egressIsolated:  true,
+			ingressIsolated: true,
+			decidedBy:       []string{"from-web"},
+		},
+		{
+			name: "egress denies although ingress allows",
+			port: "5432",
+			sourcePolicies: []networkingv1.NetworkPolicy{
+				reachabilityEgress(reachabilityPolicy("web", "to-cache", "frontend", networkingv1.PolicyTypeEgress),
+					networkingv1.NetworkPolicyEgressRule{
+						To: []networkingv1.NetworkPolicyPeer{{PodSelector: reachabilityAppSelector("redis")}},
+					}),
+			},
+			destinationPolicies: []networkingv1.NetworkPolicy{
+				reachabilityIngress(reachabilityPolicy("db", "from-web", "postgres", networkingv1.PolicyTypeIngress),
+					networkingv1.NetworkPolicyIngressRule{From: []networkingv1.NetworkPolicyPeer{fromWeb}}),
+			},
+			egressIsolated:  true,
+			ingressIsolated: true,
+			decidedBy:       []string{"from-web"},
+		},
+		{
+			name: "namespaceSelector and podSelector in one peer match",
+			port: "5432",
+			destinationPolicies: []networkingv1.NetworkPolicy{
+				reachabilityIngress(reachabilityPolicy("db", "from-frontend", "postgres", networkingv1.PolicyTypeIngress),
+					networkingv1.NetworkPolicyIngressRule{From: []networkingv1.NetworkPolicyPeer{{
+						NamespaceSelector: reachabilityNamespaceSelector("web"),
+						PodSelector:       reachabilityAppSelector("frontend"),
+					}}}),
+			},
+			allowed:         true,
+			ingressIsolated: true,
+			decidedBy:       []string{"from-frontend"},
+		},
+		{
+			name: "namespaceSelector and podSelector in one peer need both",
+			port: "5432",
+			destinationPolicies: []networkingv1.NetworkPolicy{
+				reachabilityIngress(reachabilityPolicy("db", "from-backend", "postgres", networkingv1.PolicyTypeIngress),
+					networkingv1.NetworkPolicyIngressRule{From: []networkingv1.NetworkPolicyPeer{{
+						NamespaceSelector: reachabilityNamespaceSelector("web"),
+						PodSelector:       reachabilityAppSelector("backend"),
+					}}}),
+			},
+			ingressIsolated: true,
+		},
+		{
+			name: "podSelector alone only selects the policy namespace",
+			port: "5432",
+			destinationPolicies: []networkingv1.NetworkPolicy{
+				reachabilityIngress(reachabilityPolicy("db", "from-frontend", "postgres", networkingv1.PolicyTypeIngress),
+					networkingv1.NetworkPolicyIngressRule{From: []networkingv1.NetworkPolicyPeer{{
+						PodSelector: reachabilityAppSelector("frontend"),
+					}}}),
+			},
+			ingressIsolated: true,
+		},
+		{
+			name: "ipBlock contains the source",
+			port: "5432",
+			destinationPolicies: []networkingv1.NetworkPolicy{
+				reachabilityIngress(reachabilityPolicy("db", "from-cidr", "postgres", networkingv1.PolicyTypeIngress),
+					networkingv1.NetworkPolicyIngressRule{From: []networkingv1.NetworkPolicyPeer{{
+						IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/16"},
+					}}}),
+			},
+			allowed:         true,
+			ingressIsolated: true,
+			decidedBy:       []string{"from-cidr"},
+		},
+		{
+			name: "ipBlock except leaves the source out",
+			port: "5432",
+			destinationPolicies: []networkingv1.NetworkPolicy{
+				reachabilityIngress(reachabilityPolicy("db", "from-cidr", "postgres", networkingv1.PolicyTypeIngress),
+					networkingv1.NetworkPolicyIngressRule{From: []networkingv1.NetworkPolicyPeer{{
+						IPBlock: &networkingv1.IPBlock{CIDR: "10.0.0.0/16", Except: []string{"10.0.1.0/24"}},
+					}}}),
+			},
+			ingressIsolated: true,
+		},
+		{
+			name: "named port in the rule matches the number asked for",
+			port: "5432",
+			destinationPolicies: []networkingv1.NetworkPolicy{
+				reachabilityIngress(reachabilityPolicy("db", "pg-port", "postgres", networkingv1.PolicyTypeIngress),
+					networkingv1.NetworkPolicyIngressRule{Ports: reachabilityPorts(intstr.FromString("pg"), nil)}),
+			},
+			allowed:         true,
+			ingressIsolated: true,
+			decidedBy:       []string{"pg-port"},
+		},
+		{
+			name: "named port asked for matches the number in the rule",
+			port: "pg",
+			destinationPolicies: []networkingv1.NetworkPolicy{
+				reachabilityIngress(reachabilityPolicy("db", "pg-port", "postgres", networkingv1.PolicyTypeIngress),
+					networkingv1.NetworkPolicyIngressRule{Ports: reachabilityPorts(intstr.FromInt32(5432), nil)}),
+			},
+			allowed:         true,
+			ingressIsolated: true,
+			decidedBy:       []string{"pg-port"},
+		},
+		{
+			name: "other named port does not match",
+			port: "5432",
+			destinationPolicies: []networkingv1.NetworkPolicy{
+				reachabilityIngress(reachabilityPolicy("db", "http-port", "postgres", networkingv1.PolicyTypeIngress),
+					networkingv1.NetworkPolicyIngressRule{Ports: reachabilityPorts(intstr.FromString("http"), nil)}),
+			},
+			ingressIsolated: true,
+		},
+		{
+			name: "endPort range contains the port",
+			port: "5432",
+			destinationPolicies: []networkingv1.NetworkPolicy{
+				reachabilityIngress(reachabilityPolicy("db", "range", "postgres", networkingv1.PolicyTypeIngress),
+					networkingv1.NetworkPolicyIngressRule{Ports: reachabilityPorts(intstr.FromInt32(5000), endPort(6000))}),
+			},
+			allowed:         true,
+			ingressIsolated: true,
+			decidedBy:       []string{"range"},
+		},
+		{
+			name: "endPort range leaves the port out",
+			port: "5432",
+			destinationPolicies: []networkingv1.NetworkPolicy{
+				reachabilityIngress(reachabilityPolicy("db", "range", "postgres", networkingv1.PolicyTypeIngress),
+					networkingv1.NetworkPolicyIngressRule{Ports: reachabilityPorts(intstr.FromInt32(6000), endPort(7000))}),
+			},
+			ingressIsolated: true,
+		},
+		{
+			name: "default policyTypes isolate ingress",
+			port: "5432",
+			destinationPolicies: []networkingv1.NetworkPolicy{
+				reachabilityPolicy("db", "no-types", "postgres"),
+			},
+			ingressIsolated: true,
+		},
+		{
+			name: "default policyTypes isolate egress only with egress rules",
+			port: "5432",
+			sourcePolicies: []networkingv1.NetworkPolicy{
+				// without egress rules it only isolates ingress of the source
+				reachabilityPolicy("web", "no-types", "frontend"),
+				reachabilityEgress(reachabilityPolicy("web", "egress-rules", "frontend"),
+					networkingv1.NetworkPolicyEgressRule{
+						To: []networkingv1.NetworkPolicyPeer{{IPBlock: &networkingv1.IPBlock{CIDR: "192.168.0.0/16"}}},
+					}),
+			},
+			egressIsolated: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := analyzeReachability(&reachabilityInput{
+				source:               reachabilityPod("web", "frontend", "frontend", "10.0.1.5"),
+				sourceNamespace:      reachabilityNamespace("web"),
+				destination:          reachabilityPod("db", "postgres", "postgres", "10.0.2.7", corev1.ContainerPort{Name: "pg", ContainerPort: 5432}),
+				destinationNamespace: reachabilityNamespace("db"),
+				port:                 tt.port,
+				sourcePolicies:       tt.sourcePolicies,
+				destinationPolicies:  tt.destinationPolicies,
+			})
+			if err != nil {
+				t.Fatalf("analyzeReachability: %v", err)
+			}
+
+			if res.Allowed != tt.allowed {
+				t.Errorf("allowed = %v, want %v (egress: %s; ingress: %s)", res.Allowed, tt.allowed, res.Egress.Reason, res.Ingress.Reason)
+			}
+			if res.Port != 5432 {
+				t.Errorf("port = %d, want 5432", res.Port)
+			}
+			if res.Egress.Isolated != tt.egressIsolated {
+				t.Errorf("egress isolated = %v, want %v", res.Egress.Isolated, tt.egressIsolated)
+			}
+			if res.Ingress.Isolated != tt.ingressIsolated {
+				t.Errorf("ingress isolated = %v, want %v", res.Ingress.Isolated, tt.ingressIsolated)
+			}
+			if tt.decidedBy == nil {
+				tt.decidedBy = []string{}
+			}
+			if !slices.Equal(res.Ingress.DecidedBy, tt.decidedBy) {
+				t.Errorf("ingress decided by %v, want %v", res.Ingress.DecidedBy, tt.decidedBy)
+			}
+		})
+	}
+}
+
+func TestAnalyzeReachabilityUnknownPort(t *testing.T) {
+	_, err := analyzeReachability(&reachabilityInput{
+		source:               reachabilityPod("web", "frontend", "frontend", "10.0.1.5"),
+		sourceNamespace:      reachabilityNamespace("web"),
+		destination:          reachabilityPod("db", "postgres", "postgres", "10.0.2.7", corev1.ContainerPort{Name: "pg", ContainerPort: 5432}),
+		destinationNamespace: reachabilityNamespace("db"),
+		port:                 "http",
+	})
+	if !errors.Is(err, ErrReachabilityTarget) {
+		t.Fatalf("err = %v, want %v", err, ErrReachabilityTarget)
+	}
+}
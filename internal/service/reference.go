@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/bytedance/sonic"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+)
+
+const (
+	RefKindConfigMap = "ConfigMap"
+	RefKindSecret    = "Secret"
+
+	restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+)
+
+type refKey struct {
+	kind string
+	name string
+}
+
+// referenceIndex maps a ConfigMap or Secret to the workloads whose pod template uses it.
+type referenceIndex map[refKey][]resp.Consumer
+
+// buildReferenceIndex scans the pod templates of every workload in namespace, plus the pods
+// without a controller. Pods created by a workload are covered by its template.
+func buildReferenceIndex(ctx context.Context, cs *kubernetes.Clientset, namespace string) (referenceIndex, error) {
+	index := make(referenceIndex)
+
+	deployments, err := cs.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range deployments.Items {
+		index.add("Deployment", d.Name, true, &d.Spec.Template.Spec)
+	}
+
+	statefulSets, err := cs.AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range statefulSets.Items {
+		index.add("StatefulSet", s.Name, true, &s.Spec.Template.Spec)
+	}
+
+	daemonSets, err := cs.AppsV1().DaemonSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range daemonSets.Items {
+		index.add("DaemonSet", d.Name, true, &d.Spec.Template.Spec)
+	}
+
+	cronJobs, err := cs.BatchV1().CronJobs(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range cronJobs.Items {
+		index.add("CronJob", c.Name, false, &c.Spec.JobTemplate.Spec.Template.Spec)
+	}
+
+	jobs, err := cs.BatchV1().Jobs(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, j := range jobs.Items {
+		if metav1.GetControllerOf(&j) != nil {
+			continue
+		}
+		index.add("Job", j.Name, false, &j.Spec.Template.Spec)
+	}
+
+	pods, err := cs.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range pods.Items {
+		if metav1.GetControllerOf(&p) != nil {
+			continue
+		}
+		index.add("Pod", p.Name, false, &p.Spec)
+	}
+
+	return index, nil
+}
+
+// Consumers returns the users of the ConfigMap or Secret, ordered by kind and name.
+func (idx referenceIndex) Consumers(kind string, name string) []resp.Consumer {
+	consumers := idx[refKey{kind: kind, name: name}]
+	if consumers == nil {
+		return []resp.Consumer{}
+	}
+	sort.Slice(consumers, func(i, j int) bool {
+		if consumers[i].Kind != consumers[j].Kind {
+			return consumers[i].Kind < consumers[j].Kind
+		}
+		return consumers[i].Name < consumers[j].Name
+	})
+
+	return consumers
+}
+
+func (idx referenceIndex) add(kind string, name string, restartable bool, spec *corev1.PodSpec) {
+	for key, refs := range podSpecRefs(spec) {
+		idx[key] = append(idx[key], resp.Consumer{
+			Kind:        kind,
+			Name:        name,
+			Refs:        refs,
+			Restartable: restartable,
+		})
+	}
+}
+
+// podSpecRefs collects the ConfigMaps and Secrets used by volumes, projected volumes,
+// env valueFrom, envFrom and image pull secrets.
+func podSpecRefs(spec *corev1.PodSpec) map[refKey][]string {
+	refs := make(map[refKey][]string)
+	ref := func(kind string, name string, where string) {
+		if name == "" {
+			return
+		}
+		key := refKey{kind: kind, name: name}
+		refs[key] = append(refs[key], where)
+	}
+
+	for _, v := range spec.Volumes {
+		switch {
+		case v.ConfigMap != nil:
+			ref(RefKindConfigMap, v.ConfigMap.Name, "volume:"+v.Name)
+		case v.Secret != nil:
+			ref(RefKindSecret, v.Secret.SecretName, "volume:"+v.Name)
+		case v.Projected != nil:
+			for _, src := range v.Projected.Sources {
+				if src.ConfigMap != nil {
+					ref(RefKindConfigMap, src.ConfigMap.Name, "projected:"+v.Name)
+				}
+				if src.Secret != nil {
+					ref(RefKindSecret, src.Secret.Name, "projected:"+v.Name)
+				}
+			}
+		}
+	}
+
+	containers := make([]corev1.Container, 0, len(spec.InitContainers)+len(spec.Containers))
+	containers = append(containers, spec.InitContainers...)
+	containers = append(containers, spec.Containers...)
+	for _, c := range containers {
+		for _, e := range c.EnvFrom {
+			if e.ConfigMapRef != nil {
+				ref(RefKindConfigMap, e.ConfigMapRef.Name, "envFrom:"+c.Name)
+			}
+			if e.SecretRef != nil {
+				ref(RefKindSecret, e.SecretRef.Name, "envFrom:"+c.Name)
+			}
+		}
+		for _, e := range c.Env {
+			if e.ValueFrom == nil {
+				continue
+			}
+			if e.ValueFrom.ConfigMapKeyRef != nil {
+				ref(RefKindConfigMap, e.ValueFrom.ConfigMapKeyRef.Name, fmt.Sprintf("env:%s/%s", c.Name, e.Name))
+			}
+			if e.ValueFrom.SecretKeyRef != nil {
+				ref(RefKindSecret, e.ValueFrom.SecretKeyRef.Name, fmt.Sprintf("env:%s/%s", c.Name, e.Name))
+			}
+		}
+	}
+
+	for _, s := range spec.ImagePullSecrets {
+		ref(RefKindSecret, s.Name, "imagePullSecret")
+	}
+
+	return refs
+}
+
+// restartConsumers triggers a rollout restart the same way as kubectl, by stamping the pod template.
+// Consumers that cannot be restarted are skipped, the restarted ones are returned.
+func restartConsumers(ctx context.Context, cs *kubernetes.Clientset, namespace string, consumers []resp.Consumer) ([]resp.Consumer, error) {
+	patch := map[string]any{
+		"spec": map[string]any{
+			"template": map[string]any{
+				"metadata": map[string]any{
+					"annotations": map[string]string{
+						restartedAtAnnotation: time.Now().Format(time.RFC3339),
+					},
+				},
+			},
+		},
+	}
+	data, err := sonic.Marshal(&patch)
+	if err != nil {
+		return nil, err
+	}
+
+	restarted := make([]resp.Consumer, 0, len(consumers))
+	for _, c := range consumers {
+		switch c.Kind {
+		case "Deployment":
+			_, err = cs.AppsV1().Deployments(namespace).Patch(ctx, c.Name, types.StrategicMergePatchType, data, metav1.PatchOptions{})
+		case "StatefulSet":
+			_, err = cs.AppsV1().StatefulSets(namespace).Patch(ctx, c.Name, types.StrategicMergePatchType, data, metav1.PatchOptions{})
+		case "DaemonSet":
+			_, err = cs.AppsV1().DaemonSets(namespace).Patch(ctx, c.Name, types.StrategicMergePatchType, data, metav1.PatchOptions{})
+		default:
+			continue
+		}
+		if err != nil {
+			return restarted, fmt.Errorf("restart %s %s: %w", c.Kind, c.Name, err)
+		}
+		restarted = append(restarted, c)
+	}
+
+	return restarted, nil
+}
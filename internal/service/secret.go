@@ -2,6 +2,10 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -9,13 +13,38 @@ import (
 
 	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+	"github.com/crazyfrankie/kube-ctl/pkg/consts"
 )
 
+var (
+	ErrSecretMasked   = fmt.Errorf("masked secret value has no stored value to keep")
+	ErrSecretKey      = fmt.Errorf("secret key not found")
+	ErrSecretTooLarge = fmt.Errorf("secret exceeds the size limit")
+)
+
+// SecretAudit identifies who revealed a secret and why.
+type SecretAudit struct {
+	ClientIP  string
+	UserAgent string
+	Reason    string
+}
+
 type SecretService interface {
 	CreateOrUpdateSecret(ctx context.Context, req *req.Secret) error
+	CreateOrUpdateDockerConfigSecret(ctx context.Context, req *req.DockerConfigSecret) error
+	CreateOrUpdateTLSSecret(ctx context.Context, req *req.TLSSecret) error
+	CreateOrUpdateBasicAuthSecret(ctx context.Context, req *req.BasicAuthSecret) error
+	CreateOrUpdateSSHAuthSecret(ctx context.Context, req *req.SSHAuthSecret) error
 	GetSecret(ctx context.Context, name string, namespace string) (*corev1.Secret, error)
 	GetSecretList(ctx context.Context, namespace string) ([]corev1.Secret, error)
 	DeleteSecret(ctx context.Context, name string, namespace string) error
+	// RevealSecret returns decoded values and records who asked for them.
+	RevealSecret(ctx context.Context, req *req.SecretReveal, audit SecretAudit) ([]resp.SecretItem, error)
+	// GetConsumers lists the workloads and bare pods that use the secret.
+	GetConsumers(ctx context.Context, name string, namespace string) ([]resp.Consumer, error)
+	// RestartConsumers rolls out the restartable consumers so they pick up the new data.
+	RestartConsumers(ctx context.Context, name string, namespace string) ([]resp.Consumer, error)
 }
 
 type secretService struct {
@@ -27,19 +56,55 @@ func NewSecretService(cs *kubernetes.Clientset) SecretService {
 }
 
 func (s *secretService) CreateOrUpdateSecret(ctx context.Context, req *req.Secret) error {
-	secret := convert.SecretReqConvert(req)
-	if _, err := s.clientSet.CoreV1().Secrets(secret.Namespace).Get(ctx, secret.Name, metav1.GetOptions{}); err == nil {
-		_, err := s.clientSet.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{})
-		if err != nil {
-			return err
-		}
+	return s.apply(ctx, convert.SecretReqConvert(req), req.ResourceVersion)
+}
 
-		return nil
+func (s *secretService) CreateOrUpdateDockerConfigSecret(ctx context.Context, req *req.DockerConfigSecret) error {
+	secret, err := convert.DockerConfigSecretReqConvert(req)
+	if err != nil {
+		return err
 	}
 
-	_, err := s.clientSet.CoreV1().Secrets(secret.Namespace).Create(ctx, secret, metav1.CreateOptions{})
+	return s.apply(ctx, secret, req.ResourceVersion)
+}
+
+func (s *secretService) CreateOrUpdateTLSSecret(ctx context.Context, req *req.TLSSecret) error {
+	return s.apply(ctx, convert.TLSSecretReqConvert(req), req.ResourceVersion)
+}
 
-	return err
+func (s *secretService) CreateOrUpdateBasicAuthSecret(ctx context.Context, req *req.BasicAuthSecret) error {
+	return s.apply(ctx, convert.BasicAuthSecretReqConvert(req), req.ResourceVersion)
+}
+
+func (s *secretService) CreateOrUpdateSSHAuthSecret(ctx context.Context, req *req.SSHAuthSecret) error {
+	return s.apply(ctx, convert.SSHAuthSecretReqConvert(req), req.ResourceVersion)
+}
+
+// apply creates or updates the secret with server-side apply.
+// A value equal to the mask keeps what is stored, so a detail view can be sent back unchanged.
+// A non-empty resourceVersion makes the update fail when the secret changed since it was read.
+func (s *secretService) apply(ctx context.Context, secret *corev1.Secret, resourceVersion string) error {
+	client := s.clientSet.CoreV1().Secrets(secret.Namespace)
+	old, found, err := getLive(ctx, client, secret.Name)
+	if err != nil {
+		return err
+	}
+
+	for k, v := range secret.Data {
+		if string(v) != consts.SecretMaskValue {
+			continue
+		}
+		if !found {
+			return fmt.Errorf("%w: %s", ErrSecretMasked, k)
+		}
+		stored, ok := old.Data[k]
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrSecretMasked, k)
+		}
+		secret.Data[k] = stored
+	}
+
+	return applyObject(ctx, client, secret, old, found, resourceVersion)
 }
 
 func (s *secretService) GetSecret(ctx context.Context, name string, namespace string) (*corev1.Secret, error) {
@@ -63,3 +128,75 @@ func (s *secretService) GetSecretList(ctx context.Context, namespace string) ([]
 func (s *secretService) DeleteSecret(ctx context.Context, name string, namespace string) error {
 	return s.clientSet.CoreV1().Secrets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
 }
+
+func (s *secretService) RevealSecret(ctx context.Context, req *req.SecretReveal, audit SecretAudit) ([]resp.SecretItem, error) {
+	secret, err := s.clientSet.CoreV1().Secrets(req.Namespace).Get(ctx, req.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range req.Keys {
+		if _, ok := secret.Data[k]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrSecretKey, k)
+		}
+	}
+
+	items := convert.SecretRevealResp(secret, req.Keys)
+	keys := make([]string, 0, len(items))
+	for _, i := range items {
+		keys = append(keys, i.Key)
+	}
+
+	msg := fmt.Sprintf("keys [%s] revealed by %s (%s), reason: %s",
+		strings.Join(keys, ","), audit.ClientIP, audit.UserAgent, audit.Reason)
+	log.Printf("audit: secret %s/%s %s", secret.Namespace, secret.Name, msg)
+	s.recordRevealEvent(ctx, secret, msg)
+
+	return items, nil
+}
+
+// recordRevealEvent leaves an audit trail on the secret, visible with kubectl describe.
+func (s *secretService) recordRevealEvent(ctx context.Context, secret *corev1.Secret, msg string) {
+	now := metav1.NewTime(time.Now())
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: secret.Name + "-reveal-",
+			Namespace:    secret.Namespace,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Kind:            "Secret",
+			APIVersion:      "v1",
+			Name:            secret.Name,
+			Namespace:       secret.Namespace,
+			UID:             secret.UID,
+			ResourceVersion: secret.ResourceVersion,
+		},
+		Reason:         "SecretRevealed",
+		Message:        msg,
+		Type:           corev1.EventTypeNormal,
+		Source:         corev1.EventSource{Component: "kube-ctl"},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+	if _, err := s.clientSet.CoreV1().Events(secret.Namespace).Create(ctx, event, metav1.CreateOptions{}); err != nil {
+		log.Printf("audit: failed to record reveal event for secret %s/%s: %v", secret.Namespace, secret.Name, err)
+	}
+}
+
+func (s *secretService) GetConsumers(ctx context.Context, name string, namespace string) ([]resp.Consumer, error) {
+	index, err := buildReferenceIndex(ctx, s.clientSet, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return index.Consumers(RefKindSecret, name), nil
+}
+
+func (s *secretService) RestartConsumers(ctx context.Context, name string, namespace string) ([]resp.Consumer, error) {
+	consumers, err := s.GetConsumers(ctx, name, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return restartConsumers(ctx, s.clientSet, namespace, consumers)
+}
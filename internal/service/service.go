@@ -2,13 +2,22 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"strconv"
 
+	"github.com/bytedance/sonic"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
+	"github.com/crazyfrankie/kube-ctl/pkg/utils"
 )
 
 type SvcService interface {
@@ -16,6 +25,7 @@ type SvcService interface {
 	DeleteService(ctx context.Context, name string, namespace string) error
 	GetServiceDetail(ctx context.Context, name string, namespace string) (*corev1.Service, error)
 	GetServiceList(ctx context.Context, namespace string) ([]corev1.Service, error)
+	GetServiceTopology(ctx context.Context, name string, namespace string) (*resp.ServiceTopology, error)
 }
 
 type svcService struct {
@@ -29,16 +39,13 @@ func NewServiceService(cs *kubernetes.Clientset) SvcService {
 func (s *svcService) CreateOrUpdateService(ctx context.Context, req *req.Service) error {
 	svc := convert.ServiceReqConvert(req)
 
-	if exists, err := s.clientSet.CoreV1().Services(svc.Namespace).Get(ctx, svc.Name, metav1.GetOptions{}); err == nil {
-		exists.Spec = svc.Spec
-		_, err := s.clientSet.CoreV1().Services(svc.Namespace).Update(ctx, exists, metav1.UpdateOptions{})
-
+	client := s.clientSet.CoreV1().Services(svc.Namespace)
+	live, found, err := getLive(ctx, client, svc.Name)
+	if err != nil {
 		return err
 	}
 
-	_, err := s.clientSet.CoreV1().Services(svc.Namespace).Create(ctx, svc, metav1.CreateOptions{})
-
-	return err
+	return applyObject(ctx, client, svc, live, found, req.ResourceVersion)
 }
 
 func (s *svcService) DeleteService(ctx context.Context, name string, namespace string) error {
@@ -62,3 +69,235 @@ func (s *svcService) GetServiceList(ctx context.Context, namespace string) ([]co
 
 	return res.Items, nil
 }
+
+// GetServiceTopology resolves the whole routing chain of a service:
+// ingress routes -> service ports -> endpoint slices -> backing pods.
+func (s *svcService) GetServiceTopology(ctx context.Context, name string, namespace string) (*resp.ServiceTopology, error) {
+	svc, err := s.clientSet.CoreV1().Services(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &resp.ServiceTopology{
+		Name:      svc.Name,
+		Namespace: svc.Namespace,
+		Type:      svc.Spec.Type,
+		ClusterIP: svc.Spec.ClusterIP,
+		Selector:  utils.ResMapToItem(svc.Spec.Selector),
+		Endpoints: make([]resp.ServiceEndpoint, 0),
+		Pods:      make([]string, 0),
+		Routes:    make([]resp.ServiceRoute, 0),
+		Warnings:  make([]string, 0),
+	}
+
+	// endpoints
+	slices, err := s.clientSet.DiscoveryV1().EndpointSlices(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", discoveryv1.LabelServiceName, name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var ready int
+	for _, slice := range slices.Items {
+		for _, e := range getServiceEndpoints(&slice) {
+			if e.Ready {
+				ready++
+			}
+			res.Endpoints = append(res.Endpoints, e)
+		}
+	}
+
+	// pods behind the selector
+	var pods []corev1.Pod
+	if len(svc.Spec.Selector) > 0 {
+		list, err := s.clientSet.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: labels.SelectorFromSet(svc.Spec.Selector).String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		pods = list.Items
+		for _, p := range pods {
+			res.Pods = append(res.Pods, p.Name)
+		}
+		if len(pods) == 0 {
+			res.Warnings = append(res.Warnings, fmt.Sprintf("selector %s matches no pods", labels.SelectorFromSet(svc.Spec.Selector).String()))
+		}
+	} else if svc.Spec.Type != corev1.ServiceTypeExternalName {
+		res.Warnings = append(res.Warnings, "service has no selector, endpoints must be managed manually")
+	}
+
+	// ports
+	res.Ports = make([]resp.ServicePortCheck, 0, len(svc.Spec.Ports))
+	for _, p := range svc.Spec.Ports {
+		check := resp.ServicePortCheck{
+			Name:       p.Name,
+			Port:       p.Port,
+			TargetPort: p.TargetPort.String(),
+			Resolved:   len(pods) == 0 || targetPortResolved(pods, p),
+		}
+		if !check.Resolved {
+			if p.TargetPort.Type == intstr.String {
+				res.Warnings = append(res.Warnings, fmt.Sprintf("port %d: named targetPort %s is not declared by any matched container", p.Port, p.TargetPort.StrVal))
+			} else {
+				res.Warnings = append(res.Warnings, fmt.Sprintf("port %d: targetPort %d is not declared by any matched container", p.Port, targetPortNumber(p)))
+			}
+		}
+		res.Ports = append(res.Ports, check)
+	}
+
+	if len(pods) > 0 && ready == 0 {
+		res.Warnings = append(res.Warnings, "service has no ready endpoints")
+	}
+
+	// routing
+	routes, err := s.getServiceRoutes(ctx, svc)
+	if err != nil {
+		return nil, err
+	}
+	res.Routes = routes
+
+	return res, nil
+}
+
+func getServiceEndpoints(slice *discoveryv1.EndpointSlice) []resp.ServiceEndpoint {
+	ports := make([]string, 0, len(slice.Ports))
+	for _, p := range slice.Ports {
+		var name, protocol string
+		var port int32
+		if p.Name != nil {
+			name = *p.Name
+		}
+		if p.Port != nil {
+			port = *p.Port
+		}
+		if p.Protocol != nil {
+			protocol = string(*p.Protocol)
+		}
+		ports = append(ports, fmt.Sprintf("%s/%d/%s", name, port, protocol))
+	}
+
+	res := make([]resp.ServiceEndpoint, 0, len(slice.Endpoints))
+	for _, e := range slice.Endpoints {
+		// A nil condition should be interpreted as true for ready and serving
+		ep := resp.ServiceEndpoint{
+			Ready:       e.Conditions.Ready == nil || *e.Conditions.Ready,
+			Serving:     e.Conditions.Serving == nil || *e.Conditions.Serving,
+			Terminating: e.Conditions.Terminating != nil && *e.Conditions.Terminating,
+			Ports:       ports,
+		}
+		if e.TargetRef != nil && e.TargetRef.Kind == "Pod" {
+			ep.Pod = e.TargetRef.Name
+		}
+		if e.NodeName != nil {
+			ep.Node = *e.NodeName
+		}
+		for _, addr := range e.Addresses {
+			ep.Address = addr
+			res = append(res, ep)
+		}
+	}
+
+	return res
+}
+
+func targetPortNumber(p corev1.ServicePort) int32 {
+	// An unset targetPort defaults to the service port
+	if p.TargetPort.IntVal == 0 {
+		return p.Port
+	}
+
+	return p.TargetPort.IntVal
+}
+
+func targetPortResolved(pods []corev1.Pod, p corev1.ServicePort) bool {
+	for _, pod := range pods {
+		for _, c := range pod.Spec.Containers {
+			for _, cp := range c.Ports {
+				if p.TargetPort.Type == intstr.String && cp.Name == p.TargetPort.StrVal {
+					return true
+				}
+				if p.TargetPort.Type == intstr.Int && cp.ContainerPort == targetPortNumber(p) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+func (s *svcService) getServiceRoutes(ctx context.Context, svc *corev1.Service) ([]resp.ServiceRoute, error) {
+	res := make([]resp.ServiceRoute, 0)
+
+	ingresses, err := s.clientSet.NetworkingV1().Ingresses(svc.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, ig := range ingresses.Items {
+		if b := ig.Spec.DefaultBackend; b != nil && b.Service != nil && b.Service.Name == svc.Name {
+			res = append(res, resp.ServiceRoute{
+				Kind: "Ingress",
+				Name: ig.Name,
+				Host: "*",
+				Port: ingressBackendPort(b.Service.Port.Name, b.Service.Port.Number),
+			})
+		}
+		for _, r := range ig.Spec.Rules {
+			if r.HTTP == nil {
+				continue
+			}
+			for _, p := range r.HTTP.Paths {
+				if p.Backend.Service == nil || p.Backend.Service.Name != svc.Name {
+					continue
+				}
+				res = append(res, resp.ServiceRoute{
+					Kind: "Ingress",
+					Name: ig.Name,
+					Host: r.Host,
+					Path: p.Path,
+					Port: ingressBackendPort(p.Backend.Service.Port.Name, p.Backend.Service.Port.Number),
+				})
+			}
+		}
+	}
+
+	// IngressRoutes only exist when traefik CRDs are installed, any other error must not pass for no routes
+	url := fmt.Sprintf("/apis/traefik.io/v1alpha1/namespaces/%s/ingressroutes", svc.Namespace)
+	raw, err := s.clientSet.NetworkingV1().RESTClient().Get().AbsPath(url).DoRaw(ctx)
+	if errors.IsNotFound(err) {
+		return res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var routes IngressRouteList
+	if err = sonic.Unmarshal(raw, &routes); err != nil {
+		return nil, err
+	}
+	for _, ir := range routes.Items {
+		for _, r := range ir.Spec.Routes {
+			for _, b := range r.Services {
+				if b.Name != svc.Name {
+					continue
+				}
+				res = append(res, resp.ServiceRoute{
+					Kind: "IngressRoute",
+					Name: ir.Metadata.Name,
+					Host: r.Match,
+					Port: strconv.Itoa(int(b.Port)),
+				})
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func ingressBackendPort(name string, number int32) string {
+	if name != "" {
+		return name
+	}
+
+	return strconv.Itoa(int(number))
+}
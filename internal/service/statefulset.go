@@ -4,11 +4,16 @@ import (
 	"context"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	autoscalingv1apply "k8s.io/client-go/applyconfigurations/autoscaling/v1"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
+	"github.com/crazyfrankie/kube-ctl/internal/model/resp"
 )
 
 type StatefulSetService interface {
@@ -16,6 +21,16 @@ type StatefulSetService interface {
 	DeleteStatefulSet(ctx context.Context, name string, namespace string) error
 	GetStatefulSetDetail(ctx context.Context, name string, namespace string) (*appsv1.StatefulSet, error)
 	GetStatefulSetList(ctx context.Context, namespace string) ([]appsv1.StatefulSet, error)
+	// GetAutoscaler returns the HPA scaling the StatefulSet, nil when there is none.
+	GetAutoscaler(ctx context.Context, name string, namespace string) (*autoscalingv2.HorizontalPodAutoscaler, error)
+	// ScaleStatefulSet sets the replicas through the scale subresource.
+	ScaleStatefulSet(ctx context.Context, name string, namespace string, replicas int32) error
+	// GetStatefulSetPods lists the pods the StatefulSet owns.
+	GetStatefulSetPods(ctx context.Context, state *appsv1.StatefulSet) ([]corev1.Pod, error)
+	// GetStatefulSetPVCs describes the claims created from the volumeClaimTemplates.
+	GetStatefulSetPVCs(ctx context.Context, state *appsv1.StatefulSet) ([]resp.StatefulSetPVC, error)
+	// DeleteOrphanedPVCs deletes the claims left behind by a scale down and returns their names.
+	DeleteOrphanedPVCs(ctx context.Context, state *appsv1.StatefulSet) ([]string, error)
 }
 
 type statefulSetService struct {
@@ -29,16 +44,13 @@ func NewStatefulSetService(cs *kubernetes.Clientset) StatefulSetService {
 func (s *statefulSetService) CreateOrUpdateStatefulSet(ctx context.Context, req *req.StatefulSet) error {
 	stateful := convert.StatefulSetReqConvert(req)
 
-	if exists, err := s.clientSet.AppsV1().StatefulSets(stateful.Namespace).Get(ctx, stateful.Name, metav1.GetOptions{}); err != nil {
-		exists.Spec = stateful.Spec
-		_, err := s.clientSet.AppsV1().StatefulSets(stateful.Namespace).Create(ctx, exists, metav1.CreateOptions{})
-
+	client := s.clientSet.AppsV1().StatefulSets(stateful.Namespace)
+	live, found, err := getLive(ctx, client, stateful.Name)
+	if err != nil {
 		return err
 	}
 
-	_, err := s.clientSet.AppsV1().StatefulSets(stateful.Namespace).Update(ctx, stateful, metav1.UpdateOptions{})
-
-	return err
+	return applyObject(ctx, client, stateful, live, found, req.ResourceVersion)
 }
 
 func (s *statefulSetService) DeleteStatefulSet(ctx context.Context, name string, namespace string) error {
@@ -62,3 +74,85 @@ func (s *statefulSetService) GetStatefulSetList(ctx context.Context, namespace s
 
 	return res.Items, nil
 }
+
+func (s *statefulSetService) GetAutoscaler(ctx context.Context, name string, namespace string) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	return workloadAutoscaler(ctx, s.clientSet, namespace, "StatefulSet", name)
+}
+
+func (s *statefulSetService) ScaleStatefulSet(ctx context.Context, name string, namespace string, replicas int32) error {
+	// applied as kube-ctl so that the next edit of the StatefulSet does not conflict on the replicas
+	scale := autoscalingv1apply.Scale().WithSpec(autoscalingv1apply.ScaleSpec().WithReplicas(replicas))
+	_, err := s.clientSet.AppsV1().StatefulSets(namespace).ApplyScale(ctx, name, scale, metav1.ApplyOptions{
+		FieldManager: FieldManager,
+		Force:        true,
+	})
+
+	return err
+}
+
+func (s *statefulSetService) GetStatefulSetPods(ctx context.Context, state *appsv1.StatefulSet) ([]corev1.Pod, error) {
+	selector, err := metav1.LabelSelectorAsSelector(state.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.clientSet.CoreV1().Pods(state.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]corev1.Pod, 0, len(list.Items))
+	for _, pod := range list.Items {
+		if ref := metav1.GetControllerOf(&pod); ref != nil && ref.UID == state.UID {
+			res = append(res, pod)
+		}
+	}
+
+	return res, nil
+}
+
+func (s *statefulSetService) GetStatefulSetPVCs(ctx context.Context, state *appsv1.StatefulSet) ([]resp.StatefulSetPVC, error) {
+	if len(state.Spec.VolumeClaimTemplates) == 0 {
+		return []resp.StatefulSetPVC{}, nil
+	}
+	list, err := s.clientSet.CoreV1().PersistentVolumeClaims(state.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	pvcs := make([]corev1.PersistentVolumeClaim, 0, len(list.Items))
+	for _, pvc := range list.Items {
+		if _, _, ok := convert.StatefulSetClaim(state, pvc.Name); ok {
+			pvcs = append(pvcs, pvc)
+		}
+	}
+
+	// any pod of the namespace may mount the claim, not only the ones of the StatefulSet
+	pods, err := s.clientSet.CoreV1().Pods(state.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return convert.StatefulSetPVCConvertResp(state, pvcs, pods.Items), nil
+}
+
+func (s *statefulSetService) DeleteOrphanedPVCs(ctx context.Context, state *appsv1.StatefulSet) ([]string, error) {
+	claims, err := s.GetStatefulSetPVCs(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted := make([]string, 0)
+	for _, claim := range claims {
+		if !claim.Orphaned {
+			continue
+		}
+		err := s.clientSet.CoreV1().PersistentVolumeClaims(state.Namespace).Delete(ctx, claim.Name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return deleted, err
+		}
+		deleted = append(deleted, claim.Name)
+	}
+
+	return deleted, nil
+}
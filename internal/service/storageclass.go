@@ -2,19 +2,33 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"strings"
 
+	"github.com/bytedance/sonic"
 	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
 	"github.com/crazyfrankie/kube-ctl/internal/model/req"
 )
 
+var (
+	ErrStorageClassImmutable = fmt.Errorf("storage class field is immutable")
+)
+
 type StorageClassService interface {
-	CreateStorageClass(ctx context.Context, req *req.StorageClass) error
+	// CreateOrUpdateStorageClass creates a class or updates its labels, mount options and expansion,
+	// the other fields can not change once it exists.
+	CreateOrUpdateStorageClass(ctx context.Context, req *req.StorageClass) error
 	DeleteStorageClass(ctx context.Context, name string) error
+	GetStorageClassDetail(ctx context.Context, name string) (*storagev1.StorageClass, error)
 	GetStorageClassList(ctx context.Context) ([]storagev1.StorageClass, error)
+	// SetDefaultStorageClass marks or unmarks the cluster default class, marking one unmarks the others.
+	SetDefaultStorageClass(ctx context.Context, name string, isDefault bool) error
 }
 
 type storageClassService struct {
@@ -25,17 +39,51 @@ func NewStorageClassService(cs *kubernetes.Clientset) StorageClassService {
 	return &storageClassService{clientSet: cs}
 }
 
-func (s *storageClassService) CreateStorageClass(ctx context.Context, req *req.StorageClass) error {
+func (s *storageClassService) CreateOrUpdateStorageClass(ctx context.Context, req *req.StorageClass) error {
 	sc := convert.StorageClassReqConvert(req)
-	_, err := s.clientSet.StorageV1().StorageClasses().Create(ctx, sc, metav1.CreateOptions{})
+	client := s.clientSet.StorageV1().StorageClasses()
 
-	return err
+	live, found, err := getLive(ctx, client, sc.Name)
+	if err != nil {
+		return err
+	}
+	if found {
+		if changed := storageClassChanges(live, sc); len(changed) > 0 {
+			return fmt.Errorf("%w: %s of StorageClass %s can not be changed, delete and recreate it",
+				ErrStorageClassImmutable, strings.Join(changed, ", "), sc.Name)
+		}
+	}
+
+	return applyObject(ctx, client, sc, live, found, req.ResourceVersion)
+}
+
+// storageClassChanges lists the immutable fields sc changes, the api server would only reject them as invalid.
+func storageClassChanges(live *storagev1.StorageClass, sc *storagev1.StorageClass) []string {
+	var changed []string
+	if live.Provisioner != sc.Provisioner {
+		changed = append(changed, "provisioner")
+	}
+	if !maps.Equal(live.Parameters, sc.Parameters) {
+		changed = append(changed, "parameters")
+	}
+	if live.ReclaimPolicy != nil && *live.ReclaimPolicy != *sc.ReclaimPolicy {
+		changed = append(changed, "reclaimPolicy")
+	}
+	if live.VolumeBindingMode != nil && *live.VolumeBindingMode != *sc.VolumeBindingMode {
+		changed = append(changed, "volumeBindingMode")
+	}
+
+	return changed
 }
 
 func (s *storageClassService) DeleteStorageClass(ctx context.Context, name string) error {
 	return s.clientSet.StorageV1().StorageClasses().Delete(ctx, name, metav1.DeleteOptions{})
 }
 
+func (s *storageClassService) GetStorageClassDetail(ctx context.Context, name string) (*storagev1.StorageClass, error) {
+	return s.clientSet.StorageV1().StorageClasses().Get(ctx, name, metav1.GetOptions{})
+}
+
 func (s *storageClassService) GetStorageClassList(ctx context.Context) ([]storagev1.StorageClass, error) {
 	list, err := s.clientSet.StorageV1().StorageClasses().List(ctx, metav1.ListOptions{})
 	if err != nil {
@@ -44,3 +92,49 @@ func (s *storageClassService) GetStorageClassList(ctx context.Context) ([]storag
 
 	return list.Items, nil
 }
+
+func (s *storageClassService) SetDefaultStorageClass(ctx context.Context, name string, isDefault bool) error {
+	if !isDefault {
+		return s.patchDefault(ctx, name, nil)
+	}
+
+	// mark the new default first, so claims created meanwhile never go without a class
+	if err := s.patchDefault(ctx, name, "true"); err != nil {
+		return err
+	}
+	list, err := s.clientSet.StorageV1().StorageClasses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, sc := range list.Items {
+		if sc.Name != name && convert.IsDefaultStorageClass(&sc) {
+			if err := s.patchDefault(ctx, sc.Name, nil); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// patchDefault sets the default class annotation to value, nil removes it along with the beta one.
+func (s *storageClassService) patchDefault(ctx context.Context, name string, value any) error {
+	annotations := map[string]any{
+		convert.DefaultStorageClassAnnotation: value,
+	}
+	if value == nil {
+		annotations[convert.BetaDefaultStorageClassAnnotation] = nil
+	}
+	patch := map[string]any{
+		"metadata": map[string]any{
+			"annotations": annotations,
+		},
+	}
+	data, err := sonic.Marshal(&patch)
+	if err != nil {
+		return err
+	}
+	_, err = s.clientSet.StorageV1().StorageClasses().Patch(ctx, name, types.MergePatchType, data, metav1.PatchOptions{})
+
+	return err
+}
@@ -0,0 +1,427 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bytedance/sonic"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/crazyfrankie/kube-ctl/internal/model/convert"
+	"github.com/crazyfrankie/kube-ctl/internal/model/req"
+	"github.com/crazyfrankie/kube-ctl/pkg/utils"
+)
+
+const (
+	snapshotGroup        = "snapshot.storage.k8s.io"
+	snapshotGroupVersion = "snapshot.storage.k8s.io/v1"
+	// DefaultSnapshotClassAnnotation marks the class of the snapshots that name none, one per driver
+	DefaultSnapshotClassAnnotation = "snapshot.storage.kubernetes.io/is-default-class"
+)
+
+var (
+	ErrSnapshotUnsupported = fmt.Errorf("volume snapshots are not supported, the %s CRDs are not installed", snapshotGroupVersion)
+	ErrVolumeSnapshot      = fmt.Errorf("volume snapshot can not be taken")
+	ErrSnapshotRestore     = fmt.Errorf("volume snapshot can not be restored")
+)
+
+type VolumeSnapshot struct {
+	metav1.TypeMeta `json:",inline"`
+	Metadata        metav1.ObjectMeta     `json:"metadata,omitempty"`
+	Spec            VolumeSnapshotSpec    `json:"spec"`
+	Status          *VolumeSnapshotStatus `json:"status,omitempty"`
+}
+
+type VolumeSnapshotSpec struct {
+	Source                  VolumeSnapshotSource `json:"source"`
+	VolumeSnapshotClassName *string              `json:"volumeSnapshotClassName,omitempty"`
+}
+
+// VolumeSnapshotSource is either a claim to snapshot or the content of a snapshot taken outside the cluster.
+type VolumeSnapshotSource struct {
+	PersistentVolumeClaimName *string `json:"persistentVolumeClaimName,omitempty"`
+	VolumeSnapshotContentName *string `json:"volumeSnapshotContentName,omitempty"`
+}
+
+type VolumeSnapshotStatus struct {
+	BoundVolumeSnapshotContentName *string              `json:"boundVolumeSnapshotContentName,omitempty"`
+	CreationTime                   *metav1.Time         `json:"creationTime,omitempty"`
+	ReadyToUse                     *bool                `json:"readyToUse,omitempty"`
+	RestoreSize                    *resource.Quantity   `json:"restoreSize,omitempty"`
+	Error                          *VolumeSnapshotError `json:"error,omitempty"`
+}
+
+type VolumeSnapshotError struct {
+	Time    *metav1.Time `json:"time,omitempty"`
+	Message *string      `json:"message,omitempty"`
+}
+
+type VolumeSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VolumeSnapshot `json:"items"`
+}
+
+type VolumeSnapshotClass struct {
+	metav1.TypeMeta `json:",inline"`
+	Metadata        metav1.ObjectMeta `json:"metadata,omitempty"`
+	Driver          string            `json:"driver"`
+	Parameters      map[string]string `json:"parameters,omitempty"`
+	DeletionPolicy  string            `json:"deletionPolicy"`
+}
+
+type VolumeSnapshotClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VolumeSnapshotClass `json:"items"`
+}
+
+type VolumeSnapshotService interface {
+	// Supported tells whether the snapshot CRDs are installed in the cluster.
+	Supported(ctx context.Context) (bool, error)
+	// CreateVolumeSnapshot snapshots a bound CSI claim with the named or the default class of its driver.
+	CreateVolumeSnapshot(ctx context.Context, req *req.VolumeSnapshot) error
+	DeleteVolumeSnapshot(ctx context.Context, name string, namespace string) error
+	GetVolumeSnapshotList(ctx context.Context, namespace string) ([]VolumeSnapshot, error)
+	CreateVolumeSnapshotClass(ctx context.Context, req *req.VolumeSnapshotClass) error
+	DeleteVolumeSnapshotClass(ctx context.Context, name string) error
+	GetVolumeSnapshotClassList(ctx context.Context) ([]VolumeSnapshotClass, error)
+	// RestoreVolumeSnapshot creates a claim provisioned from a ready snapshot,
+	// what the request leaves out is taken from the snapshotted claim.
+	RestoreVolumeSnapshot(ctx context.Context, req *req.VolumeSnapshotRestore) error
+}
+
+type volumeSnapshotService struct {
+	clientSet *kubernetes.Clientset
+}
+
+func NewVolumeSnapshotService(cs *kubernetes.Clientset) VolumeSnapshotService {
+	return &volumeSnapshotService{clientSet: cs}
+}
+
+func (s *volumeSnapshotService) Supported(ctx context.Context) (bool, error) {
+	_, err := s.clientSet.Discovery().ServerResourcesForGroupVersion(snapshotGroupVersion)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *volumeSnapshotService) checkSupported(ctx context.Context) error {
+	ok, err := s.Supported(ctx)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrSnapshotUnsupported
+	}
+
+	return nil
+}
+
+func (s *volumeSnapshotService) CreateVolumeSnapshot(ctx context.Context, request *req.VolumeSnapshot) error {
+	if err := s.checkSupported(ctx); err != nil {
+		return err
+	}
+
+	pvc, err := s.clientSet.CoreV1().PersistentVolumeClaims(request.Namespace).Get(ctx, request.PVCName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return fmt.Errorf("%w: pvc %s does not exist", ErrVolumeSnapshot, request.PVCName)
+		}
+		return err
+	}
+	if pvc.Status.Phase != corev1.ClaimBound {
+		return fmt.Errorf("%w: pvc %s is %s, only a bound claim can be snapshotted", ErrVolumeSnapshot, pvc.Name, pvc.Status.Phase)
+	}
+	pv, err := s.clientSet.CoreV1().PersistentVolumes().Get(ctx, pvc.Spec.VolumeName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if pv.Spec.CSI == nil {
+		return fmt.Errorf("%w: volume %s of pvc %s is not a CSI volume", ErrVolumeSnapshot, pv.Name, pvc.Name)
+	}
+
+	// the snapshot controller only marks a snapshot failed when its class is missing, check it upfront
+	classes, err := s.GetVolumeSnapshotClassList(ctx)
+	if err != nil {
+		return err
+	}
+	className := request.SnapshotClassName
+	if className == "" {
+		for _, c := range classes {
+			if c.Driver == pv.Spec.CSI.Driver && c.Metadata.Annotations[DefaultSnapshotClassAnnotation] == "true" {
+				className = c.Metadata.Name
+			}
+		}
+		if className == "" {
+			return fmt.Errorf("%w: driver %s has no default VolumeSnapshotClass, name one", ErrVolumeSnapshot, pv.Spec.CSI.Driver)
+		}
+	} else {
+		var class *VolumeSnapshotClass
+		for i := range classes {
+			if classes[i].Metadata.Name == className {
+				class = &classes[i]
+			}
+		}
+		if class == nil {
+			return fmt.Errorf("%w: VolumeSnapshotClass %s does not exist", ErrVolumeSnapshot, className)
+		}
+		if class.Driver != pv.Spec.CSI.Driver {
+			return fmt.Errorf("%w: VolumeSnapshotClass %s is for driver %s, the volume is provisioned by %s",
+				ErrVolumeSnapshot, className, class.Driver, pv.Spec.CSI.Driver)
+		}
+	}
+
+	snapshot := &VolumeSnapshot{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "VolumeSnapshot",
+			APIVersion: snapshotGroupVersion,
+		},
+		Metadata: metav1.ObjectMeta{
+			Name:      request.Name,
+			Namespace: request.Namespace,
+			Labels:    utils.ReqItemToMap(request.Labels),
+		},
+		Spec: VolumeSnapshotSpec{
+			Source: VolumeSnapshotSource{
+				PersistentVolumeClaimName: &request.PVCName,
+			},
+			VolumeSnapshotClassName: &className,
+		},
+	}
+
+	return s.create(ctx, snapshotURL(request.Namespace, ""), snapshot)
+}
+
+func (s *volumeSnapshotService) DeleteVolumeSnapshot(ctx context.Context, name string, namespace string) error {
+	_, err := s.clientSet.StorageV1().RESTClient().Delete().AbsPath(snapshotURL(namespace, name)).DoRaw(ctx)
+
+	return err
+}
+
+func (s *volumeSnapshotService) GetVolumeSnapshotList(ctx context.Context, namespace string) ([]VolumeSnapshot, error) {
+	if err := s.checkSupported(ctx); err != nil {
+		return nil, err
+	}
+
+	raw, err := s.clientSet.StorageV1().RESTClient().Get().AbsPath(snapshotURL(namespace, "")).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var res VolumeSnapshotList
+	if err = sonic.Unmarshal(raw, &res); err != nil {
+		return nil, err
+	}
+
+	return res.Items, nil
+}
+
+func (s *volumeSnapshotService) CreateVolumeSnapshotClass(ctx context.Context, request *req.VolumeSnapshotClass) error {
+	if err := s.checkSupported(ctx); err != nil {
+		return err
+	}
+
+	class := &VolumeSnapshotClass{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "VolumeSnapshotClass",
+			APIVersion: snapshotGroupVersion,
+		},
+		Metadata: metav1.ObjectMeta{
+			Name:   request.Name,
+			Labels: utils.ReqItemToMap(request.Labels),
+		},
+		Driver:         request.Driver,
+		Parameters:     utils.ReqItemToMap(request.Parameters),
+		DeletionPolicy: request.DeletionPolicy,
+	}
+	if request.IsDefault {
+		class.Metadata.Annotations = map[string]string{DefaultSnapshotClassAnnotation: "true"}
+	}
+
+	return s.create(ctx, snapshotClassURL(""), class)
+}
+
+func (s *volumeSnapshotService) DeleteVolumeSnapshotClass(ctx context.Context, name string) error {
+	_, err := s.clientSet.StorageV1().RESTClient().Delete().AbsPath(snapshotClassURL(name)).DoRaw(ctx)
+
+	return err
+}
+
+func (s *volumeSnapshotService) GetVolumeSnapshotClassList(ctx context.Context) ([]VolumeSnapshotClass, error) {
+	if err := s.checkSupported(ctx); err != nil {
+		return nil, err
+	}
+
+	raw, err := s.clientSet.StorageV1().RESTClient().Get().AbsPath(snapshotClassURL("")).DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var res VolumeSnapshotClassList
+	if err = sonic.Unmarshal(raw, &res); err != nil {
+		return nil, err
+	}
+
+	return res.Items, nil
+}
+
+func (s *volumeSnapshotService) RestoreVolumeSnapshot(ctx context.Context, request *req.VolumeSnapshotRestore) error {
+	if err := s.checkSupported(ctx); err != nil {
+		return err
+	}
+
+	raw, err := s.clientSet.StorageV1().RESTClient().Get().AbsPath(snapshotURL(request.Namespace, request.SnapshotName)).DoRaw(ctx)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return fmt.Errorf("%w: snapshot %s does not exist", ErrSnapshotRestore, request.SnapshotName)
+		}
+		return err
+	}
+	var snapshot VolumeSnapshot
+	if err = sonic.Unmarshal(raw, &snapshot); err != nil {
+		return err
+	}
+	status := snapshot.Status
+	if status == nil || status.ReadyToUse == nil || !*status.ReadyToUse {
+		if status != nil && status.Error != nil && status.Error.Message != nil {
+			return fmt.Errorf("%w: snapshot %s failed: %s", ErrSnapshotRestore, request.SnapshotName, *status.Error.Message)
+		}
+		return fmt.Errorf("%w: snapshot %s is not ready to use yet", ErrSnapshotRestore, request.SnapshotName)
+	}
+
+	claim := req.PersistentVolumeClaim{
+		Name:             request.PVCName,
+		Namespace:        request.Namespace,
+		AccessModes:      request.AccessModes,
+		Capacity:         request.Capacity,
+		StorageClassName: request.StorageClassName,
+	}
+	// the snapshotted claim may be gone already, then only the request counts
+	var source *corev1.PersistentVolumeClaim
+	if name := snapshot.Spec.Source.PersistentVolumeClaimName; name != nil {
+		source, err = s.clientSet.CoreV1().PersistentVolumeClaims(request.Namespace).Get(ctx, *name, metav1.GetOptions{})
+		if err != nil {
+			if !errors.IsNotFound(err) {
+				return err
+			}
+			source = nil
+		}
+	}
+	if source != nil {
+		if claim.StorageClassName == "" && source.Spec.StorageClassName != nil {
+			claim.StorageClassName = *source.Spec.StorageClassName
+		}
+		if len(claim.AccessModes) == 0 {
+			claim.AccessModes = source.Spec.AccessModes
+		}
+	}
+	if len(claim.AccessModes) == 0 {
+		claim.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}
+	}
+	if status.RestoreSize != nil && !status.RestoreSize.IsZero() {
+		if claim.Capacity == "" {
+			claim.Capacity = status.RestoreSize.String()
+		} else if capacity := resource.MustParse(claim.Capacity); capacity.Cmp(*status.RestoreSize) < 0 {
+			return fmt.Errorf("%w: capacity %s is less than the restore size %s of the snapshot",
+				ErrSnapshotRestore, claim.Capacity, status.RestoreSize.String())
+		}
+	}
+	if claim.Capacity == "" {
+		return fmt.Errorf("%w: snapshot %s has no restore size, set the capacity", ErrSnapshotRestore, request.SnapshotName)
+	}
+
+	// only the driver that took the snapshot can provision a volume from it
+	if claim.StorageClassName != "" && snapshot.Spec.VolumeSnapshotClassName != nil {
+		sc, err := s.clientSet.StorageV1().StorageClasses().Get(ctx, claim.StorageClassName, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return fmt.Errorf("%w: StorageClass %s does not exist", ErrSnapshotRestore, claim.StorageClassName)
+			}
+			return err
+		}
+		raw, err := s.clientSet.StorageV1().RESTClient().Get().AbsPath(snapshotClassURL(*snapshot.Spec.VolumeSnapshotClassName)).DoRaw(ctx)
+		if err == nil {
+			var class VolumeSnapshotClass
+			if err = sonic.Unmarshal(raw, &class); err != nil {
+				return err
+			}
+			if class.Driver != sc.Provisioner {
+				return fmt.Errorf("%w: the snapshot was taken by driver %s, StorageClass %s provisions with %s",
+					ErrSnapshotRestore, class.Driver, sc.Name, sc.Provisioner)
+			}
+		} else if !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	pvc := convert.PVCReqConvert(&claim)
+	if source != nil {
+		// a Block snapshot restores only into a Block claim
+		pvc.Spec.VolumeMode = source.Spec.VolumeMode
+	}
+	group := snapshotGroup
+	pvc.Spec.DataSource = &corev1.TypedLocalObjectReference{
+		APIGroup: &group,
+		Kind:     "VolumeSnapshot",
+		Name:     request.SnapshotName,
+	}
+	res, err := s.clientSet.CoreV1().PersistentVolumeClaims(pvc.Namespace).Create(ctx, pvc, createOptions(ctx))
+	recordDryRun(ctx, DryRunCreate, nil, res)
+
+	return err
+}
+
+// create posts a new custom object, the CRDs have no typed client.
+func (s *volumeSnapshotService) create(ctx context.Context, url string, obj any) error {
+	data, err := sonic.Marshal(obj)
+	if err != nil {
+		return err
+	}
+
+	post := s.clientSet.StorageV1().RESTClient().Post().AbsPath(url).
+		SetHeader("Content-Type", "application/json").
+		Body(data)
+	for _, d := range dryRunOption(ctx) {
+		post = post.Param("dryRun", d)
+	}
+	res, err := post.DoRaw(ctx)
+	if err != nil {
+		return err
+	}
+	recordDryRun(ctx, DryRunCreate, nil, res)
+
+	return nil
+}
+
+// snapshotURL is the path of the snapshots of namespace, or of all of them when namespace is empty.
+func snapshotURL(namespace string, name string) string {
+	url := "/apis/" + snapshotGroupVersion
+	if namespace != "" {
+		url += "/namespaces/" + namespace
+	}
+	url += "/volumesnapshots"
+	if name != "" {
+		url += "/" + name
+	}
+
+	return url
+}
+
+func snapshotClassURL(name string) string {
+	url := "/apis/" + snapshotGroupVersion + "/volumesnapshotclasses"
+	if name != "" {
+		url += "/" + name
+	}
+
+	return url
+}
@@ -29,7 +29,16 @@ type App struct {
 	Metrics *metrics.MetricsHandler
 }
 
-func InitKubernetes() *kubernetes.Clientset {
+func InitKubeConfig() *rest.Config {
+	if isInCluster() {
+		cfg, err := rest.InClusterConfig()
+		if err != nil {
+			panic(err)
+		}
+
+		return cfg
+	}
+
 	kubeConfig := ".kube/config"
 	// use the current context in kubeconfig
 	config, err := clientcmd.BuildConfigFromFlags("", kubeConfig)
@@ -37,8 +46,12 @@ func InitKubernetes() *kubernetes.Clientset {
 		panic(err.Error())
 	}
 
+	return config
+}
+
+func InitKubernetesWithDiscovery(cfg *rest.Config) *kubernetes.Clientset {
 	// create the clientSet
-	clientSet, err := kubernetes.NewForConfig(config)
+	clientSet, err := kubernetes.NewForConfig(cfg)
 	if err != nil {
 		panic(err.Error())
 	}
@@ -46,23 +59,6 @@ func InitKubernetes() *kubernetes.Clientset {
 	return clientSet
 }
 
-func InitKubernetesWithDiscovery() *kubernetes.Clientset {
-	if isInCluster() {
-		cfg, err := rest.InClusterConfig()
-		if err != nil {
-			panic(err)
-		}
-		clientSet, err := kubernetes.NewForConfig(cfg)
-		if err != nil {
-			panic(err)
-		}
-
-		return clientSet
-	} else {
-		return InitKubernetes()
-	}
-}
-
 func isInCluster() bool {
 	tokenFile := "/var/run/secrets/kubernetes.io/serviceaccount/token"
 
@@ -98,7 +94,10 @@ func InitGin(mws []gin.HandlerFunc, pod *k8s.PodHandler, node *k8s.NodeHandler,
 	igRoute *k8s.IngressRouteHandler, deployment *k8s.DeploymentHandler,
 	daemon *k8s.DaemonSetHandler, stateful *k8s.StatefulSetHandler,
 	job *k8s.JobHandler, cron *k8s.CronJobHandler,
-	rbac *k8s.RbacHandler, metrics *k8s.MetricsHandler) *gin.Engine {
+	rbac *k8s.RbacHandler, metrics *k8s.MetricsHandler,
+	portForward *k8s.PortForwardHandler, podFile *k8s.PodFileHandler,
+	hpa *k8s.HPAHandler, pdb *k8s.PDBHandler,
+	networkPolicy *k8s.NetworkPolicyHandler, volumeSnapshot *k8s.VolumeSnapshotHandler) *gin.Engine {
 	srv := gin.Default()
 	srv.Use(mws...)
 
@@ -119,6 +118,12 @@ func InitGin(mws []gin.HandlerFunc, pod *k8s.PodHandler, node *k8s.NodeHandler,
 	cron.RegisterRoute(srv)
 	rbac.RegisterRoute(srv)
 	metrics.RegisterRoute(srv)
+	portForward.RegisterRoute(srv)
+	podFile.RegisterRoute(srv)
+	hpa.RegisterRoute(srv)
+	pdb.RegisterRoute(srv)
+	networkPolicy.RegisterRoute(srv)
+	volumeSnapshot.RegisterRoute(srv)
 
 	srv.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerfiles.Handler))
 
@@ -130,6 +135,7 @@ func InitGin(mws []gin.HandlerFunc, pod *k8s.PodHandler, node *k8s.NodeHandler,
 func InitApp() *App {
 	wire.Build(
 		InitMws,
+		InitKubeConfig,
 		InitKubernetesWithDiscovery,
 		InitPromAPI,
 
@@ -150,6 +156,12 @@ func InitApp() *App {
 		service.NewCronJobService,
 		service.NewRbacService,
 		service.NewMetricsService,
+		service.NewPortForwardService,
+		service.NewPodFileService,
+		service.NewHPAService,
+		service.NewPDBService,
+		service.NewNetworkPolicyService,
+		service.NewVolumeSnapshotService,
 		k8s.NewPodHandler,
 		k8s.NewNodeHandler,
 		k8s.NewConfigMapHandler,
@@ -167,6 +179,12 @@ func InitApp() *App {
 		k8s.NewCronJobHandler,
 		k8s.NewRbacHandler,
 		k8s.NewMetricsHandler,
+		k8s.NewPortForwardHandler,
+		k8s.NewPodFileHandler,
+		k8s.NewHPAHandler,
+		k8s.NewPDBHandler,
+		k8s.NewNetworkPolicyHandler,
+		k8s.NewVolumeSnapshotHandler,
 
 		InitGin,
 		metrics.NewMetricsHandler,
@@ -29,7 +29,8 @@ import (
 
 func InitApp() *App {
 	v := InitMws()
-	clientset := InitKubernetesWithDiscovery()
+	config := InitKubeConfig()
+	clientset := InitKubernetesWithDiscovery(config)
 	podService := service.NewPodService(clientset)
 	podHandler := k8s.NewPodHandler(podService)
 	nodeService := service.NewNodeService(clientset)
@@ -65,7 +66,19 @@ func InitApp() *App {
 	api := InitPromAPI()
 	metricsService := service.NewMetricsService(clientset, api)
 	metricsHandler := k8s.NewMetricsHandler(metricsService)
-	engine := InitGin(v, podHandler, nodeHandler, configMapHandler, secretHandler, pvHandler, pvcHandler, storageClassHandler, serviceHandler, ingressHandler, ingressRouteHandler, deploymentHandler, daemonSetHandler, statefulSetHandler, jobHandler, cronJobHandler, rbacHandler, metricsHandler)
+	portForwardService := service.NewPortForwardService(clientset, config)
+	portForwardHandler := k8s.NewPortForwardHandler(portForwardService)
+	podFileService := service.NewPodFileService(clientset, config)
+	podFileHandler := k8s.NewPodFileHandler(podFileService)
+	hpaService := service.NewHPAService(clientset)
+	hpaHandler := k8s.NewHPAHandler(hpaService)
+	pdbService := service.NewPDBService(clientset)
+	pdbHandler := k8s.NewPDBHandler(pdbService)
+	networkPolicyService := service.NewNetworkPolicyService(clientset)
+	networkPolicyHandler := k8s.NewNetworkPolicyHandler(networkPolicyService)
+	volumeSnapshotService := service.NewVolumeSnapshotService(clientset)
+	volumeSnapshotHandler := k8s.NewVolumeSnapshotHandler(volumeSnapshotService)
+	engine := InitGin(v, podHandler, nodeHandler, configMapHandler, secretHandler, pvHandler, pvcHandler, storageClassHandler, serviceHandler, ingressHandler, ingressRouteHandler, deploymentHandler, daemonSetHandler, statefulSetHandler, jobHandler, cronJobHandler, rbacHandler, metricsHandler, portForwardHandler, podFileHandler, hpaHandler, pdbHandler, networkPolicyHandler, volumeSnapshotHandler)
 	metricsMetricsHandler := metrics.NewMetricsHandler(metricsService)
 	app := &App{
 		Engine:  engine,
@@ -81,7 +94,16 @@ type App struct {
 	Metrics *metrics.MetricsHandler
 }
 
-func InitKubernetes() *kubernetes.Clientset {
+func InitKubeConfig() *rest.Config {
+	if isInCluster() {
+		cfg, err := rest.InClusterConfig()
+		if err != nil {
+			panic(err)
+		}
+
+		return cfg
+	}
+
 	kubeConfig := ".kube/config"
 
 	config, err := clientcmd.BuildConfigFromFlags("", kubeConfig)
@@ -89,7 +111,11 @@ func InitKubernetes() *kubernetes.Clientset {
 		panic(err.Error())
 	}
 
-	clientSet, err := kubernetes.NewForConfig(config)
+	return config
+}
+
+func InitKubernetesWithDiscovery(cfg *rest.Config) *kubernetes.Clientset {
+	clientSet, err := kubernetes.NewForConfig(cfg)
 	if err != nil {
 		panic(err.Error())
 	}
@@ -97,23 +123,6 @@ func InitKubernetes() *kubernetes.Clientset {
 	return clientSet
 }
 
-func InitKubernetesWithDiscovery() *kubernetes.Clientset {
-	if isInCluster() {
-		cfg, err := rest.InClusterConfig()
-		if err != nil {
-			panic(err)
-		}
-		clientSet, err := kubernetes.NewForConfig(cfg)
-		if err != nil {
-			panic(err)
-		}
-
-		return clientSet
-	} else {
-		return InitKubernetes()
-	}
-}
-
 func isInCluster() bool {
 	tokenFile := "/var/run/secrets/kubernetes.io/serviceaccount/token"
 
@@ -147,7 +156,10 @@ func InitGin(mws []gin.HandlerFunc, pod *k8s.PodHandler, node *k8s.NodeHandler,
 	igRoute *k8s.IngressRouteHandler, deployment *k8s.DeploymentHandler,
 	daemon *k8s.DaemonSetHandler, stateful *k8s.StatefulSetHandler,
 	job *k8s.JobHandler, cron *k8s.CronJobHandler,
-	rbac *k8s.RbacHandler, metrics2 *k8s.MetricsHandler) *gin.Engine {
+	rbac *k8s.RbacHandler, metrics2 *k8s.MetricsHandler,
+	portForward *k8s.PortForwardHandler, podFile *k8s.PodFileHandler,
+	hpa *k8s.HPAHandler, pdb *k8s.PDBHandler,
+	networkPolicy *k8s.NetworkPolicyHandler, volumeSnapshot *k8s.VolumeSnapshotHandler) *gin.Engine {
 	srv := gin.Default()
 	srv.Use(mws...)
 
@@ -169,6 +181,12 @@ func InitGin(mws []gin.HandlerFunc, pod *k8s.PodHandler, node *k8s.NodeHandler,
 	rbac.RegisterRoute(srv)
 	metrics2.
 		RegisterRoute(srv)
+	portForward.RegisterRoute(srv)
+	podFile.RegisterRoute(srv)
+	hpa.RegisterRoute(srv)
+	pdb.RegisterRoute(srv)
+	networkPolicy.RegisterRoute(srv)
+	volumeSnapshot.RegisterRoute(srv)
 
 	srv.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 	docs.SwaggerInfo.
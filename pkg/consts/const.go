@@ -9,4 +9,18 @@ const (
 	RestartPolicyOnFailure      = "On-Failure"
 
 	TokenTypeFile = "file"
+
+	// SecretMaskValue replaces secret values in responses
+	SecretMaskValue = "******"
+
+	// ConfigMapMaxSize is the limit of data plus binaryData enforced by the API server
+	ConfigMapMaxSize = 1 << 20
+	// SecretMaxSize is the limit of the data of a secret enforced by the API server
+	SecretMaxSize = 1 << 20
+
+	// HPADefaultCPUUtilization is the target the API server sets for an HPA without metrics
+	HPADefaultCPUUtilization = 80
+	// HPAMaxStabilizationWindow and HPAMaxPolicyPeriod are the limits of the autoscaling/v2 behavior, in seconds
+	HPAMaxStabilizationWindow = 3600
+	HPAMaxPolicyPeriod        = 1800
 )
@@ -41,11 +41,16 @@ func SuccessWithData(c *gin.Context, data any) {
 }
 
 func Error(c *gin.Context, code int, err error) {
+	ErrorWithData(c, code, err, nil)
+}
+
+// ErrorWithData carries data the client needs to recover, such as the current object of a conflict.
+func ErrorWithData(c *gin.Context, code int, err error, data any) {
 	if bizErr, ok := gerrors.FromBizStatusError(err); ok {
 		c.JSON(code, Response{
 			Code: bizErr.BizStatusCode(),
 			Msg:  bizErr.BizMessage(),
-			Data: nil,
+			Data: data,
 		})
 		return
 	}
@@ -53,6 +58,6 @@ func Error(c *gin.Context, code int, err error) {
 	c.JSON(code, Response{
 		Code: 50000,
 		Msg:  err.Error(),
-		Data: nil,
+		Data: data,
 	})
 }
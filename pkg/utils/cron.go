@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	// the time zones of CronJobs are loaded by name, images without zoneinfo need the embedded copy
+	_ "time/tzdata"
+
+	"github.com/robfig/cron/v3"
+)
+
+// ParseSchedule parses a CronJob schedule the way the cronjob controller does,
+// an empty timeZone means the local time of kube-controller-manager, taken as UTC here.
+func ParseSchedule(schedule string, timeZone string) (cron.Schedule, *time.Location, error) {
+	if strings.Contains(schedule, "TZ") {
+		return nil, nil, errors.New("schedule must not contain TZ or CRON_TZ, set timeZone instead")
+	}
+
+	loc := time.UTC
+	if timeZone != "" {
+		var err error
+		loc, err = time.LoadLocation(timeZone)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unknown time zone %s: %w", timeZone, err)
+		}
+	}
+
+	sched, err := cron.ParseStandard(schedule)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid schedule %q: %w", schedule, err)
+	}
+
+	return sched, loc, nil
+}
+
+// NextRuns returns the next count activations of sched after from, in loc.
+func NextRuns(sched cron.Schedule, loc *time.Location, from time.Time, count int) []time.Time {
+	res := make([]time.Time, 0, count)
+	next := from.In(loc)
+	for i := 0; i < count; i++ {
+		next = sched.Next(next)
+		// cron returns the zero time when the schedule never fires, e.g. on Feb 30
+		if next.IsZero() {
+			break
+		}
+		res = append(res, next)
+	}
+
+	return res
+}